@@ -607,6 +607,12 @@ var (
 		Value:    ethconfig.Defaults.RPCTxFeeCap,
 		Category: flags.APICategory,
 	}
+	RPCGlobalTraceFilterRangeFlag = &cli.Uint64Flag{
+		Name:     "rpc.tracefilterrange",
+		Usage:    "Sets the maximum number of blocks a trace_filter request can span (0=no limit)",
+		Value:    ethconfig.Defaults.RPCTraceFilterRange,
+		Category: flags.APICategory,
+	}
 	// Authenticated RPC HTTP settings
 	AuthListenFlag = &cli.StringFlag{
 		Name:     "authrpc.addr",
@@ -1897,6 +1903,10 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 		cfg.RPCTxFeeCap = ctx.Float64(RPCGlobalTxFeeCapFlag.Name)
 	}
 
+	if ctx.IsSet(RPCGlobalTraceFilterRangeFlag.Name) {
+		cfg.RPCTraceFilterRange = ctx.Uint64(RPCGlobalTraceFilterRangeFlag.Name)
+	}
+
 	if ctx.IsSet(NoDiscoverFlag.Name) {
 		cfg.EthDiscoveryURLs, cfg.SnapDiscoveryURLs = []string{}, []string{}
 	} else if ctx.IsSet(DNSDiscoveryFlag.Name) {
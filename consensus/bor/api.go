@@ -2,27 +2,30 @@ package bor
 
 import (
 	"encoding/hex"
+	"errors"
 	"math"
-	"math/big"
+	"slices"
 	"sort"
 	"strconv"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/bor/valset"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rpc"
 
 	lru "github.com/hashicorp/golang-lru"
-	"github.com/xsleonard/go-merkle"
-	"golang.org/x/crypto/sha3"
 )
 
 var (
 	// MaxCheckpointLength is the maximum number of blocks that can be requested for constructing a checkpoint root hash
 	MaxCheckpointLength = uint64(math.Pow(2, 15))
+
+	// errBlockOutOfCheckpoint is returned when proving the inclusion of a block
+	// outside of the checkpoint range.
+	errBlockOutOfCheckpoint = errors.New("block out of checkpoint range")
 )
 
 // API is a user facing RPC API to allow controlling the signer and voting
@@ -283,16 +286,134 @@ func (api *API) GetRootHash(start uint64, end uint64) (string, error) {
 		return root.(string), nil
 	}
 
+	blockHeaders, err := api.getRootHashHeaders(start, end)
+	if err != nil {
+		return "", err
+	}
+
+	rootHash, err := RootHash(blockHeaders)
+	if err != nil {
+		return "", err
+	}
+
+	root := hex.EncodeToString(rootHash[:])
+	api.rootHashCache.Add(key, root)
+
+	return root, nil
+}
+
+// RootHashProof is a merkle proof of the inclusion of a block header in the
+// checkpoint root hash of a block range.
+type RootHashProof struct {
+	RootHash common.Hash    `json:"rootHash"`
+	Header   *types.Header  `json:"header"`
+	Leaf     common.Hash    `json:"leaf"`
+	Index    hexutil.Uint64 `json:"index"`
+	Proof    []common.Hash  `json:"proof"`
+}
+
+// GetRootHashProof returns the merkle proof of the inclusion of the block header
+// in the root hash of the start to end block headers. The leaf is derived from
+// the header, and leads to the root hash with the sibling hashes of the proof.
+func (api *API) GetRootHashProof(start uint64, end uint64, number uint64) (*RootHashProof, error) {
+	if err := api.initializeRootHashCache(); err != nil {
+		return nil, err
+	}
+
+	if number < start || number > end {
+		return nil, errBlockOutOfCheckpoint
+	}
+
+	key := getRootHashKey(start, end)
+
+	var leaves [][32]byte
+
+	if cached, known := api.rootHashCache.Get(rootHashLeavesPrefix + key); known {
+		leaves = cached.([][32]byte)
+	} else {
+		blockHeaders, err := api.getRootHashHeaders(start, end)
+		if err != nil {
+			return nil, err
+		}
+
+		leaves = rootHashLeaves(blockHeaders)
+		api.rootHashCache.Add(rootHashLeavesPrefix+key, leaves)
+	}
+
+	header := api.chain.GetHeaderByNumber(number)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+
+	// The header might have been reorged since the leaves were cached
+	index := number - start
+	if leaves[index] != RootHashLeaf(header) {
+		return nil, errUnknownBlock
+	}
+
+	root, proof, err := rootHashProof(leaves, index)
+	if err != nil {
+		return nil, err
+	}
+
+	api.rootHashCache.Add(key, hex.EncodeToString(root[:]))
+
+	return &RootHashProof{
+		RootHash: root,
+		Header:   header,
+		Leaf:     leaves[index],
+		Index:    hexutil.Uint64(index),
+		Proof:    proof,
+	}, nil
+}
+
+// GetMilestoneProof returns the headers from the block up to the end block of a
+// milestone, each header being the parent of the next one. Hashing the headers
+// proves the block is an ancestor of the end block hash of the milestone.
+func (api *API) GetMilestoneProof(number uint64, end uint64) ([]*types.Header, error) {
+	if number > end {
+		return nil, &valset.InvalidStartEndBlockError{Start: number, End: end, CurrentHeader: api.chain.CurrentHeader().Number.Uint64()}
+	}
+
+	if end-number+1 > MaxCheckpointLength {
+		return nil, &MaxCheckpointLengthExceededError{number, end}
+	}
+
+	headers := make([]*types.Header, 0, end-number+1)
+
+	header := api.chain.GetHeaderByNumber(end)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+
+	headers = append(headers, header)
+
+	for header.Number.Uint64() > number {
+		header = api.chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+		if header == nil {
+			return nil, errUnknownBlock
+		}
+
+		headers = append(headers, header)
+	}
+
+	slices.Reverse(headers)
+
+	return headers, nil
+}
+
+// getRootHashHeaders retrieves the start to end block headers of a checkpoint.
+func (api *API) getRootHashHeaders(start uint64, end uint64) ([]*types.Header, error) {
 	length := end - start + 1
 
 	if length > MaxCheckpointLength {
-		return "", &MaxCheckpointLengthExceededError{start, end}
+		return nil, &MaxCheckpointLengthExceededError{start, end}
 	}
 
 	currentHeaderNumber := api.chain.CurrentHeader().Number.Uint64()
 
 	if start > end || end > currentHeaderNumber {
-		return "", &valset.InvalidStartEndBlockError{Start: start, End: end, CurrentHeader: currentHeaderNumber}
+		return nil, &valset.InvalidStartEndBlockError{Start: start, End: end, CurrentHeader: currentHeaderNumber}
 	}
 
 	blockHeaders := make([]*types.Header, end-start+1)
@@ -313,36 +434,14 @@ func (api *API) GetRootHash(start uint64, end uint64) (string, error) {
 	wg.Wait()
 	close(concurrent)
 
-	headers := make([][32]byte, nextPowerOfTwo(length))
-
-	for i := 0; i < len(blockHeaders); i++ {
-		blockHeader := blockHeaders[i]
-		// Handle no header case, which is possible if ancient pruning was done
+	// Handle no header case, which is possible if ancient pruning was done
+	for _, blockHeader := range blockHeaders {
 		if blockHeader == nil {
-			return "", errUnknownBlock
+			return nil, errUnknownBlock
 		}
-		header := crypto.Keccak256(appendBytes32(
-			blockHeader.Number.Bytes(),
-			new(big.Int).SetUint64(blockHeader.Time).Bytes(),
-			blockHeader.TxHash.Bytes(),
-			blockHeader.ReceiptHash.Bytes(),
-		))
-
-		var arr [32]byte
-
-		copy(arr[:], header)
-		headers[i] = arr
-	}
-
-	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
-	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
-		return "", err
 	}
 
-	root := hex.EncodeToString(tree.Root().Hash)
-	api.rootHashCache.Add(key, root)
-
-	return root, nil
+	return blockHeaders, nil
 }
 
 func (api *API) initializeRootHashCache() error {
@@ -354,6 +453,10 @@ func (api *API) initializeRootHashCache() error {
 	return err
 }
 
+// rootHashLeavesPrefix prefixes the keys of the leaves of a checkpoint in the
+// root hash cache.
+const rootHashLeavesPrefix = "leaves-"
+
 func getRootHashKey(start uint64, end uint64) string {
 	return strconv.FormatUint(start, 10) + "-" + strconv.FormatUint(end, 10)
 }
@@ -475,24 +475,9 @@ func (c *Bor) verifyCascadingFields(chain consensus.ChainHeaderReader, header *t
 			return err
 		}
 
-		sort.Sort(valset.ValidatorsByAddress(newValidators))
-
-		headerVals, err := valset.ParseValidators(header.GetValidatorBytes(c.chainConfig))
-		if err != nil {
+		if err := verifyHeaderValidators(c.chainConfig, header, newValidators); err != nil {
 			return err
 		}
-
-		if len(newValidators) != len(headerVals) {
-			log.Warn("Invalid validator set", "block number", number, "newValidators", newValidators, "headerVals", headerVals)
-			return errInvalidSpanValidators
-		}
-
-		for i, val := range newValidators {
-			if !bytes.Equal(val.HeaderBytes(), headerVals[i].HeaderBytes()) {
-				log.Warn("Invalid validator set", "block number", number, "index", i, "local validator", val, "header validator", headerVals[i])
-				return errInvalidSpanValidators
-			}
-		}
 	}
 
 	// verify the validator list in the last sprint block
@@ -709,6 +694,33 @@ func (c *Bor) verifySeal(chain consensus.ChainHeaderReader, header *types.Header
 	return nil
 }
 
+// verifyHeaderValidators checks that the validator set announced by the header
+// ending a sprint matches the validators of the next sprint.
+func verifyHeaderValidators(chainConfig *params.ChainConfig, header *types.Header, newValidators []*valset.Validator) error {
+	number := header.Number.Uint64()
+
+	sort.Sort(valset.ValidatorsByAddress(newValidators))
+
+	headerVals, err := valset.ParseValidators(header.GetValidatorBytes(chainConfig))
+	if err != nil {
+		return err
+	}
+
+	if len(newValidators) != len(headerVals) {
+		log.Warn("Invalid validator set", "block number", number, "newValidators", newValidators, "headerVals", headerVals)
+		return errInvalidSpanValidators
+	}
+
+	for i, val := range newValidators {
+		if !bytes.Equal(val.HeaderBytes(), headerVals[i].HeaderBytes()) {
+			log.Warn("Invalid validator set", "block number", number, "index", i, "local validator", val, "header validator", headerVals[i])
+			return errInvalidSpanValidators
+		}
+	}
+
+	return nil
+}
+
 func IsBlockOnTime(parent *types.Header, header *types.Header, number uint64, succession int, cfg *params.BorConfig) bool {
 	return parent != nil && header.Time < parent.Time+CalcProducerDelay(number, succession, cfg)
 }
@@ -820,34 +832,15 @@ func (c *Bor) Prepare(chain consensus.ChainHeaderReader, header *types.Header) e
 // Finalize implements consensus.Engine, ensuring no uncles are set, nor block
 // rewards given.
 func (c *Bor) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, body *types.Body) {
-	var (
-		stateSyncData []*types.StateSyncData
-		err           error
-	)
-
 	headerNumber := header.Number.Uint64()
 
 	if body.Withdrawals != nil || header.WithdrawalsHash != nil {
 		return
 	}
 
-	if IsSprintStart(headerNumber, c.config.CalculateSprint(headerNumber)) {
-		ctx := context.Background()
-		cx := statefull.ChainContext{Chain: chain, Bor: c}
-		// check and commit span
-		if err := c.checkAndCommitSpan(ctx, state, header, cx); err != nil {
-			log.Error("Error while committing span", "error", err)
-			return
-		}
-
-		if c.HeimdallClient != nil {
-			// commit states
-			stateSyncData, err = c.CommitStates(ctx, state, header, cx)
-			if err != nil {
-				log.Error("Error while committing states", "error", err)
-				return
-			}
-		}
+	stateSyncData, systemCalls, err := c.CommitSystemCalls(chain, header, state)
+	if err != nil {
+		return
 	}
 
 	if err = c.changeContractCodeIfNeeded(headerNumber, state); err != nil {
@@ -862,6 +855,42 @@ func (c *Bor) Finalize(chain consensus.ChainHeaderReader, header *types.Header,
 	// Set state sync data to blockchain
 	bc := chain.(*core.BlockChain)
 	bc.SetStateSync(stateSyncData)
+	bc.SetSystemCalls(systemCalls)
+}
+
+// CommitSystemCalls applies the system calls of the block on the state, which
+// commit the span and the state syncs at the start of a sprint, and returns the
+// committed state syncs along with the system calls applied. Unlike Finalize,
+// it leaves the header and the chain untouched.
+func (c *Bor) CommitSystemCalls(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) ([]*types.StateSyncData, []types.SystemCall, error) {
+	headerNumber := header.Number.Uint64()
+
+	if !IsSprintStart(headerNumber, c.config.CalculateSprint(headerNumber)) {
+		return nil, nil, nil
+	}
+
+	var systemCalls []types.SystemCall
+
+	ctx := context.Background()
+	cx := statefull.ChainContext{Chain: chain, Bor: c, SystemCalls: &systemCalls}
+	// check and commit span
+	if err := c.checkAndCommitSpan(ctx, state, header, cx); err != nil {
+		log.Error("Error while committing span", "error", err)
+		return nil, nil, err
+	}
+
+	if c.HeimdallClient == nil {
+		return nil, systemCalls, nil
+	}
+
+	// commit states
+	stateSyncData, err := c.CommitStates(ctx, state, header, cx)
+	if err != nil {
+		log.Error("Error while committing states", "error", err)
+		return nil, nil, err
+	}
+
+	return stateSyncData, systemCalls, nil
 }
 
 func decodeGenesisAlloc(i interface{}) (types.GenesisAlloc, error) {
@@ -916,10 +945,13 @@ func (c *Bor) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *typ
 
 	stateSyncData := []*types.StateSyncData{}
 
-	var err error
+	var (
+		systemCalls []types.SystemCall
+		err         error
+	)
 
 	if IsSprintStart(headerNumber, c.config.CalculateSprint(headerNumber)) {
-		cx := statefull.ChainContext{Chain: chain, Bor: c}
+		cx := statefull.ChainContext{Chain: chain, Bor: c, SystemCalls: &systemCalls}
 
 		tracing.Exec(finalizeCtx, "", "bor.checkAndCommitSpan", func(ctx context.Context, span trace.Span) {
 			// check and commit span
@@ -967,6 +999,7 @@ func (c *Bor) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *typ
 	// set state sync
 	bc := chain.(core.BorStateSyncer)
 	bc.SetStateSync(stateSyncData)
+	bc.SetSystemCalls(systemCalls)
 
 	tracing.SetAttributes(
 		finalizeSpan,
@@ -1243,19 +1276,17 @@ func (c *Bor) CommitStates(
 	if c.config.IsIndore(header.Number) {
 		// Fetch the LastStateId from contract via current state instance
 		lastStateIDBig, err = c.GenesisContractsClient.LastStateId(state.Copy(), number-1, header.ParentHash)
-		if err != nil {
-			return nil, err
-		}
-
-		stateSyncDelay := c.config.CalculateStateSyncDelay(number)
-		to = time.Unix(int64(header.Time-stateSyncDelay), 0)
 	} else {
 		lastStateIDBig, err = c.GenesisContractsClient.LastStateId(nil, number-1, header.ParentHash)
-		if err != nil {
-			return nil, err
-		}
+	}
 
-		to = time.Unix(int64(chain.Chain.GetHeaderByNumber(number-c.config.CalculateSprint(number)).Time), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err = c.stateSyncTo(chain.Chain, header)
+	if err != nil {
+		return nil, err
 	}
 
 	lastStateID := lastStateIDBig.Uint64()
@@ -1324,6 +1355,89 @@ func (c *Bor) CommitStates(
 	return stateSyncs, nil
 }
 
+// stateSyncTo returns the end of the time window of the state syncs committed
+// by the sprint start block with the given header.
+func (c *Bor) stateSyncTo(chain consensus.ChainHeaderReader, header *types.Header) (time.Time, error) {
+	number := header.Number.Uint64()
+
+	if c.config.IsIndore(header.Number) {
+		stateSyncDelay := c.config.CalculateStateSyncDelay(number)
+		return time.Unix(int64(header.Time-stateSyncDelay), 0), nil
+	}
+
+	previous := chain.GetHeaderByNumber(number - c.config.CalculateSprint(number))
+	if previous == nil {
+		return time.Time{}, consensus.ErrUnknownAncestor
+	}
+
+	return time.Unix(int64(previous.Time), 0), nil
+}
+
+// CommittedStateSyncs retrieves from heimdall the ids of the state syncs committed
+// by the sprint start blocks with the given headers, in ascending order, given
+// the id of the last state sync committed before them. The state syncs are
+// selected like CommitStates does, without executing the blocks.
+func (c *Bor) CommittedStateSyncs(ctx context.Context, chain consensus.ChainHeaderReader, headers []*types.Header, lastStateID uint64) ([][]uint64, error) {
+	if c.HeimdallClient == nil {
+		return nil, errors.New("no heimdall client")
+	}
+
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	tos := make([]time.Time, len(headers))
+
+	for i, header := range headers {
+		to, err := c.stateSyncTo(chain, header)
+		if err != nil {
+			return nil, err
+		}
+
+		tos[i] = to
+	}
+
+	// The windows of the blocks follow each other, the state syncs of all of them
+	// are fetched at once
+	eventRecords, err := c.HeimdallClient.StateSyncEvents(ctx, lastStateID+1, tos[len(tos)-1].Unix())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		chainID    = c.chainConfig.ChainID.String()
+		stateSyncs = make([][]uint64, len(headers))
+	)
+
+	for i, header := range headers {
+		number := header.Number.Uint64()
+
+		for len(eventRecords) > 0 && eventRecords[0].ID <= lastStateID {
+			eventRecords = eventRecords[1:]
+		}
+
+		pending := eventRecords
+
+		if c.config.OverrideStateSyncRecords != nil {
+			if val, ok := c.config.OverrideStateSyncRecords[strconv.FormatUint(number, 10)]; ok && val < len(pending) {
+				pending = pending[:val]
+			}
+		}
+
+		for _, eventRecord := range pending {
+			if validateEventRecord(eventRecord, number, tos[i], lastStateID, chainID) != nil {
+				break
+			}
+
+			stateSyncs[i] = append(stateSyncs[i], eventRecord.ID)
+
+			lastStateID++
+		}
+	}
+
+	return stateSyncs, nil
+}
+
 func validateEventRecord(eventRecord *clerk.EventRecordWithTime, number uint64, to time.Time, lastStateID uint64, chainID string) error {
 	// event id should be sequential and event.Time should lie in the range [from, to)
 	if lastStateID+1 != eventRecord.ID || eventRecord.ChainID != chainID || !eventRecord.Time.Before(to) {
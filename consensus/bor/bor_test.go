@@ -1,14 +1,18 @@
 package bor
 
 import (
+	"context"
+	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/holiman/uint256"
 	"github.com/stretchr/testify/require"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil" //nolint:typecheck
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -162,3 +166,79 @@ func TestEncodeSigHeaderJaipur(t *testing.T) {
 	hash = SealHash(h, &params.BorConfig{JaipurBlock: big.NewInt(10)})
 	require.Equal(t, hash, hashWithoutBaseFee)
 }
+
+// stateSyncsHeimdall is a heimdall client serving state sync events.
+type stateSyncsHeimdall struct {
+	IHeimdallClient
+
+	events []*clerk.EventRecordWithTime
+}
+
+func (h *stateSyncsHeimdall) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	var events []*clerk.EventRecordWithTime
+
+	for _, event := range h.events {
+		if event.ID >= fromID && event.Time.Unix() < to {
+			events = append(events, event)
+		}
+	}
+
+	return events, nil
+}
+
+func TestCommittedStateSyncs(t *testing.T) {
+	t.Parallel()
+
+	event := func(id uint64, chainID string, at int64) *clerk.EventRecordWithTime {
+		return &clerk.EventRecordWithTime{EventRecord: clerk.EventRecord{ID: id, ChainID: chainID}, Time: time.Unix(at, 0)}
+	}
+
+	heimdall := &stateSyncsHeimdall{events: []*clerk.EventRecordWithTime{
+		event(4, "1", 10), event(5, "1", 20), event(6, "1", 90), event(7, "1", 95),
+		event(8, "2", 100), event(9, "1", 110), event(10, "1", 200),
+	}}
+
+	b := &Bor{
+		config: &params.BorConfig{
+			Sprint:                     map[string]uint64{"0": 4},
+			IndoreBlock:                common.Big0,
+			StateSyncConfirmationDelay: map[string]uint64{"0": 10},
+		},
+		chainConfig:    &params.ChainConfig{ChainID: big.NewInt(1)},
+		HeimdallClient: heimdall,
+	}
+
+	headers := []*types.Header{
+		{Number: big.NewInt(4), Time: 50},
+		{Number: big.NewInt(8), Time: 60},
+		{Number: big.NewInt(12), Time: 150},
+		{Number: big.NewInt(16), Time: 300},
+	}
+
+	// The state syncs follow the last one committed, stop at the end of the window
+	// of the blocks and at the first invalid event
+	stateSyncs, err := b.CommittedStateSyncs(context.Background(), nil, headers, 3)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{4, 5}, nil, {6, 7}, nil}, stateSyncs)
+
+	// The overridden blocks commit fewer state syncs
+	b.config.OverrideStateSyncRecords = map[string]int{"4": 1}
+
+	stateSyncs, err = b.CommittedStateSyncs(context.Background(), nil, headers, 3)
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{4}, {5}, {6, 7}, nil}, stateSyncs)
+}
+
+func TestIsSealError(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsSealError(&UnauthorizedSignerError{Number: 1, Signer: common.Address{1}.Bytes()}))
+	require.True(t, IsSealError(fmt.Errorf("verify: %w", &WrongDifficultyError{Number: 1, Expected: 2, Actual: 1})))
+	require.True(t, IsSealError(&UnauthorizedProposerError{Number: 1, Proposer: common.Address{1}.Bytes()}))
+	require.True(t, IsSealError(errMissingSignature))
+
+	// The failures not caused by the signer of the header aren't seal errors
+	require.False(t, IsSealError(&BlockTooSoonError{Number: 1, Succession: 1}))
+	require.False(t, IsSealError(errUnknownBlock))
+	require.False(t, IsSealError(nil))
+}
@@ -1,6 +1,7 @@
 package bor
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -114,3 +115,17 @@ func (e *InvalidStateReceivedError) Error() string {
 		e.LastStateID,
 	)
 }
+
+// IsSealError reports whether the error is returned by the verification of the
+// seal of a header, meaning the header was forged or signed out of turn or by a
+// signer outside of the producer set.
+func IsSealError(err error) bool {
+	var (
+		signerErr     *UnauthorizedSignerError
+		proposerErr   *UnauthorizedProposerError
+		difficultyErr *WrongDifficultyError
+	)
+
+	return errors.As(err, &signerErr) || errors.As(err, &proposerErr) || errors.As(err, &difficultyErr) ||
+		errors.Is(err, errMissingSignature)
+}
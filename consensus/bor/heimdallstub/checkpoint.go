@@ -0,0 +1,92 @@
+package heimdallstub
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func (h *HeimdallStubClient) FetchCheckpointCount(ctx context.Context) (int64, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if err := h.proposeCheckpoints(ctx); err != nil {
+		return 0, err
+	}
+
+	return int64(len(h.checkpoints)), nil
+}
+
+// FetchCheckpoint returns the checkpoint number (starting from 1), or the
+// latest checkpoint if number is -1
+func (h *HeimdallStubClient) FetchCheckpoint(ctx context.Context, number int64) (*checkpoint.Checkpoint, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if err := h.proposeCheckpoints(ctx); err != nil {
+		return nil, err
+	}
+
+	if number == -1 {
+		number = int64(len(h.checkpoints))
+	}
+
+	if number < 1 || number > int64(len(h.checkpoints)) {
+		return nil, heimdall.ErrNoResponse
+	}
+
+	checkpoint := *h.checkpoints[number-1]
+
+	return &checkpoint, nil
+}
+
+// proposeCheckpoints adds the checkpoints of checkpointLength blocks which
+// are milestoneConfirmations blocks behind the head of the backend
+func (h *HeimdallStubClient) proposeCheckpoints(ctx context.Context) error {
+	if h.backend == nil {
+		return nil
+	}
+
+	head := h.backend.CurrentHeader().Number.Uint64()
+
+	for {
+		var start uint64
+
+		if len(h.checkpoints) > 0 {
+			start = h.checkpoints[len(h.checkpoints)-1].EndBlock.Uint64() + 1
+		}
+
+		end := start + checkpointLength - 1
+		if end+milestoneConfirmations > head {
+			return nil
+		}
+
+		rootHash, err := h.backend.GetRootHash(ctx, start, end)
+		if err != nil {
+			return err
+		}
+
+		header, err := h.backend.HeaderByNumber(ctx, rpc.BlockNumber(end))
+		if err != nil {
+			return err
+		}
+
+		if header == nil {
+			return fmt.Errorf("header %d not found", end)
+		}
+
+		h.checkpoints = append(h.checkpoints, &checkpoint.Checkpoint{
+			Proposer:   h.validators[0].Address,
+			StartBlock: new(big.Int).SetUint64(start),
+			EndBlock:   new(big.Int).SetUint64(end),
+			RootHash:   common.HexToHash(rootHash),
+			BorChainID: h.chainID,
+			Timestamp:  header.Time,
+		})
+	}
+}
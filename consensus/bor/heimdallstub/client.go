@@ -0,0 +1,118 @@
+package heimdallstub
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// firstSpanEndBlock is the last block of span 0, the span stored in the genesis
+	firstSpanEndBlock = 255
+
+	// milestoneConfirmations is the number of blocks a milestone ends before the head
+	milestoneConfirmations = 16
+
+	// checkpointLength is the number of blocks of a checkpoint
+	checkpointLength = 64
+)
+
+// Backend is the chain the milestones and checkpoints are proposed for
+type Backend interface {
+	CurrentHeader() *types.Header
+	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
+	GetRootHash(ctx context.Context, start uint64, end uint64) (string, error)
+}
+
+// StateSync is a state sync event sent to the chain
+type StateSync struct {
+	Contract common.Address
+	Data     []byte
+	Time     time.Time
+}
+
+// Config is the configuration of the stub
+type Config struct {
+	// ChainID is the bor chain id
+	ChainID string
+
+	// Validators are the validators of every span
+	Validators []*valset.Validator
+
+	// SpanLength is the number of blocks of the spans after span 0
+	SpanLength uint64
+
+	// Producers are the indexes in Validators of the producers of a span. All
+	// validators produce blocks in the spans not listed.
+	Producers map[uint64][]int
+
+	// StateSyncs are the state sync events, their ids are assigned in order
+	StateSyncs []StateSync
+}
+
+// HeimdallStubClient is an in-process heimdall serving the spans, state sync
+// events, milestones and checkpoints of a devnet
+type HeimdallStubClient struct {
+	chainID    string
+	validators []*valset.Validator
+	spanLength uint64
+	producers  map[uint64][]int
+	events     []*clerk.EventRecordWithTime
+
+	lock        sync.Mutex
+	backend     Backend
+	milestones  []*milestone.Milestone
+	checkpoints []*checkpoint.Checkpoint
+}
+
+func NewHeimdallStubClient(config *Config) *HeimdallStubClient {
+	events := make([]*clerk.EventRecordWithTime, 0, len(config.StateSyncs))
+
+	for i, stateSync := range config.StateSyncs {
+		id := uint64(i + 1)
+
+		events = append(events, &clerk.EventRecordWithTime{
+			EventRecord: clerk.EventRecord{
+				ID:       id,
+				Contract: stateSync.Contract,
+				Data:     common.CopyBytes(stateSync.Data),
+				TxHash:   crypto.Keccak256Hash(new(big.Int).SetUint64(id).Bytes()),
+				ChainID:  config.ChainID,
+			},
+			Time: stateSync.Time,
+		})
+	}
+
+	return &HeimdallStubClient{
+		chainID:    config.ChainID,
+		validators: config.Validators,
+		spanLength: config.SpanLength,
+		producers:  config.Producers,
+		events:     events,
+	}
+}
+
+// SetBackend sets the chain the milestones and checkpoints are proposed for.
+// No milestone or checkpoint is served until it is set.
+func (h *HeimdallStubClient) SetBackend(backend Backend) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.backend = backend
+}
+
+func (h *HeimdallStubClient) Close() {
+	// Nothing to close, the stub is shared by the validators of the devnet
+	log.Debug("Shutdown detected, Closing Heimdall stub client")
+}
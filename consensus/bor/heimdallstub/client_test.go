@@ -0,0 +1,191 @@
+package heimdallstub
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stretchr/testify/require"
+)
+
+var _ bor.IHeimdallClient = (*HeimdallStubClient)(nil)
+
+// fakeBackend is a chain of empty headers
+type fakeBackend struct {
+	head uint64
+}
+
+func (b *fakeBackend) header(number uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Time: number}
+}
+
+func (b *fakeBackend) CurrentHeader() *types.Header {
+	return b.header(b.head)
+}
+
+func (b *fakeBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if number < 0 || uint64(number) > b.head {
+		return nil, nil
+	}
+
+	return b.header(uint64(number)), nil
+}
+
+func (b *fakeBackend) GetRootHash(ctx context.Context, start uint64, end uint64) (string, error) {
+	return common.BigToHash(new(big.Int).SetUint64(end)).Hex(), nil
+}
+
+func newTestClient(stateSyncs ...StateSync) *HeimdallStubClient {
+	validators := make([]*valset.Validator, 3)
+	for i := range validators {
+		validators[i] = valset.NewValidator(common.BigToAddress(big.NewInt(int64(i+1))), 10)
+	}
+
+	return NewHeimdallStubClient(&Config{
+		ChainID:    "1337",
+		Validators: validators,
+		SpanLength: 128,
+		Producers:  map[uint64][]int{2: {1, 2}, 3: {5}},
+		StateSyncs: stateSyncs,
+	})
+}
+
+func TestSpan(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient()
+
+	span, err := client.Span(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), span.StartBlock)
+	require.Equal(t, uint64(383), span.EndBlock)
+	require.Equal(t, "1337", span.ChainID)
+	require.Len(t, span.ValidatorSet.Validators, 3)
+	require.Len(t, span.SelectedProducers, 3)
+
+	span, err = client.Span(context.Background(), 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(384), span.StartBlock)
+	require.Equal(t, uint64(511), span.EndBlock)
+	require.Len(t, span.ValidatorSet.Validators, 3)
+	require.Len(t, span.SelectedProducers, 2)
+	require.Equal(t, client.validators[1].Address, span.SelectedProducers[0].Address)
+	require.Equal(t, client.validators[2].Address, span.SelectedProducers[1].Address)
+
+	_, err = client.Span(context.Background(), 3)
+	require.Error(t, err)
+
+	start, end := client.SpanBlocks(0)
+	require.Equal(t, uint64(0), start)
+	require.Equal(t, uint64(255), end)
+}
+
+func TestStateSyncEvents(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	contract := common.HexToAddress("0x1234")
+
+	client := newTestClient(
+		StateSync{Contract: contract, Data: []byte{1}, Time: now},
+		StateSync{Contract: contract, Data: []byte{2}, Time: now.Add(10 * time.Second)},
+		StateSync{Contract: contract, Data: []byte{3}, Time: now.Add(20 * time.Second)},
+	)
+
+	events, err := client.StateSyncEvents(context.Background(), 1, now.Unix()+15)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(1), events[0].ID)
+	require.Equal(t, uint64(2), events[1].ID)
+	require.Equal(t, contract, events[1].Contract)
+	require.Equal(t, []byte{2}, []byte(events[1].Data))
+	require.Equal(t, "1337", events[1].ChainID)
+
+	events, err = client.StateSyncEvents(context.Background(), 2, now.Unix()+30)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(2), events[0].ID)
+	require.Equal(t, uint64(3), events[1].ID)
+
+	events, err = client.StateSyncEvents(context.Background(), 1, now.Unix())
+	require.NoError(t, err)
+	require.Empty(t, events)
+}
+
+func TestMilestones(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient()
+
+	_, err := client.FetchMilestone(context.Background())
+	require.True(t, errors.Is(err, heimdall.ErrNoResponse))
+
+	backend := &fakeBackend{head: 10}
+	client.SetBackend(backend)
+
+	count, err := client.FetchMilestoneCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(0), count)
+
+	backend.head = 40
+
+	milestone, err := client.FetchMilestone(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), milestone.StartBlock.Uint64())
+	require.Equal(t, uint64(24), milestone.EndBlock.Uint64())
+	require.Equal(t, backend.header(24).Hash(), milestone.Hash)
+
+	backend.head = 50
+
+	milestone, err = client.FetchMilestone(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(25), milestone.StartBlock.Uint64())
+	require.Equal(t, uint64(34), milestone.EndBlock.Uint64())
+
+	count, err = client.FetchMilestoneCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	err = client.FetchNoAckMilestone(context.Background(), "id")
+	require.True(t, errors.Is(err, heimdall.ErrNotInRejectedList))
+}
+
+func TestCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient()
+	backend := &fakeBackend{head: 100}
+	client.SetBackend(backend)
+
+	count, err := client.FetchCheckpointCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+
+	backend.head = 200
+
+	count, err = client.FetchCheckpointCount(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+
+	checkpoint, err := client.FetchCheckpoint(context.Background(), -1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(64), checkpoint.StartBlock.Uint64())
+	require.Equal(t, uint64(127), checkpoint.EndBlock.Uint64())
+	require.Equal(t, common.BigToHash(big.NewInt(127)), checkpoint.RootHash)
+
+	checkpoint, err = client.FetchCheckpoint(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(63), checkpoint.EndBlock.Uint64())
+
+	_, err = client.FetchCheckpoint(context.Background(), 3)
+	require.True(t, errors.Is(err, heimdall.ErrNoResponse))
+}
@@ -0,0 +1,97 @@
+package heimdallstub
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+func (h *HeimdallStubClient) FetchMilestoneCount(ctx context.Context) (int64, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if err := h.proposeMilestone(ctx); err != nil {
+		return 0, err
+	}
+
+	return int64(len(h.milestones)), nil
+}
+
+func (h *HeimdallStubClient) FetchMilestone(ctx context.Context) (*milestone.Milestone, error) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if err := h.proposeMilestone(ctx); err != nil {
+		return nil, err
+	}
+
+	if len(h.milestones) == 0 {
+		return nil, heimdall.ErrNoResponse
+	}
+
+	milestone := *h.milestones[len(h.milestones)-1]
+
+	return &milestone, nil
+}
+
+// FetchLastNoAckMilestone returns an empty id, the milestones of the stub are
+// never rejected
+func (h *HeimdallStubClient) FetchLastNoAckMilestone(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (h *HeimdallStubClient) FetchNoAckMilestone(ctx context.Context, milestoneID string) error {
+	return fmt.Errorf("%w: milestoneID %q", heimdall.ErrNotInRejectedList, milestoneID)
+}
+
+func (h *HeimdallStubClient) FetchMilestoneID(ctx context.Context, milestoneID string) error {
+	return fmt.Errorf("%w: milestoneID %q", heimdall.ErrNotInMilestoneList, milestoneID)
+}
+
+// proposeMilestone adds a milestone ending milestoneConfirmations blocks
+// before the head of the backend if the chain advanced since the last one
+func (h *HeimdallStubClient) proposeMilestone(ctx context.Context) error {
+	if h.backend == nil {
+		return nil
+	}
+
+	head := h.backend.CurrentHeader().Number.Uint64()
+	if head < milestoneConfirmations+1 {
+		return nil
+	}
+
+	var start uint64
+
+	if len(h.milestones) > 0 {
+		start = h.milestones[len(h.milestones)-1].EndBlock.Uint64() + 1
+	}
+
+	end := head - milestoneConfirmations
+	if end < start {
+		return nil
+	}
+
+	header, err := h.backend.HeaderByNumber(ctx, rpc.BlockNumber(end))
+	if err != nil {
+		return err
+	}
+
+	if header == nil {
+		return fmt.Errorf("header %d not found", end)
+	}
+
+	h.milestones = append(h.milestones, &milestone.Milestone{
+		Proposer:   h.validators[0].Address,
+		StartBlock: new(big.Int).SetUint64(start),
+		EndBlock:   new(big.Int).SetUint64(end),
+		Hash:       header.Hash(),
+		BorChainID: h.chainID,
+		Timestamp:  header.Time,
+	})
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package heimdallstub
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+func (h *HeimdallStubClient) Span(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error) {
+	log.Info("Fetching span", "spanID", spanID)
+
+	start, end := h.SpanBlocks(spanID)
+
+	validators := make([]*valset.Validator, 0, len(h.validators))
+	for _, validator := range h.validators {
+		validators = append(validators, validator.Copy())
+	}
+
+	producers := make([]valset.Validator, 0, len(h.validators))
+
+	if indexes, ok := h.producers[spanID]; ok {
+		for _, index := range indexes {
+			if index < 0 || index >= len(h.validators) {
+				return nil, fmt.Errorf("invalid producer %d of span %d", index, spanID)
+			}
+
+			producers = append(producers, *h.validators[index].Copy())
+		}
+	} else {
+		for _, validator := range h.validators {
+			producers = append(producers, *validator.Copy())
+		}
+	}
+
+	return &span.HeimdallSpan{
+		Span: span.Span{
+			ID:         spanID,
+			StartBlock: start,
+			EndBlock:   end,
+		},
+		ValidatorSet:      *valset.NewValidatorSet(validators),
+		SelectedProducers: producers,
+		ChainID:           h.chainID,
+	}, nil
+}
+
+// SpanBlocks returns the first and last block of the span
+func (h *HeimdallStubClient) SpanBlocks(spanID uint64) (uint64, uint64) {
+	if spanID == 0 {
+		return 0, firstSpanEndBlock
+	}
+
+	start := firstSpanEndBlock + 1 + (spanID-1)*h.spanLength
+
+	return start, start + h.spanLength - 1
+}
@@ -0,0 +1,22 @@
+package heimdallstub
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+)
+
+func (h *HeimdallStubClient) StateSyncEvents(ctx context.Context, fromID uint64, to int64) ([]*clerk.EventRecordWithTime, error) {
+	eventRecords := make([]*clerk.EventRecordWithTime, 0)
+
+	for _, event := range h.events {
+		if event.ID < fromID || event.Time.Unix() >= to {
+			continue
+		}
+
+		eventRecord := *event
+		eventRecords = append(eventRecords, &eventRecord)
+	}
+
+	return eventRecords, nil
+}
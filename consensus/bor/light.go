@@ -0,0 +1,146 @@
+package bor
+
+import (
+	"encoding/json"
+	"errors"
+
+	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// errEmptyValidatorSet is returned if a snapshot trusted by a light client holds
+// no validators.
+var errEmptyValidatorSet = errors.New("snapshot without validators")
+
+// SpanProducersFn retrieves the producers of the span covering a block, against
+// which the validator sets announced by the headers are checked.
+type SpanProducersFn func(number uint64) ([]*valset.Validator, error)
+
+// NewLightSnapshot decodes a snapshot served by a full node through the
+// bor_getSnapshotAtHash API, as the starting point of a light client.
+func NewLightSnapshot(chainConfig *params.ChainConfig, blob []byte) (*Snapshot, error) {
+	snap := new(Snapshot)
+
+	if err := json.Unmarshal(blob, snap); err != nil {
+		return nil, err
+	}
+
+	if snap.ValidatorSet == nil || len(snap.ValidatorSet.Validators) == 0 {
+		return nil, errEmptyValidatorSet
+	}
+
+	if snap.Recents == nil {
+		snap.Recents = make(map[uint64]common.Address)
+	}
+
+	snap.ValidatorSet.UpdateValidatorMap()
+
+	if err := snap.ValidatorSet.UpdateTotalVotingPower(); err != nil {
+		return nil, err
+	}
+
+	sigcache, _ := lru.NewARC(inmemorySignatures)
+
+	snap.chainConfig = chainConfig
+	snap.sigcache = sigcache
+
+	return snap, nil
+}
+
+// ApplyLight verifies the headers following the snapshot without the state, and
+// returns the snapshot at the last of them. On top of the seals, the difficulty
+// and the timing of the headers, the validator sets announced at the end of the
+// sprints are checked against the span producers, in place of the validator set
+// contract. The parent is the header of the snapshot, the timing of the first
+// header isn't checked if it's nil.
+func (s *Snapshot) ApplyLight(parent *types.Header, headers []*types.Header, producers SpanProducersFn) (*Snapshot, error) {
+	snap := s
+
+	for _, header := range headers {
+		number := header.Number.Uint64()
+		if number != snap.Number+1 || header.ParentHash != snap.Hash {
+			return nil, errOutOfRangeChain
+		}
+
+		if err := validateHeaderExtraField(header.Extra); err != nil {
+			return nil, err
+		}
+
+		signer, err := ecrecover(header, snap.sigcache, snap.chainConfig.Bor)
+		if err != nil {
+			return nil, err
+		}
+
+		if !snap.ValidatorSet.HasAddress(signer) {
+			// Check the UnauthorizedSignerError.Error() msg to see why we pass number-1
+			return nil, &UnauthorizedSignerError{number - 1, signer.Bytes()}
+		}
+
+		succession, err := snap.GetSignerSuccessionNumber(signer)
+		if err != nil {
+			return nil, err
+		}
+
+		if IsBlockOnTime(parent, header, number, succession, snap.chainConfig.Bor) {
+			return nil, &BlockTooSoonError{number, succession}
+		}
+
+		if header.Difficulty == nil {
+			return nil, errInvalidDifficulty
+		}
+
+		if difficulty := Difficulty(snap.ValidatorSet, signer); header.Difficulty.Uint64() != difficulty {
+			return nil, &WrongDifficultyError{number, difficulty, header.Difficulty.Uint64(), signer.Bytes()}
+		}
+
+		if IsSprintStart(number+1, snap.chainConfig.Bor.CalculateSprint(number)) {
+			newValidators, err := producers(number + 1)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := verifyHeaderValidators(snap.chainConfig, header, newValidators); err != nil {
+				return nil, err
+			}
+		}
+
+		if snap, err = snap.apply([]*types.Header{header}); err != nil {
+			return nil, err
+		}
+
+		parent = header
+	}
+
+	return snap, nil
+}
+
+// VerifySeal checks that the header is signed by one of the producers of the
+// span covering it, which authenticates a header whose ancestors aren't known.
+func VerifySeal(chainConfig *params.ChainConfig, header *types.Header, producers SpanProducersFn) error {
+	sigcache, _ := lru.NewARC(1)
+
+	signer, err := ecrecover(header, sigcache, chainConfig.Bor)
+	if err != nil {
+		return err
+	}
+
+	number := header.Number.Uint64()
+
+	validators, err := producers(number)
+	if err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		if validator.Address == signer {
+			return nil
+		}
+	}
+
+	// Check the UnauthorizedSignerError.Error() msg to see why we pass number-1
+	return &UnauthorizedSignerError{number - 1, signer.Bytes()}
+}
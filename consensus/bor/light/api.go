@@ -0,0 +1,134 @@
+package light
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	errBlockNotFound     = errors.New("block not found")
+	errFullTxUnsupported = errors.New("transactions are not served by the light client")
+)
+
+// API serves the headers verified by the light client under the eth namespace,
+// and the state proofs of the upstream node once verified against them.
+type API struct {
+	client *Client
+}
+
+// NewAPI creates the API of the light client.
+func NewAPI(client *Client) *API {
+	return &API{client: client}
+}
+
+// ChainId returns the chain id of the followed chain.
+func (api *API) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(api.client.ChainConfig().ChainID)
+}
+
+// BlockNumber returns the number of the verified head.
+func (api *API) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(api.client.CurrentHeader().Number.Uint64())
+}
+
+// GetBlockByNumber returns the header fields of the verified block with the
+// number. The transactions aren't known to the light client.
+func (api *API) GetBlockByNumber(ctx context.Context, number rpc.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	if fullTx {
+		return nil, errFullTxUnsupported
+	}
+
+	return api.GetHeaderByNumber(ctx, number)
+}
+
+// GetBlockByHash returns the header fields of the verified block with the hash.
+// The transactions aren't known to the light client.
+func (api *API) GetBlockByHash(ctx context.Context, hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	if fullTx {
+		return nil, errFullTxUnsupported
+	}
+
+	return api.GetHeaderByHash(ctx, hash), nil
+}
+
+// GetHeaderByNumber returns the verified header with the number.
+func (api *API) GetHeaderByNumber(ctx context.Context, number rpc.BlockNumber) (map[string]interface{}, error) {
+	header, err := api.header(rpc.BlockNumberOrHashWithNumber(number))
+	if header == nil || err != nil {
+		return nil, err
+	}
+
+	return ethapi.RPCMarshalHeader(header), nil
+}
+
+// GetHeaderByHash returns the verified header with the hash.
+func (api *API) GetHeaderByHash(ctx context.Context, hash common.Hash) map[string]interface{} {
+	header := api.client.HeaderByHash(hash)
+	if header == nil {
+		return nil
+	}
+
+	return ethapi.RPCMarshalHeader(header)
+}
+
+// GetProof forwards the proof request to the upstream node at the verified
+// header, and returns the proof once verified against the state root.
+func (api *API) GetProof(ctx context.Context, address common.Address, storageKeys []string, blockNrOrHash rpc.BlockNumberOrHash) (*ethapi.AccountResult, error) {
+	header, err := api.header(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if header == nil {
+		return nil, errBlockNotFound
+	}
+
+	if storageKeys == nil {
+		storageKeys = []string{}
+	}
+
+	var res *ethapi.AccountResult
+	if err := api.client.upstream.CallContext(ctx, &res, "eth_getProof", address, storageKeys, rpc.BlockNumberOrHashWithHash(header.Hash(), false)); err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, errInvalidProof
+	}
+
+	if err := verifyAccountResult(header.Root, address, storageKeys, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// header resolves the verified header of a block number or hash, nil if it isn't
+// known.
+func (api *API) header(blockNrOrHash rpc.BlockNumberOrHash) (*types.Header, error) {
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		return api.client.HeaderByHash(hash), nil
+	}
+
+	number, ok := blockNrOrHash.Number()
+	if !ok {
+		return nil, errors.New("invalid arguments; neither block nor hash specified")
+	}
+
+	switch number {
+	case rpc.LatestBlockNumber, rpc.PendingBlockNumber:
+		return api.client.CurrentHeader(), nil
+	case rpc.FinalizedBlockNumber, rpc.SafeBlockNumber:
+		return api.client.FinalizedHeader(), nil
+	case rpc.EarliestBlockNumber:
+		return nil, nil
+	default:
+		return api.client.HeaderByNumber(uint64(number)), nil
+	}
+}
@@ -0,0 +1,403 @@
+// Package light implements a bor light client following the headers of an
+// upstream full node. The headers are verified against the validator sets of the
+// heimdall spans and anchored to the heimdall milestones, without the state.
+package light
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/milestone"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	syncInterval   = 2 * time.Second  // Time between two rounds of header following
+	requestTimeout = 10 * time.Second // Maximum time of a request to heimdall or to the upstream node
+	maxHeaderBatch = 64               // Maximum number of headers requested at once
+	maxUnfinalized = 2048             // Maximum number of headers followed beyond the last milestone
+	maxHeaders     = 8192             // Number of recent headers kept to be served
+)
+
+var (
+	errInvalidSnapshot   = errors.New("invalid trusted snapshot")
+	errMilestoneMismatch = errors.New("followed chain diverges from milestone")
+	errMissingHeader     = errors.New("header not served by upstream node")
+)
+
+// Heimdall is the part of the heimdall client needed by the light client.
+type Heimdall interface {
+	Span(ctx context.Context, spanID uint64) (*span.HeimdallSpan, error)
+	FetchMilestone(ctx context.Context) (*milestone.Milestone, error)
+}
+
+// Client follows the headers of an upstream full node, verifying their seals and
+// the span transitions. The headers up to the last milestone are final, the
+// headers after it are dropped if the milestones don't confirm them.
+type Client struct {
+	config   *params.ChainConfig
+	upstream *rpc.Client
+	heimdall Heimdall
+	spans    *spanCache
+
+	lock    sync.RWMutex
+	head    *bor.Snapshot            // Snapshot at the verified head
+	final   *bor.Snapshot            // Snapshot at the end block of the last milestone
+	snaps   map[uint64]*bor.Snapshot // Snapshots of the headers after the last milestone
+	headers map[uint64]*types.Header // Recent verified headers by number
+	numbers map[common.Hash]uint64   // Numbers of the recent verified headers by hash
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New creates a light client following the headers of the upstream node.
+func New(config *params.ChainConfig, upstream *rpc.Client, heimdall Heimdall) *Client {
+	return &Client{
+		config:   config,
+		upstream: upstream,
+		heimdall: heimdall,
+		spans:    newSpanCache(heimdall),
+		snaps:    make(map[uint64]*bor.Snapshot),
+		headers:  make(map[uint64]*types.Header),
+		numbers:  make(map[common.Hash]uint64),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start anchors the client on the latest milestone, and starts following the
+// headers after it.
+func (c *Client) Start() error {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	if err := c.bootstrap(ctx); err != nil {
+		return err
+	}
+
+	c.wg.Add(1)
+
+	go c.loop()
+
+	return nil
+}
+
+// Stop terminates the header following.
+func (c *Client) Stop() {
+	close(c.quit)
+	c.wg.Wait()
+}
+
+// ChainConfig returns the configuration of the followed chain.
+func (c *Client) ChainConfig() *params.ChainConfig {
+	return c.config
+}
+
+// CurrentHeader returns the verified head header.
+func (c *Client) CurrentHeader() *types.Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.headers[c.head.Number]
+}
+
+// FinalizedHeader returns the header at the end block of the last milestone.
+func (c *Client) FinalizedHeader() *types.Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.headers[c.final.Number]
+}
+
+// HeaderByNumber returns the recent verified header with the number, or nil if
+// it isn't known.
+func (c *Client) HeaderByNumber(number uint64) *types.Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return c.headers[number]
+}
+
+// HeaderByHash returns the recent verified header with the hash, or nil if it
+// isn't known.
+func (c *Client) HeaderByHash(hash common.Hash) *types.Header {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	number, ok := c.numbers[hash]
+	if !ok {
+		return nil
+	}
+
+	return c.headers[number]
+}
+
+// bootstrap anchors the client on the latest milestone. The snapshot at its end
+// block is retrieved from the upstream node, and trusted if its validator set
+// matches the producers of the span.
+func (c *Client) bootstrap(ctx context.Context) error {
+	m, err := c.heimdall.FetchMilestone(ctx)
+	if err != nil {
+		return err
+	}
+
+	var blob json.RawMessage
+	if err := c.upstream.CallContext(ctx, &blob, "bor_getSnapshotAtHash", m.Hash); err != nil {
+		return err
+	}
+
+	snap, err := bor.NewLightSnapshot(c.config, blob)
+	if err != nil {
+		return err
+	}
+
+	if snap.Hash != m.Hash || snap.Number != m.EndBlock.Uint64() {
+		return fmt.Errorf("%w: snapshot #%d [%x], milestone #%d [%x]", errInvalidSnapshot, snap.Number, snap.Hash, m.EndBlock, m.Hash)
+	}
+
+	var header *types.Header
+	if err := c.upstream.CallContext(ctx, &header, "eth_getHeaderByHash", m.Hash); err != nil {
+		return err
+	}
+
+	if header == nil || header.Hash() != m.Hash {
+		return fmt.Errorf("%w: milestone #%d [%x]", errMissingHeader, m.EndBlock, m.Hash)
+	}
+
+	producers, err := c.spans.producers(snap.Number + 1)
+	if err != nil {
+		return err
+	}
+
+	if !sameValidators(snap.ValidatorSet.Validators, producers) {
+		return fmt.Errorf("%w: validator set not matching span producers", errInvalidSnapshot)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.head, c.final = snap, snap
+	c.snaps = make(map[uint64]*bor.Snapshot)
+	c.headers = make(map[uint64]*types.Header)
+	c.numbers = make(map[common.Hash]uint64)
+	c.addHeader(header)
+
+	log.Info("Anchored light client on milestone", "number", snap.Number, "hash", snap.Hash)
+
+	return nil
+}
+
+// loop follows the headers of the upstream node until the client is stopped.
+func (c *Client) loop() {
+	defer c.wg.Done()
+
+	ticker := time.NewTicker(syncInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+
+			if err := c.finalize(ctx); err != nil {
+				log.Warn("Failed to anchor light client on milestone", "err", err)
+			}
+
+			if err := c.follow(ctx); err != nil {
+				log.Warn("Failed to follow headers", "err", err)
+			}
+
+			cancel()
+
+		case <-c.quit:
+			return
+		}
+	}
+}
+
+// follow verifies the headers after the head, up to the head of the upstream
+// node. The headers are dropped back to the last milestone if they don't link
+// to the head or fail the verification.
+func (c *Client) follow(ctx context.Context) error {
+	var latest hexutil.Uint64
+	if err := c.upstream.CallContext(ctx, &latest, "eth_blockNumber"); err != nil {
+		return err
+	}
+
+	c.lock.RLock()
+	head, parent := c.head, c.headers[c.head.Number]
+	target := min(uint64(latest), c.final.Number+maxUnfinalized)
+	c.lock.RUnlock()
+
+	for head.Number < target {
+		headers, err := c.fetchHeaders(ctx, head.Number+1, min(target-head.Number, maxHeaderBatch))
+		if err != nil {
+			return err
+		}
+
+		for _, header := range headers {
+			snap, err := head.ApplyLight(parent, []*types.Header{header}, c.spans.producers)
+			if err != nil {
+				c.rewind()
+				return fmt.Errorf("header #%d [%x]: %w", header.Number, header.Hash(), err)
+			}
+
+			c.lock.Lock()
+			c.head, c.snaps[snap.Number] = snap, snap
+			c.addHeader(header)
+			c.lock.Unlock()
+
+			head, parent = snap, header
+		}
+	}
+
+	return nil
+}
+
+// finalize anchors the followed headers on the latest milestone. The headers
+// after the previous milestone are dropped if they diverge from it. The client
+// is anchored again if the milestone is too far ahead of the followed headers.
+func (c *Client) finalize(ctx context.Context) error {
+	m, err := c.heimdall.FetchMilestone(ctx)
+	if err != nil {
+		return err
+	}
+
+	end := m.EndBlock.Uint64()
+
+	c.lock.Lock()
+
+	if end <= c.final.Number {
+		c.lock.Unlock()
+		return nil
+	}
+
+	if end > c.final.Number+maxUnfinalized {
+		c.lock.Unlock()
+		return c.bootstrap(ctx)
+	}
+
+	defer c.lock.Unlock()
+
+	snap, ok := c.snaps[end]
+	if !ok {
+		return nil
+	}
+
+	if snap.Hash != m.Hash {
+		c.rewindLocked()
+		return fmt.Errorf("%w: have #%d [%x], want [%x]", errMilestoneMismatch, end, snap.Hash, m.Hash)
+	}
+
+	c.final = snap
+
+	for number := range c.snaps {
+		if number <= end {
+			delete(c.snaps, number)
+		}
+	}
+
+	return nil
+}
+
+// rewind drops the headers after the last milestone.
+func (c *Client) rewind() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.rewindLocked()
+}
+
+func (c *Client) rewindLocked() {
+	for number := range c.snaps {
+		if header, ok := c.headers[number]; ok {
+			delete(c.numbers, header.Hash())
+			delete(c.headers, number)
+		}
+
+		delete(c.snaps, number)
+	}
+
+	c.head = c.final
+}
+
+// addHeader adds a verified header, dropping the oldest header if too many are
+// kept. The lock must be held.
+func (c *Client) addHeader(header *types.Header) {
+	number := header.Number.Uint64()
+
+	c.headers[number] = header
+	c.numbers[header.Hash()] = number
+
+	if number >= maxHeaders {
+		if old, ok := c.headers[number-maxHeaders]; ok {
+			delete(c.numbers, old.Hash())
+			delete(c.headers, number-maxHeaders)
+		}
+	}
+}
+
+// fetchHeaders retrieves a batch of consecutive headers from the upstream node.
+func (c *Client) fetchHeaders(ctx context.Context, from uint64, count uint64) ([]*types.Header, error) {
+	var (
+		headers = make([]*types.Header, count)
+		reqs    = make([]rpc.BatchElem, count)
+	)
+
+	for i := range reqs {
+		reqs[i] = rpc.BatchElem{
+			Method: "eth_getHeaderByNumber",
+			Args:   []interface{}{hexutil.Uint64(from + uint64(i))},
+			Result: &headers[i],
+		}
+	}
+
+	if err := c.upstream.BatchCallContext(ctx, reqs); err != nil {
+		return nil, err
+	}
+
+	for i := range reqs {
+		if reqs[i].Error != nil {
+			return nil, reqs[i].Error
+		}
+
+		if headers[i] == nil || headers[i].Number.Uint64() != from+uint64(i) {
+			return nil, fmt.Errorf("%w: #%d", errMissingHeader, from+uint64(i))
+		}
+	}
+
+	return headers, nil
+}
+
+// sameValidators reports whether the validator sets hold the same validators
+// with the same voting powers, regardless of the proposer priorities.
+func sameValidators(a []*valset.Validator, b []*valset.Validator) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	powers := make(map[common.Address]int64, len(a))
+	for _, validator := range a {
+		powers[validator.Address] = validator.VotingPower
+	}
+
+	for _, validator := range b {
+		if power, ok := powers[validator.Address]; !ok || power != validator.VotingPower {
+			return false
+		}
+	}
+
+	return true
+}
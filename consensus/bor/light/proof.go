@@ -0,0 +1,135 @@
+package light
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+var errInvalidProof = errors.New("invalid proof from upstream node")
+
+// verifyAccountResult checks that the account and the storage slots of a proof
+// are proven by the state root.
+func verifyAccountResult(root common.Hash, address common.Address, storageKeys []string, res *ethapi.AccountResult) error {
+	if res.Address != address || len(res.StorageProof) != len(storageKeys) {
+		return fmt.Errorf("%w: proof not matching the request", errInvalidProof)
+	}
+
+	db, err := proofDB(res.AccountProof)
+	if err != nil {
+		return err
+	}
+
+	value, err := trie.VerifyProof(root, crypto.Keccak256(address.Bytes()), db)
+	if err != nil {
+		return fmt.Errorf("%w: account: %v", errInvalidProof, err)
+	}
+
+	balance := (*big.Int)(res.Balance)
+	if balance == nil {
+		balance = new(big.Int)
+	}
+
+	// Missing accounts are served with zero hashes
+	storageRoot := res.StorageHash
+
+	if value == nil {
+		if res.Nonce != 0 || balance.Sign() != 0 ||
+			(res.CodeHash != (common.Hash{}) && res.CodeHash != types.EmptyCodeHash) ||
+			(storageRoot != (common.Hash{}) && storageRoot != types.EmptyRootHash) {
+			return fmt.Errorf("%w: missing account served", errInvalidProof)
+		}
+
+		storageRoot = types.EmptyRootHash
+	} else {
+		var account types.StateAccount
+		if err := rlp.DecodeBytes(value, &account); err != nil {
+			return fmt.Errorf("%w: account: %v", errInvalidProof, err)
+		}
+
+		if uint64(res.Nonce) != account.Nonce || balance.Cmp(account.Balance.ToBig()) != 0 ||
+			res.CodeHash != common.BytesToHash(account.CodeHash) || storageRoot != account.Root {
+			return fmt.Errorf("%w: account not matching its proof", errInvalidProof)
+		}
+	}
+
+	for i, slot := range res.StorageProof {
+		if common.HexToHash(slot.Key) != common.HexToHash(storageKeys[i]) {
+			return fmt.Errorf("%w: storage key %s not matching the request", errInvalidProof, slot.Key)
+		}
+
+		if err := verifyStorageResult(storageRoot, slot); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// verifyStorageResult checks that the value of a storage slot is proven by the
+// storage root.
+func verifyStorageResult(root common.Hash, slot ethapi.StorageResult) error {
+	want := (*big.Int)(slot.Value)
+	if want == nil {
+		want = new(big.Int)
+	}
+
+	have := new(big.Int)
+
+	if root != types.EmptyRootHash {
+		db, err := proofDB(slot.Proof)
+		if err != nil {
+			return err
+		}
+
+		key := common.HexToHash(slot.Key)
+
+		value, err := trie.VerifyProof(root, crypto.Keccak256(key.Bytes()), db)
+		if err != nil {
+			return fmt.Errorf("%w: storage %s: %v", errInvalidProof, slot.Key, err)
+		}
+
+		if value != nil {
+			_, content, _, err := rlp.Split(value)
+			if err != nil {
+				return fmt.Errorf("%w: storage %s: %v", errInvalidProof, slot.Key, err)
+			}
+
+			have.SetBytes(content)
+		}
+	}
+
+	if have.Cmp(want) != 0 {
+		return fmt.Errorf("%w: storage %s not matching its proof", errInvalidProof, slot.Key)
+	}
+
+	return nil
+}
+
+// proofDB collects the trie nodes of a proof, keyed by their hashes.
+func proofDB(proof []string) (ethdb.KeyValueStore, error) {
+	db := rawdb.NewMemoryDatabase()
+
+	for _, node := range proof {
+		enc, err := hexutil.Decode(node)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errInvalidProof, err)
+		}
+
+		if err := db.Put(crypto.Keccak256(enc), enc); err != nil {
+			return nil, err
+		}
+	}
+
+	return db, nil
+}
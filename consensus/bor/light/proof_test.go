@@ -0,0 +1,100 @@
+package light
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
+)
+
+// proofList collects the nodes of a proof as hex strings.
+type proofList []string
+
+func (n *proofList) Put(key []byte, value []byte) error {
+	*n = append(*n, hexutil.Encode(value))
+	return nil
+}
+
+func (n *proofList) Delete(key []byte) error {
+	panic("not supported")
+}
+
+func TestVerifyAccountResult(t *testing.T) {
+	t.Parallel()
+
+	newTrie := func() *trie.Trie {
+		return trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	}
+
+	var (
+		address = common.Address{0x01}
+		missing = common.Address{0x02}
+		slot    = common.Hash{0x03}
+	)
+
+	// Create a storage trie holding a single slot
+	storage := newTrie()
+	value, _ := rlp.EncodeToBytes(big.NewInt(42).Bytes())
+	require.NoError(t, storage.Update(crypto.Keccak256(slot.Bytes()), value))
+
+	var storageProof proofList
+	require.NoError(t, storage.Prove(crypto.Keccak256(slot.Bytes()), &storageProof))
+
+	// Create a state trie holding the account
+	account := &types.StateAccount{Nonce: 5, Balance: uint256.NewInt(1000), Root: storage.Hash(), CodeHash: types.EmptyCodeHash.Bytes()}
+	enc, _ := rlp.EncodeToBytes(account)
+
+	state := newTrie()
+	require.NoError(t, state.Update(crypto.Keccak256(address.Bytes()), enc))
+	require.NoError(t, state.Update(crypto.Keccak256(common.Address{0x04}.Bytes()), enc))
+
+	root := state.Hash()
+
+	var accountProof, missingProof proofList
+	require.NoError(t, state.Prove(crypto.Keccak256(address.Bytes()), &accountProof))
+	require.NoError(t, state.Prove(crypto.Keccak256(missing.Bytes()), &missingProof))
+
+	result := func() *ethapi.AccountResult {
+		return &ethapi.AccountResult{
+			Address:      address,
+			AccountProof: accountProof,
+			Balance:      (*hexutil.Big)(big.NewInt(1000)),
+			CodeHash:     types.EmptyCodeHash,
+			Nonce:        5,
+			StorageHash:  storage.Hash(),
+			StorageProof: []ethapi.StorageResult{{Key: slot.Hex(), Value: (*hexutil.Big)(big.NewInt(42)), Proof: storageProof}},
+		}
+	}
+
+	keys := []string{slot.Hex()}
+
+	require.NoError(t, verifyAccountResult(root, address, keys, result()))
+
+	// Missing accounts are proven by their absence
+	require.NoError(t, verifyAccountResult(root, missing, nil, &ethapi.AccountResult{Address: missing, AccountProof: missingProof, Balance: new(hexutil.Big)}))
+
+	// Forged account and storage values are rejected
+	res := result()
+	res.Balance = (*hexutil.Big)(big.NewInt(2000))
+	require.ErrorIs(t, verifyAccountResult(root, address, keys, res), errInvalidProof)
+
+	res = result()
+	res.StorageProof[0].Value = (*hexutil.Big)(big.NewInt(43))
+	require.ErrorIs(t, verifyAccountResult(root, address, keys, res), errInvalidProof)
+
+	require.ErrorIs(t, verifyAccountResult(root, missing, nil, &ethapi.AccountResult{Address: missing, AccountProof: missingProof, Balance: (*hexutil.Big)(big.NewInt(1))}), errInvalidProof)
+
+	// Proofs of other blocks are rejected
+	require.ErrorIs(t, verifyAccountResult(common.Hash{0xff}, address, keys, result()), errInvalidProof)
+}
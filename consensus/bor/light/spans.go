@@ -0,0 +1,119 @@
+package light
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/span"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+)
+
+const (
+	zerothSpanEnd     = 255  // Last block of the first span
+	defaultSpanLength = 6400 // Number of blocks of the spans after the first one, to guess span ids
+	maxSpanLookups    = 8    // Maximum number of spans fetched to find the span of a block
+	maxCachedSpans    = 8    // Maximum number of spans kept in memory
+)
+
+var errSpanNotFound = errors.New("span not found")
+
+// spanCache retrieves and caches the heimdall spans covering the followed blocks.
+type spanCache struct {
+	heimdall Heimdall
+
+	lock  sync.Mutex
+	spans map[uint64]*span.HeimdallSpan
+}
+
+func newSpanCache(heimdall Heimdall) *spanCache {
+	return &spanCache{
+		heimdall: heimdall,
+		spans:    make(map[uint64]*span.HeimdallSpan),
+	}
+}
+
+// producers returns the producers of the span covering the block, implementing
+// bor.SpanProducersFn.
+func (s *spanCache) producers(number uint64) ([]*valset.Validator, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	sp, err := s.spanAt(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	producers := make([]*valset.Validator, 0, len(sp.SelectedProducers))
+	for i := range sp.SelectedProducers {
+		producers = append(producers, sp.SelectedProducers[i].Copy())
+	}
+
+	return producers, nil
+}
+
+// spanAt returns the span covering the block. The span id is guessed from the
+// default span length, then the neighbouring spans are fetched until the block
+// is covered.
+func (s *spanCache) spanAt(ctx context.Context, number uint64) (*span.HeimdallSpan, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, sp := range s.spans {
+		if sp.StartBlock <= number && number <= sp.EndBlock {
+			return sp, nil
+		}
+	}
+
+	var id uint64
+	if number > zerothSpanEnd {
+		id = (number-zerothSpanEnd-1)/defaultSpanLength + 1
+	}
+
+	for i := 0; i < maxSpanLookups; i++ {
+		sp, err := s.heimdall.Span(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case number < sp.StartBlock:
+			if id == 0 {
+				return nil, errSpanNotFound
+			}
+			id--
+
+		case number > sp.EndBlock:
+			id++
+
+		default:
+			s.add(sp)
+			return sp, nil
+		}
+	}
+
+	return nil, errSpanNotFound
+}
+
+// add caches the span, evicting the oldest span if the cache is full.
+func (s *spanCache) add(sp *span.HeimdallSpan) {
+	s.spans[sp.ID] = sp
+
+	if len(s.spans) > maxCachedSpans {
+		oldest := sp.ID
+		for id := range s.spans {
+			if id < oldest {
+				oldest = id
+			}
+		}
+
+		delete(s.spans, oldest)
+	}
+}
+
+// SpanProducers returns a function retrieving the producers of the span covering
+// a block from heimdall, caching the recent spans.
+func SpanProducers(heimdall Heimdall) bor.SpanProducersFn {
+	return newSpanCache(heimdall).producers
+}
@@ -0,0 +1,150 @@
+package bor
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// makeLightHeaders creates a chain of headers after the parent signed by the key,
+// announcing the validators at the end of the sprints.
+func makeLightHeaders(t *testing.T, config *params.ChainConfig, parent *types.Header, n int, key *ecdsa.PrivateKey, validators []*valset.Validator) []*types.Header {
+	t.Helper()
+
+	headers := make([]*types.Header, 0, n)
+
+	for i := 0; i < n; i++ {
+		number := parent.Number.Uint64() + 1
+
+		extra := make([]byte, types.ExtraVanityLength)
+		if IsSprintStart(number+1, config.Bor.CalculateSprint(number)) {
+			for _, validator := range validators {
+				extra = append(extra, validator.HeaderBytes()...)
+			}
+		}
+
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     new(big.Int).SetUint64(number),
+			Time:       parent.Time + CalcProducerDelay(number, 0, config.Bor),
+			Difficulty: big.NewInt(1),
+			Extra:      append(extra, make([]byte, types.ExtraSealLength)...),
+		}
+
+		sig, err := crypto.Sign(SealHash(header, config.Bor).Bytes(), key)
+		require.NoError(t, err)
+
+		copy(header.Extra[len(header.Extra)-types.ExtraSealLength:], sig)
+
+		headers = append(headers, header)
+		parent = header
+	}
+
+	return headers
+}
+
+func TestApplyLight(t *testing.T) {
+	t.Parallel()
+
+	var (
+		config = &params.ChainConfig{
+			ChainID: big.NewInt(1),
+			Bor: &params.BorConfig{
+				Sprint:           map[string]uint64{"0": 4},
+				Period:           map[string]uint64{"0": 2},
+				ProducerDelay:    map[string]uint64{"0": 4},
+				BackupMultiplier: map[string]uint64{"0": 2},
+			},
+		}
+
+		key, _   = crypto.GenerateKey()
+		other, _ = crypto.GenerateKey()
+
+		validators = []*valset.Validator{{Address: crypto.PubkeyToAddress(key.PublicKey), VotingPower: 10}}
+		producers  = func(uint64) ([]*valset.Validator, error) {
+			return []*valset.Validator{validators[0].Copy()}, nil
+		}
+
+		genesis = &types.Header{Number: big.NewInt(0), Difficulty: big.NewInt(1), Extra: make([]byte, types.ExtraVanityLength+types.ExtraSealLength)}
+	)
+
+	newSnap := func() *Snapshot {
+		sigcache, _ := lru.NewARC(inmemorySignatures)
+		return newSnapshot(config, sigcache, 0, genesis.Hash(), []*valset.Validator{validators[0].Copy()})
+	}
+
+	// A valid chain is followed across the sprints
+	headers := makeLightHeaders(t, config, genesis, 9, key, validators)
+
+	snap, err := newSnap().ApplyLight(genesis, headers, producers)
+	require.NoError(t, err)
+	require.Equal(t, uint64(9), snap.Number)
+	require.Equal(t, headers[8].Hash(), snap.Hash)
+
+	// Headers not following the snapshot are rejected
+	_, err = newSnap().ApplyLight(genesis, headers[1:], producers)
+	require.ErrorIs(t, err, errOutOfRangeChain)
+
+	// Headers signed by a signer out of the validator set are rejected
+	_, err = newSnap().ApplyLight(genesis, makeLightHeaders(t, config, genesis, 2, other, validators), producers)
+	require.ErrorAs(t, err, new(*UnauthorizedSignerError))
+
+	// Headers produced too soon after their parents are rejected
+	fast := *config.Bor
+	fast.Period = map[string]uint64{"0": 1}
+
+	_, err = newSnap().ApplyLight(genesis, makeLightHeaders(t, &params.ChainConfig{ChainID: config.ChainID, Bor: &fast}, genesis, 2, key, validators), producers)
+	require.ErrorAs(t, err, new(*BlockTooSoonError))
+
+	// Validator sets not matching the span producers are rejected
+	forged := []*valset.Validator{{Address: crypto.PubkeyToAddress(other.PublicKey), VotingPower: 10}}
+
+	_, err = newSnap().ApplyLight(genesis, makeLightHeaders(t, config, genesis, 4, key, forged), producers)
+	require.ErrorIs(t, err, errInvalidSpanValidators)
+}
+
+func TestVerifySeal(t *testing.T) {
+	t.Parallel()
+
+	var (
+		config = &params.ChainConfig{
+			ChainID: big.NewInt(1),
+			Bor: &params.BorConfig{
+				Sprint:        map[string]uint64{"0": 4},
+				Period:        map[string]uint64{"0": 2},
+				ProducerDelay: map[string]uint64{"0": 4},
+			},
+		}
+
+		key, _   = crypto.GenerateKey()
+		other, _ = crypto.GenerateKey()
+
+		validators = []*valset.Validator{{Address: crypto.PubkeyToAddress(key.PublicKey), VotingPower: 10}}
+		producers  = func(uint64) ([]*valset.Validator, error) {
+			return validators, nil
+		}
+
+		genesis = &types.Header{Number: big.NewInt(0), Difficulty: big.NewInt(1), Extra: make([]byte, types.ExtraVanityLength+types.ExtraSealLength)}
+	)
+
+	header := makeLightHeaders(t, config, genesis, 1, key, validators)[0]
+	require.NoError(t, VerifySeal(config, header, producers))
+
+	// Headers signed by a signer out of the span producers are rejected
+	header = makeLightHeaders(t, config, genesis, 1, other, validators)[0]
+	require.ErrorAs(t, VerifySeal(config, header, producers), new(*UnauthorizedSignerError))
+
+	// Headers changed after they were sealed are rejected
+	header = makeLightHeaders(t, config, genesis, 1, key, validators)[0]
+	header.Root = common.Hash{1}
+	require.ErrorAs(t, VerifySeal(config, header, producers), new(*UnauthorizedSignerError))
+}
@@ -1,5 +1,107 @@
 package bor
 
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/xsleonard/go-merkle"
+	"golang.org/x/crypto/sha3"
+)
+
+// RootHash returns the merkle root of a range of consecutive headers, as
+// committed by the checkpoints. Each leaf commits to the number, time,
+// transactions root and receipts root of a header.
+func RootHash(headers []*types.Header) (common.Hash, error) {
+	tree, err := rootHashTree(rootHashLeaves(headers))
+	if err != nil {
+		return common.Hash{}, err
+	}
+
+	return common.BytesToHash(tree.Root().Hash), nil
+}
+
+// RootHashLeaf returns the leaf committing to a header in the checkpoint merkle
+// tree.
+func RootHashLeaf(header *types.Header) common.Hash {
+	return crypto.Keccak256Hash(appendBytes32(
+		header.Number.Bytes(),
+		new(big.Int).SetUint64(header.Time).Bytes(),
+		header.TxHash.Bytes(),
+		header.ReceiptHash.Bytes(),
+	))
+}
+
+// VerifyRootHashProof checks that the leaf at the index in a checkpoint merkle
+// tree leads to the root hash, the proof holding the sibling hashes from the
+// leaf up to the root.
+func VerifyRootHashProof(leaf common.Hash, index uint64, proof []common.Hash, root common.Hash) bool {
+	if len(proof) < 64 && index>>len(proof) != 0 {
+		return false
+	}
+
+	hash := leaf
+
+	for _, sibling := range proof {
+		if index&1 == 0 {
+			hash = crypto.Keccak256Hash(hash[:], sibling[:])
+		} else {
+			hash = crypto.Keccak256Hash(sibling[:], hash[:])
+		}
+
+		index >>= 1
+	}
+
+	return hash == root
+}
+
+// rootHashLeaves returns the leaves of the checkpoint merkle tree of a range of
+// consecutive headers, padded with empty leaves to the next power of two.
+func rootHashLeaves(headers []*types.Header) [][32]byte {
+	leaves := make([][32]byte, nextPowerOfTwo(uint64(len(headers))))
+
+	for i, header := range headers {
+		leaves[i] = RootHashLeaf(header)
+	}
+
+	return leaves
+}
+
+// rootHashTree generates the checkpoint merkle tree of the leaves.
+func rootHashTree(leaves [][32]byte) (*merkle.Tree, error) {
+	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
+	if err := tree.Generate(convert(leaves), sha3.NewLegacyKeccak256()); err != nil {
+		return nil, err
+	}
+
+	return &tree, nil
+}
+
+// rootHashProof returns the root hash of the checkpoint merkle tree of the leaves
+// and the sibling hashes from the leaf at the index up to the root.
+func rootHashProof(leaves [][32]byte, index uint64) (common.Hash, []common.Hash, error) {
+	if index >= uint64(len(leaves)) {
+		return common.Hash{}, nil, errUnknownBlock
+	}
+
+	tree, err := rootHashTree(leaves)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	// The levels go from the root down to the leaves
+	proof := make([]common.Hash, 0, tree.Height()-1)
+
+	for h := tree.Height(); h > 1; h-- {
+		proof = append(proof, common.BytesToHash(tree.GetNodesAtHeight(h)[index^1].Hash))
+		index >>= 1
+	}
+
+	return common.BytesToHash(tree.Root().Hash), proof, nil
+}
+
 func appendBytes32(data ...[]byte) []byte {
 	var result []byte
 
@@ -0,0 +1,48 @@
+package bor
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestRootHashProof(t *testing.T) {
+	t.Parallel()
+
+	for _, length := range []int{1, 2, 5, 8, 13} {
+		headers := make([]*types.Header, length)
+		for i := range headers {
+			headers[i] = &types.Header{
+				Number:      big.NewInt(int64(100 + i)),
+				Time:        uint64(1000 + 2*i),
+				TxHash:      common.Hash{byte(i), 1},
+				ReceiptHash: common.Hash{byte(i), 2},
+			}
+		}
+
+		want, err := RootHash(headers)
+		require.NoError(t, err)
+
+		leaves := rootHashLeaves(headers)
+
+		for i, header := range headers {
+			root, proof, err := rootHashProof(leaves, uint64(i))
+			require.NoError(t, err)
+			require.Equal(t, want, root, "length %d, index %d", length, i)
+
+			leaf := RootHashLeaf(header)
+			require.True(t, VerifyRootHashProof(leaf, uint64(i), proof, root), "length %d, index %d", length, i)
+
+			// The proof must not hold for another leaf or position
+			require.False(t, VerifyRootHashProof(common.Hash{0xff}, uint64(i), proof, root))
+			require.False(t, VerifyRootHashProof(leaf, uint64(i)+1, proof, root))
+		}
+
+		_, _, err = rootHashProof(leaves, uint64(len(leaves)))
+		require.ErrorIs(t, err, errUnknownBlock)
+	}
+}
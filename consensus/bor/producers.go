@@ -0,0 +1,56 @@
+package bor
+
+import (
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// UpcomingProducers returns the producers expected to seal the blocks after the
+// header: the in-turn producer of the current sprint followed by its backups in
+// succession order, then the same for the next sprint. The next sprint is
+// assumed to keep the validator set of the current one, the producers of a new
+// span are only known once its first sprint starts.
+func (c *Bor) UpcomingProducers(chain consensus.ChainHeaderReader, header *types.Header, backups int) ([]common.Address, error) {
+	snap, err := c.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	current := snap.ValidatorSet
+	next := current.CopyIncrementProposerPriority(1)
+
+	producers := successionOrder(current, backups+1)
+	for _, producer := range successionOrder(next, backups+1) {
+		if !slices.Contains(producers, producer) {
+			producers = append(producers, producer)
+		}
+	}
+
+	return producers, nil
+}
+
+// successionOrder returns up to count validators of the set, starting with the
+// in-turn proposer and following the succession order.
+func successionOrder(validators *valset.ValidatorSet, count int) []common.Address {
+	if validators.IsNilOrEmpty() {
+		return nil
+	}
+
+	start, _ := validators.GetByAddress(validators.GetProposer().Address)
+	if start < 0 {
+		return nil
+	}
+
+	count = min(count, len(validators.Validators))
+	order := make([]common.Address, 0, count)
+
+	for i := 0; i < count; i++ {
+		order = append(order, validators.Validators[(start+i)%len(validators.Validators)].Address)
+	}
+
+	return order
+}
@@ -0,0 +1,32 @@
+package bor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+)
+
+func TestSuccessionOrder(t *testing.T) {
+	t.Parallel()
+
+	validatorSet := valset.NewValidatorSet(buildRandomValidatorSet(numVals))
+	snap := Snapshot{
+		ValidatorSet: validatorSet,
+	}
+
+	order := successionOrder(validatorSet, 5)
+	require.Len(t, order, 5)
+
+	// The validators follow the succession order from the in-turn proposer
+	for i, signer := range order {
+		successionNumber, err := snap.GetSignerSuccessionNumber(signer)
+		require.NoError(t, err)
+		require.Equal(t, i, successionNumber)
+	}
+
+	// The order is capped by the size of the set
+	require.Len(t, successionOrder(validatorSet, 2*numVals), numVals)
+	require.Empty(t, successionOrder(valset.NewValidatorSet(nil), 5))
+}
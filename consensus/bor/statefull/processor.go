@@ -23,6 +23,8 @@ var systemAddress = common.HexToAddress("0xffffFFFfFFffffffffffffffFfFFFfffFFFfF
 type ChainContext struct {
 	Chain consensus.ChainHeaderReader
 	Bor   consensus.Engine
+
+	SystemCalls *[]types.SystemCall // Collects the system calls applied in the context, if set
 }
 
 func (c ChainContext) Engine() consensus.Engine {
@@ -72,12 +74,31 @@ func ApplyMessage(
 ) (uint64, error) {
 	initialGas := msg.Gas()
 
+	if cx, ok := chainContext.(ChainContext); ok && cx.SystemCalls != nil {
+		*cx.SystemCalls = append(*cx.SystemCalls, types.SystemCall{To: *msg.To(), Data: msg.Data()})
+	}
+
 	// Create a new context to be used in the EVM environment
 	blockContext := core.NewEVMBlockContext(header, chainContext, &header.Coinbase)
 
+	// The live tracer of the state sees the message as a system call
+	var vmConfig vm.Config
+
+	if hooks := state.Logger(); hooks != nil {
+		vmConfig.Tracer = hooks
+
+		if hooks.OnSystemCallStart != nil {
+			hooks.OnSystemCallStart()
+		}
+
+		if hooks.OnSystemCallEnd != nil {
+			defer hooks.OnSystemCallEnd()
+		}
+	}
+
 	// Create a new environment which holds all relevant information
 	// about the transaction and calling mechanisms.
-	vmenv := vm.NewEVM(blockContext, vm.TxContext{}, state, chainConfig, vm.Config{})
+	vmenv := vm.NewEVM(blockContext, vm.TxContext{}, state, chainConfig, vmConfig)
 
 	// nolint : contextcheck
 	// Apply the transaction to the current state (included in the env)
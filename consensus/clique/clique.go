@@ -141,6 +141,14 @@ var (
 	errRecentlySigned = errors.New("recently signed")
 )
 
+// IsSealError reports whether the error is returned by the verification of the
+// seal of a header, meaning the header was forged or signed out of turn or by an
+// unauthorized signer.
+func IsSealError(err error) bool {
+	return errors.Is(err, errUnauthorizedSigner) || errors.Is(err, errRecentlySigned) ||
+		errors.Is(err, errWrongDifficulty) || errors.Is(err, errMissingSignature)
+}
+
 // SignerFn hashes and signs the data to be signed by a backing account.
 type SignerFn func(signer accounts.Account, mimeType string, message []byte) ([]byte, error)
 
@@ -130,3 +130,17 @@ func TestSealHash(t *testing.T) {
 		t.Errorf("have %x, want %x", have, want)
 	}
 }
+
+func TestIsSealError(t *testing.T) {
+	for _, err := range []error{errUnauthorizedSigner, errRecentlySigned, errWrongDifficulty, errMissingSignature} {
+		if !IsSealError(err) {
+			t.Errorf("%v: not a seal error", err)
+		}
+	}
+
+	for _, err := range []error{errInvalidTimestamp, errUnknownBlock, nil} {
+		if IsSealError(err) {
+			t.Errorf("%v: seal error", err)
+		}
+	}
+}
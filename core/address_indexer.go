@@ -0,0 +1,398 @@
+package core
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// addressActivityThrottling is the time to wait between processing two
+	// consecutive index sections.
+	addressActivityThrottling = 100 * time.Millisecond
+)
+
+// AddressIndexer implements a core.ChainIndexer, building up an index of the
+// transactions each address is involved in, as sender, recipient, participant
+// of an internal call or recipient of a state sync.
+//
+// The internal calls and state syncs of a block are recorded by the
+// AddressActivityTracer when the block is executed. The blocks which were
+// executed without it, or not executed at all, are only indexed with the
+// senders and recipients of their transactions.
+type AddressIndexer struct {
+	db     ethdb.Database
+	config *params.ChainConfig
+	batch  ethdb.Batch
+	blocks []common.Hash // Blocks whose recorded activity is indexed by the section
+}
+
+// NewAddressIndexer returns a chain indexer that generates the address activity
+// index of the canonical chain.
+func NewAddressIndexer(db ethdb.Database, config *params.ChainConfig, size, confirms uint64) *ChainIndexer {
+	backend := &AddressIndexer{
+		db:     db,
+		config: config,
+	}
+	table := rawdb.NewTable(db, string(rawdb.AddressActivityIndexPrefix))
+
+	return NewChainIndexer(db, table, backend, size, confirms, addressActivityThrottling, "addressactivity")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new address activity
+// index section.
+func (b *AddressIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	b.batch, b.blocks = b.db.NewBatch(), b.blocks[:0]
+
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, adding the activity of a new
+// header's block into the index.
+func (b *AddressIndexer) Process(ctx context.Context, header *types.Header) error {
+	hash, number := header.Hash(), header.Number.Uint64()
+
+	for _, activity := range BlockAddressActivity(b.db, b.config, header) {
+		rawdb.WriteAddressActivityEntry(b.batch, activity.Address, &rawdb.AddressActivityEntry{
+			Number:  number,
+			Hash:    hash,
+			TxIndex: activity.TxIndex,
+			Kind:    activity.Kind,
+		})
+	}
+
+	b.blocks = append(b.blocks, hash)
+
+	// The entries of a canonical block are valid as soon as they're written,
+	// flush them to keep the batch small
+	if b.batch.ValueSize() > ethdb.IdealBatchSize {
+		if err := b.batch.Write(); err != nil {
+			return err
+		}
+
+		b.batch.Reset()
+	}
+
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, finalizing the address activity
+// section and dropping the recorded activity of its blocks, which is indexed.
+func (b *AddressIndexer) Commit() error {
+	if err := b.batch.Write(); err != nil {
+		return err
+	}
+
+	b.batch.Reset()
+
+	for _, hash := range b.blocks {
+		rawdb.DeleteBlockAddressActivity(b.batch, hash)
+	}
+
+	return b.batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (b *AddressIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// BlockAddressActivity returns the addresses involved in the transactions of the
+// block, as recorded when it was executed. If its execution wasn't recorded,
+// only the senders and recipients of its transactions are returned.
+func BlockAddressActivity(db ethdb.Reader, config *params.ChainConfig, header *types.Header) []types.AddressActivity {
+	hash, number := header.Hash(), header.Number.Uint64()
+
+	if activity := rawdb.ReadBlockAddressActivity(db, hash); activity != nil {
+		return activity
+	}
+
+	body := rawdb.ReadBody(db, hash, number)
+	if body == nil {
+		return nil
+	}
+
+	var (
+		signer   = types.MakeSigner(config, header.Number, header.Time)
+		activity = make([]types.AddressActivity, 0, 2*len(body.Transactions))
+	)
+
+	for i, tx := range body.Transactions {
+		from, err := types.Sender(signer, tx)
+		if err != nil {
+			continue
+		}
+
+		to := crypto.CreateAddress(from, tx.Nonce())
+		if tx.To() != nil {
+			to = *tx.To()
+		}
+
+		if to == from {
+			activity = append(activity, types.AddressActivity{Address: from, TxIndex: uint64(i), Kind: types.AddressActivityFrom | types.AddressActivityTo})
+			continue
+		}
+
+		activity = append(activity,
+			types.AddressActivity{Address: from, TxIndex: uint64(i), Kind: types.AddressActivityFrom},
+			types.AddressActivity{Address: to, TxIndex: uint64(i), Kind: types.AddressActivityTo},
+		)
+	}
+
+	return activity
+}
+
+// ReadAddressActivity returns up to limit entries of the activity of the address,
+// starting with the transaction at txIndex of block from and ending with block
+// to. The blocks before indexed are read from the address activity index, the
+// blocks after it from the activity recorded when they were executed.
+func ReadAddressActivity(db ethdb.Database, config *params.ChainConfig, address common.Address, from, txIndex, to, indexed uint64, limit int) []*rawdb.AddressActivityEntry {
+	var entries []*rawdb.AddressActivityEntry
+
+	// The index has entries of reorged blocks, only the canonical ones are kept
+	canonical := make(map[uint64]common.Hash)
+
+	for from < indexed && from <= to && len(entries) < limit {
+		batch := rawdb.ReadAddressActivityEntries(db, address, from, txIndex, min(to, indexed-1), limit-len(entries))
+		if len(batch) == 0 {
+			break
+		}
+
+		for _, entry := range batch {
+			hash, ok := canonical[entry.Number]
+			if !ok {
+				hash = rawdb.ReadCanonicalHash(db, entry.Number)
+				canonical[entry.Number] = hash
+			}
+
+			if entry.Hash == hash {
+				entries = append(entries, entry)
+			}
+		}
+
+		last := batch[len(batch)-1]
+		from, txIndex = last.Number, last.TxIndex+1
+	}
+
+	if from < indexed {
+		from, txIndex = indexed, 0
+	}
+
+	for number := from; number <= to && len(entries) < limit; number++ {
+		hash := rawdb.ReadCanonicalHash(db, number)
+		if hash == (common.Hash{}) {
+			break
+		}
+
+		header := rawdb.ReadHeader(db, hash, number)
+		if header == nil {
+			break
+		}
+
+		for _, activity := range BlockAddressActivity(db, config, header) {
+			if activity.Address != address || (number == from && activity.TxIndex < txIndex) {
+				continue
+			}
+
+			if len(entries) == limit {
+				break
+			}
+
+			entries = append(entries, &rawdb.AddressActivityEntry{
+				Number:  number,
+				Hash:    hash,
+				TxIndex: activity.TxIndex,
+				Kind:    activity.Kind,
+			})
+		}
+	}
+
+	return entries
+}
+
+// AddressActivityTracer is a lightweight live tracer recording the addresses
+// involved in the transactions of the executed blocks, including the ones of
+// their internal calls and the contracts called by their state syncs, for the
+// address activity index.
+type AddressActivityTracer struct {
+	db            ethdb.KeyValueWriter
+	stateReceiver common.Address
+
+	block     *types.Block
+	txIndex   uint64
+	txs       uint64 // Number of transactions started in the block
+	stateSync bool   // Whether the system call being executed commits a state sync
+	activity  map[types.AddressActivity]struct{}
+}
+
+// NewAddressActivityTracer creates a tracer storing the activity of the blocks
+// into the database.
+func NewAddressActivityTracer(db ethdb.KeyValueWriter, config *params.ChainConfig) *AddressActivityTracer {
+	t := &AddressActivityTracer{db: db}
+
+	if config.Bor != nil {
+		t.stateReceiver = common.HexToAddress(config.Bor.StateReceiverContract)
+	}
+
+	return t
+}
+
+// Hooks returns the hooks of the tracer, chained with the given ones (which
+// may be nil) of another live tracer.
+func (t *AddressActivityTracer) Hooks(hooks *tracing.Hooks) *tracing.Hooks {
+	chained := new(tracing.Hooks)
+	if hooks != nil {
+		*chained = *hooks
+	}
+
+	onBlockStart, onBlockEnd := chained.OnBlockStart, chained.OnBlockEnd
+	chained.OnBlockStart = func(ev tracing.BlockEvent) {
+		t.OnBlockStart(ev)
+
+		if onBlockStart != nil {
+			onBlockStart(ev)
+		}
+	}
+	chained.OnBlockEnd = func(err error) {
+		t.OnBlockEnd(err)
+
+		if onBlockEnd != nil {
+			onBlockEnd(err)
+		}
+	}
+
+	onTxStart := chained.OnTxStart
+	chained.OnTxStart = func(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+		t.OnTxStart(vm, tx, from)
+
+		if onTxStart != nil {
+			onTxStart(vm, tx, from)
+		}
+	}
+
+	onEnter := chained.OnEnter
+	chained.OnEnter = func(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+		t.OnEnter(depth, typ, from, to, input, gas, value)
+
+		if onEnter != nil {
+			onEnter(depth, typ, from, to, input, gas, value)
+		}
+	}
+
+	onSystemCallStart, onSystemCallEnd := chained.OnSystemCallStart, chained.OnSystemCallEnd
+	chained.OnSystemCallStart = func() {
+		t.OnSystemCallStart()
+
+		if onSystemCallStart != nil {
+			onSystemCallStart()
+		}
+	}
+	chained.OnSystemCallEnd = func() {
+		t.OnSystemCallEnd()
+
+		if onSystemCallEnd != nil {
+			onSystemCallEnd()
+		}
+	}
+
+	return chained
+}
+
+func (t *AddressActivityTracer) OnBlockStart(ev tracing.BlockEvent) {
+	t.block, t.txs, t.stateSync = ev.Block, 0, false
+	t.activity = make(map[types.AddressActivity]struct{})
+}
+
+func (t *AddressActivityTracer) OnBlockEnd(err error) {
+	if t.block == nil {
+		return
+	}
+
+	if err == nil {
+		// Each address is listed once per transaction, with all its kinds
+		kinds := make(map[types.AddressActivity]types.AddressActivityKind)
+
+		for activity := range t.activity {
+			key := types.AddressActivity{Address: activity.Address, TxIndex: activity.TxIndex}
+			kinds[key] |= activity.Kind
+		}
+
+		activity := make([]types.AddressActivity, 0, len(kinds))
+
+		for key, kind := range kinds {
+			key.Kind = kind
+			activity = append(activity, key)
+		}
+
+		sort.Slice(activity, func(i, j int) bool {
+			if activity[i].TxIndex != activity[j].TxIndex {
+				return activity[i].TxIndex < activity[j].TxIndex
+			}
+
+			return activity[i].Address.Cmp(activity[j].Address) < 0
+		})
+
+		rawdb.WriteBlockAddressActivity(t.db, t.block.Hash(), activity)
+	}
+
+	t.block, t.activity = nil, nil
+}
+
+func (t *AddressActivityTracer) OnTxStart(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.txIndex = t.txs
+	t.txs++
+}
+
+func (t *AddressActivityTracer) OnSystemCallStart() {
+	// The state syncs of a block are part of its bor transaction
+	if t.block != nil {
+		t.txIndex = uint64(len(t.block.Transactions()))
+	}
+}
+
+func (t *AddressActivityTracer) OnSystemCallEnd() {
+	t.stateSync = false
+}
+
+func (t *AddressActivityTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.block == nil {
+		return
+	}
+
+	switch {
+	case t.txIndex == uint64(len(t.block.Transactions())):
+		// The state syncs are committed to the state receiver contract by the
+		// system address, which calls the recipient contracts
+		if depth == 0 {
+			t.stateSync = to == t.stateReceiver && t.stateReceiver != (common.Address{})
+			return
+		}
+
+		if t.stateSync {
+			t.add(to, types.AddressActivityStateSync)
+
+			if from != t.stateReceiver {
+				t.add(from, types.AddressActivityStateSync)
+			}
+		}
+	case depth == 0:
+		t.add(from, types.AddressActivityFrom)
+		t.add(to, types.AddressActivityTo)
+	default:
+		t.add(from, types.AddressActivityInternal)
+		t.add(to, types.AddressActivityInternal)
+	}
+}
+
+func (t *AddressActivityTracer) add(address common.Address, kind types.AddressActivityKind) {
+	t.activity[types.AddressActivity{Address: address, TxIndex: t.txIndex, Kind: kind}] = struct{}{}
+}
@@ -0,0 +1,190 @@
+package core
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressActivityIndex(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		sender = crypto.PubkeyToAddress(key.PublicKey)
+		caller = common.HexToAddress("0xca11")
+		callee = common.HexToAddress("0xca11ee")
+		gspec  = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				sender: {Balance: big.NewInt(params.Ether)},
+				// caller calls the callee
+				caller: {Code: common.FromHex("0x600060006000600060006300ca11ee5af100")},
+			},
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+
+	_, blocks, _ := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 4, func(i int, b *BlockGen) {
+		tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(sender), caller, big.NewInt(0), 100000, b.header.BaseFee, nil), signer, key)
+		b.AddTx(tx)
+	})
+
+	db := rawdb.NewMemoryDatabase()
+	tracer := NewAddressActivityTracer(db, gspec.Config)
+
+	chain, err := NewBlockChain(db, nil, gspec, nil, ethash.NewFaker(), vm.Config{Tracer: tracer.Hooks(nil)}, nil, nil, nil)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	// The internal calls of the blocks are recorded
+	require.Equal(t, []types.AddressActivity{
+		{Address: caller, TxIndex: 0, Kind: types.AddressActivityTo | types.AddressActivityInternal},
+		{Address: callee, TxIndex: 0, Kind: types.AddressActivityInternal},
+		{Address: sender, TxIndex: 0, Kind: types.AddressActivityFrom},
+	}, BlockAddressActivity(db, gspec.Config, blocks[0].Header()))
+
+	// Index the first two sections, the last blocks are read from their records
+	indexer := &AddressIndexer{db: db, config: gspec.Config}
+
+	for section := uint64(0); section < 2; section++ {
+		require.NoError(t, indexer.Reset(context.Background(), section, common.Hash{}))
+
+		for number := 2 * section; number < 2*section+2; number++ {
+			require.NoError(t, indexer.Process(context.Background(), chain.GetHeaderByNumber(number)))
+		}
+
+		require.NoError(t, indexer.Commit())
+	}
+
+	require.Nil(t, rawdb.ReadBlockAddressActivity(db, blocks[0].Hash()))
+	require.NotNil(t, rawdb.ReadBlockAddressActivity(db, blocks[3].Hash()))
+
+	// The entries of reorged blocks are skipped
+	rawdb.WriteAddressActivityEntry(db, callee, &rawdb.AddressActivityEntry{Number: 2, Hash: common.Hash{1}, TxIndex: 1})
+
+	entries := ReadAddressActivity(db, gspec.Config, callee, 0, 0, 4, 4, 10)
+	require.Len(t, entries, 4)
+
+	for i, entry := range entries {
+		require.Equal(t, blocks[i].Hash(), entry.Hash)
+		require.Equal(t, uint64(i+1), entry.Number)
+		require.Equal(t, types.AddressActivityInternal, entry.Kind)
+	}
+
+	// The activity is paged across the index and the records
+	entries = ReadAddressActivity(db, gspec.Config, callee, 0, 0, 4, 4, 2)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(2), entries[1].Number)
+
+	entries = ReadAddressActivity(db, gspec.Config, callee, 2, 1, 4, 4, 2)
+	require.Len(t, entries, 2)
+	require.Equal(t, uint64(3), entries[0].Number)
+	require.Equal(t, uint64(4), entries[1].Number)
+
+	entries = ReadAddressActivity(db, gspec.Config, callee, 1, 0, 2, 4, 10)
+	require.Len(t, entries, 2)
+
+	// The blocks without records only have their senders and recipients
+	rawdb.DeleteBlockAddressActivity(db, blocks[3].Hash())
+
+	require.Empty(t, ReadAddressActivity(db, gspec.Config, callee, 4, 0, 4, 4, 10))
+	require.Len(t, ReadAddressActivity(db, gspec.Config, caller, 4, 0, 4, 4, 10), 1)
+}
+
+func TestAddressActivityTracerStateSync(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db            = rawdb.NewMemoryDatabase()
+		config        = params.BorUnittestChainConfig
+		stateReceiver = common.HexToAddress(config.Bor.StateReceiverContract)
+		recipient     = common.HexToAddress("0xbeef")
+		block         = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(16)})
+		hooks         = NewAddressActivityTracer(db, config).Hooks(&tracing.Hooks{})
+	)
+
+	hooks.OnBlockStart(tracing.BlockEvent{Block: block})
+
+	// The state sync calls the recipient, the span commit isn't recorded
+	hooks.OnSystemCallStart()
+	hooks.OnEnter(0, byte(vm.CALL), types.SystemAddress, stateReceiver, nil, 0, nil)
+	hooks.OnEnter(1, byte(vm.CALL), stateReceiver, recipient, nil, 0, nil)
+	hooks.OnSystemCallEnd()
+
+	hooks.OnSystemCallStart()
+	hooks.OnEnter(0, byte(vm.CALL), types.SystemAddress, common.HexToAddress(config.Bor.ValidatorContract), nil, 0, nil)
+	hooks.OnEnter(1, byte(vm.CALL), common.HexToAddress(config.Bor.ValidatorContract), recipient, nil, 0, nil)
+	hooks.OnSystemCallEnd()
+
+	hooks.OnBlockEnd(nil)
+
+	require.Equal(t, []types.AddressActivity{
+		{Address: recipient, TxIndex: 0, Kind: types.AddressActivityStateSync},
+	}, rawdb.ReadBlockAddressActivity(db, block.Hash()))
+}
+
+func TestAddressActivityTracerParallel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		sender = crypto.PubkeyToAddress(key.PublicKey)
+		caller = common.HexToAddress("0xca11")
+		callee = common.HexToAddress("0xca11ee")
+		gspec  = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				sender: {Balance: big.NewInt(params.Ether)},
+				// caller calls the callee
+				caller: {Code: common.FromHex("0x600060006000600060006300ca11ee5af100")},
+			},
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+
+	_, blocks, _ := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 4, func(i int, b *BlockGen) {
+		for j := 0; j < 2; j++ {
+			tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(sender), caller, big.NewInt(0), 100000, b.header.BaseFee, nil), signer, key)
+			b.AddTx(tx)
+		}
+	})
+
+	db := rawdb.NewMemoryDatabase()
+	tracer := NewAddressActivityTracer(db, gspec.Config)
+
+	// Both processors execute the blocks, the tracer only sees one execution
+	chain, err := NewParallelBlockChain(db, nil, gspec, nil, ethash.NewFaker(), vm.Config{Tracer: tracer.Hooks(nil)}, nil, nil, nil, 8)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	for _, block := range blocks {
+		require.Equal(t, []types.AddressActivity{
+			{Address: caller, TxIndex: 0, Kind: types.AddressActivityTo | types.AddressActivityInternal},
+			{Address: callee, TxIndex: 0, Kind: types.AddressActivityInternal},
+			{Address: sender, TxIndex: 0, Kind: types.AddressActivityFrom},
+			{Address: caller, TxIndex: 1, Kind: types.AddressActivityTo | types.AddressActivityInternal},
+			{Address: callee, TxIndex: 1, Kind: types.AddressActivityInternal},
+			{Address: sender, TxIndex: 1, Kind: types.AddressActivityFrom},
+		}, BlockAddressActivity(db, gspec.Config, block.Header()))
+	}
+}
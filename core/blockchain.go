@@ -102,6 +102,10 @@ var (
 	blockPrefetchExecuteTimer   = metrics.NewRegisteredTimer("chain/prefetch/executes", nil)
 	blockPrefetchInterruptMeter = metrics.NewRegisteredMeter("chain/prefetch/interrupts", nil)
 
+	blockPipelineHitMeter     = metrics.NewRegisteredMeter("chain/pipeline/hits", nil)
+	blockPipelineFailMeter    = metrics.NewRegisteredMeter("chain/pipeline/failures", nil)
+	blockPipelineDiscardMeter = metrics.NewRegisteredMeter("chain/pipeline/discards", nil)
+
 	errInsertionInterrupted = errors.New("insertion is interrupted")
 	errChainStopped         = errors.New("blockchain is stopped")
 	errInvalidOldChain      = errors.New("invalid old chain")
@@ -156,6 +160,8 @@ type CacheConfig struct {
 	StateHistory        uint64        // Number of blocks from head whose state histories are reserved.
 	StateScheme         string        // Scheme used to store ethereum states and merkle tree nodes on top
 
+	PipelineImport bool // Whether to execute each imported block while its parent is committed
+
 	SnapshotNoBuild bool // Whether the background generation is allowed
 	SnapshotWait    bool // Wait for snapshot construction on startup. TODO(karalabe): This is a dirty hack for testing, nuke it
 }
@@ -282,10 +288,12 @@ type BlockChain struct {
 	forker                       *ForkChoice
 	vmConfig                     vm.Config
 	logger                       *tracing.Hooks
+	pipelineHits                 atomic.Uint64 // Number of pipelined executions used, unlike the meter it's always counted
 
 	// Bor related changes
 	borReceiptsCache *lru.Cache[common.Hash, *types.Receipt] // Cache for the most recent bor receipt receipts per block
 	stateSyncData    []*types.StateSyncData                  // State sync data
+	systemCalls      []types.SystemCall                      // System calls applied at the end of the block
 	stateSyncFeed    event.Feed                              // State sync feed
 	chain2HeadFeed   event.Feed                              // Reorg/NewHead/Fork data feed
 }
@@ -572,8 +580,16 @@ func NewParallelBlockChain(db ethdb.Database, cacheConfig *CacheConfig, genesis
 }
 
 func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (_ types.Receipts, _ []*types.Log, _ uint64, _ *state.StateDB, vtime time.Duration, blockEndErr error) {
+	return bc.executeBlock(context.Background(), block, parent, nil)
+}
+
+// executeBlock processes the block on the state of its parent. If base is set,
+// the block is executed on copies of it rather than on the committed state of the
+// parent, which may not be available yet. The trie prefetcher is bound to the
+// committed root of the parent, so it's not run on the copies.
+func (bc *BlockChain) executeBlock(ctx context.Context, block *types.Block, parent *types.Header, base *state.StateDB) (_ types.Receipts, _ []*types.Log, _ uint64, _ *state.StateDB, vtime time.Duration, blockEndErr error) {
 	// Process the block using processor and parallelProcessor at the same time, take the one which finishes first, cancel the other, and return the result
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	if bc.logger != nil && bc.logger.OnBlockStart != nil {
@@ -600,56 +616,104 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (_
 		statedb  *state.StateDB
 		counter  metrics.Counter
 		parallel bool
+		recorder *hookRecorder
 	}
 
 	resultChan := make(chan Result, 2)
 
 	processorCount := 0
 
-	if bc.parallelProcessor != nil {
-		parallelStatedb, err := state.New(parent.Root, bc.stateCache, bc.snaps)
+	// A live tracer must only see one execution of the block. When both
+	// processors run, each of them records its hooks and only the ones of the
+	// result which is used are replayed, once the block is validated. This
+	// keeps the processors racing at the cost of buffering the hooks of both.
+	// Recording the opcode hooks copies the memory and stack of every step
+	// though, so a tracer of the opcodes is attached to the serial processor
+	// alone instead.
+	var (
+		opcodes  = bc.logger != nil && (bc.logger.OnOpcode != nil || bc.logger.OnFault != nil) && bc.processor != nil
+		parallel = bc.parallelProcessor != nil && !opcodes
+		tracing  = bc.logger != nil && parallel
+	)
+
+	if parallel {
+		parallelStatedb, err := bc.blockState(parent, base)
 		if err != nil {
 			return nil, nil, 0, nil, 0, err
 		}
-		parallelStatedb.SetLogger(bc.logger)
+
+		var (
+			vmConfig = bc.vmConfig
+			recorder *hookRecorder
+		)
+
+		if tracing {
+			recorder = newHookRecorder(bc.logger)
+			vmConfig.Tracer = recorder.hooks
+		}
+
+		parallelStatedb.SetLogger(vmConfig.Tracer)
 
 		processorCount++
 
 		go func() {
-			parallelStatedb.StartPrefetcher("chain", nil)
+			if base == nil {
+				parallelStatedb.StartPrefetcher("chain", nil)
+			}
 			pstart := time.Now()
-			receipts, logs, usedGas, err := bc.parallelProcessor.Process(block, parallelStatedb, bc.vmConfig, ctx)
+			receipts, logs, usedGas, err := bc.parallelProcessor.Process(block, parallelStatedb, vmConfig, ctx)
 			blockExecutionParallelTimer.UpdateSince(pstart)
 			if err == nil {
 				vstart := time.Now()
 				err = bc.validator.ValidateState(block, parallelStatedb, receipts, usedGas, false)
 				vtime = time.Since(vstart)
 			}
-			resultChan <- Result{receipts, logs, usedGas, err, parallelStatedb, blockExecutionParallelCounter, true}
+			resultChan <- Result{receipts, logs, usedGas, err, parallelStatedb, blockExecutionParallelCounter, true, recorder}
 		}()
 	}
 
-	if bc.processor != nil {
-		statedb, err := state.New(parent.Root, bc.stateCache, bc.snaps)
+	startSerial := func() error {
+		statedb, err := bc.blockState(parent, base)
 		if err != nil {
-			return nil, nil, 0, nil, 0, err
+			return err
 		}
-		statedb.SetLogger(bc.logger)
+
+		var (
+			vmConfig = bc.vmConfig
+			recorder *hookRecorder
+		)
+
+		if tracing {
+			recorder = newHookRecorder(bc.logger)
+			vmConfig.Tracer = recorder.hooks
+		}
+
+		statedb.SetLogger(vmConfig.Tracer)
 
 		processorCount++
 
 		go func() {
-			statedb.StartPrefetcher("chain", nil)
+			if base == nil {
+				statedb.StartPrefetcher("chain", nil)
+			}
 			pstart := time.Now()
-			receipts, logs, usedGas, err := bc.processor.Process(block, statedb, bc.vmConfig, ctx)
+			receipts, logs, usedGas, err := bc.processor.Process(block, statedb, vmConfig, ctx)
 			blockExecutionSerialTimer.UpdateSince(pstart)
 			if err == nil {
 				vstart := time.Now()
 				err = bc.validator.ValidateState(block, statedb, receipts, usedGas, false)
 				vtime = time.Since(vstart)
 			}
-			resultChan <- Result{receipts, logs, usedGas, err, statedb, blockExecutionSerialCounter, false}
+			resultChan <- Result{receipts, logs, usedGas, err, statedb, blockExecutionSerialCounter, false, recorder}
 		}()
+
+		return nil
+	}
+
+	if bc.processor != nil {
+		if err := startSerial(); err != nil {
+			return nil, nil, 0, nil, 0, err
+		}
 	}
 
 	result := <-resultChan
@@ -657,6 +721,7 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (_
 	if result.parallel && result.err != nil {
 		log.Warn("Parallel state processor failed", "err", result.err)
 		blockExecutionParallelErrorCounter.Inc(1)
+
 		// If the parallel processor failed, we will fallback to the serial processor if enabled
 		if processorCount == 2 {
 			result = <-resultChan
@@ -667,6 +732,11 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (_
 
 	result.counter.Inc(1)
 
+	if result.err == nil && result.recorder != nil {
+		result.statedb.SetLogger(bc.logger)
+		result.recorder.replay(bc.logger)
+	}
+
 	// Make sure we are not leaking any prefetchers
 	if processorCount == 2 {
 		go func() {
@@ -678,6 +748,16 @@ func (bc *BlockChain) ProcessBlock(block *types.Block, parent *types.Header) (_
 	return result.receipts, result.logs, result.usedGas, result.statedb, vtime, result.err
 }
 
+// blockState returns the state to execute the block on, either the committed
+// state of the parent or a copy of the given base state.
+func (bc *BlockChain) blockState(parent *types.Header, base *state.StateDB) (*state.StateDB, error) {
+	if base != nil {
+		return base.Copy(), nil
+	}
+
+	return state.New(parent.Root, bc.stateCache, bc.snaps)
+}
+
 // empty returns an indicator whether the blockchain is empty.
 // Note, it's a special case that we connect a non-empty ancient
 // database with an empty node, so that we can plugin the ancient
@@ -1127,6 +1207,8 @@ func (bc *BlockChain) setHeadBeyondRoot(head uint64, time uint64, root common.Ha
 			rawdb.DeleteReceipts(db, hash, num)
 			rawdb.DeleteBorReceipt(db, hash, num)
 			rawdb.DeleteBorTxLookupEntry(db, hash, num)
+			rawdb.DeleteStateSyncTxLookupEntries(db, hash, num, rawdb.ReadStateSyncIDs(bc.db, hash, num))
+			rawdb.DeleteSystemCalls(db, hash, num)
 		}
 		// Todo(rjl493456442) txlookup, bloombits, etc
 	}
@@ -1557,7 +1639,14 @@ func (bc *BlockChain) InsertReceiptChain(blockChain types.Blocks, receiptChain [
 		var headers []*types.Header
 
 		for _, block := range blockChain {
-			borReceipts = append(borReceipts, []*types.Receipt{bc.GetBorReceiptByHash(block.Hash())})
+			// The receipts of the block aren't written yet, so the bor receipt is
+			// read raw instead of deriving its fields.
+			var borReceipt types.Receipts
+			if receipt := rawdb.ReadRawBorReceipt(bc.db, block.Hash(), block.NumberU64()); receipt != nil {
+				borReceipt = types.Receipts{receipt}
+			}
+
+			borReceipts = append(borReceipts, borReceipt)
 			headers = append(headers, block.Header())
 		}
 
@@ -1791,8 +1880,51 @@ func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 }
 
 // writeBlockWithState writes block, metadata and corresponding state data to the
-// database.
-func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, logs []*types.Log, statedb *state.StateDB) ([]*types.Log, error) {
+// database. The hooks recorded while a block sealed by the node was built, if
+// any, are replayed on the live tracer first, as the block isn't imported.
+func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, logs []*types.Log, statedb *state.StateDB, hooks *BlockHookRecorder) ([]*types.Log, error) {
+	if hooks != nil {
+		bc.replayBlockHooks(block, hooks)
+	}
+
+	stateSyncLogs, err := bc.writeBlockData(block, receipts, logs, statedb)
+	if err != nil {
+		return []*types.Log{}, err
+	}
+
+	if err := bc.writeBlockState(block, statedb); err != nil {
+		return []*types.Log{}, err
+	}
+
+	return stateSyncLogs, nil
+}
+
+// replayBlockHooks emits the hooks recorded while the block was built on the
+// live tracer, between the start and the end of the block.
+func (bc *BlockChain) replayBlockHooks(block *types.Block, hooks *BlockHookRecorder) {
+	if bc.logger == nil {
+		return
+	}
+
+	if bc.logger.OnBlockStart != nil {
+		bc.logger.OnBlockStart(tracing.BlockEvent{
+			Block:     block,
+			TD:        bc.GetTd(block.ParentHash(), block.NumberU64()-1),
+			Finalized: bc.CurrentFinalBlock(),
+			Safe:      bc.CurrentSafeBlock(),
+		})
+	}
+
+	hooks.recorder.replay(bc.logger)
+
+	if bc.logger.OnBlockEnd != nil {
+		bc.logger.OnBlockEnd(nil)
+	}
+}
+
+// writeBlockData writes the block and its metadata to the database, and returns
+// the state sync logs of the block. The state of the block is left uncommitted.
+func (bc *BlockChain) writeBlockData(block *types.Block, receipts []*types.Receipt, logs []*types.Log, statedb *state.StateDB) ([]*types.Log, error) {
 	// Calculate the total difficulty of the block
 	ptd := bc.GetTd(block.ParentHash(), block.NumberU64()-1)
 	if ptd == nil {
@@ -1845,24 +1977,47 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 		}
 	}
 
+	// Write the reverse lookups of the state syncs committed in the block, each
+	// of them is traceable as a transaction
+	if len(bc.stateSyncData) > 0 {
+		stateIDs := make([]uint64, 0, len(bc.stateSyncData))
+		for _, data := range bc.stateSyncData {
+			stateIDs = append(stateIDs, data.ID)
+		}
+
+		rawdb.WriteStateSyncTxLookupEntries(blockBatch, block.Hash(), block.NumberU64(), stateIDs)
+	}
+
+	// Write the system calls of the block, replayed to trace its state syncs
+	if len(bc.systemCalls) > 0 {
+		rawdb.WriteSystemCalls(blockBatch, block.Hash(), block.NumberU64(), bc.systemCalls)
+	}
+
 	rawdb.WritePreimages(blockBatch, statedb.Preimages())
 
 	if err := blockBatch.Write(); err != nil {
 		log.Crit("Failed to write block into disk", "err", err)
 	}
+
+	return stateSyncLogs, nil
+}
+
+// writeBlockState commits the state of the block, which was written already,
+// and garbage collects the tries which are no longer retained in memory.
+func (bc *BlockChain) writeBlockState(block *types.Block, statedb *state.StateDB) error {
 	// Commit all cached state changes into underlying memory database.
 	root, err := statedb.Commit(block.NumberU64(), bc.chainConfig.IsEIP158(block.Number()))
 	if err != nil {
-		return []*types.Log{}, err
+		return err
 	}
 	// If node is running in path mode, skip explicit gc operation
 	// which is unnecessary in this mode.
 	if bc.triedb.Scheme() == rawdb.PathScheme {
-		return []*types.Log{}, nil
+		return nil
 	}
 	// If we're running an archive node, always flush
 	if bc.cacheConfig.TrieDirtyDisabled {
-		return []*types.Log{}, bc.triedb.Commit(root, false)
+		return bc.triedb.Commit(root, false)
 	}
 	// Full but not archive node, do proper garbage collection
 	bc.triedb.Reference(root, common.Hash{}) // metadata reference to keep trie alive
@@ -1871,7 +2026,7 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	// Flush limits are not considered for the first TriesInMemory blocks.
 	current := block.NumberU64()
 	if current <= state.TriesInMemory {
-		return []*types.Log{}, nil
+		return nil
 	}
 	// If we exceeded our memory allowance, flush matured singleton nodes to disk
 	var (
@@ -1915,28 +2070,36 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 		bc.triedb.Dereference(root)
 	}
 
-	return stateSyncLogs, nil
+	return nil
 }
 
 // WriteBlockAndSetHead writes the given block and all associated state to the database,
-// and applies the block as the new chain head.
-func (bc *BlockChain) WriteBlockAndSetHead(ctx context.Context, block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, emitHeadEvent bool) (status WriteStatus, err error) {
+// and applies the block as the new chain head. The hooks recorded while the block
+// was built, if any, are replayed on the live tracer.
+func (bc *BlockChain) WriteBlockAndSetHead(ctx context.Context, block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, hooks *BlockHookRecorder, emitHeadEvent bool) (status WriteStatus, err error) {
 	if !bc.chainmu.TryLock() {
 		return NonStatTy, errChainStopped
 	}
 	defer bc.chainmu.Unlock()
 
-	return bc.writeBlockAndSetHead(ctx, block, receipts, logs, state, emitHeadEvent)
+	return bc.writeBlockAndSetHead(ctx, block, receipts, logs, state, hooks, emitHeadEvent)
 }
 
 // writeBlockAndSetHead is the internal implementation of WriteBlockAndSetHead.
 // This function expects the chain mutex to be held.
-func (bc *BlockChain) writeBlockAndSetHead(ctx context.Context, block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, emitHeadEvent bool) (status WriteStatus, err error) {
-	stateSyncLogs, err := bc.writeBlockWithState(block, receipts, logs, state)
+func (bc *BlockChain) writeBlockAndSetHead(ctx context.Context, block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, hooks *BlockHookRecorder, emitHeadEvent bool) (status WriteStatus, err error) {
+	stateSyncLogs, err := bc.writeBlockWithState(block, receipts, logs, state, hooks)
 	if err != nil {
 		return NonStatTy, err
 	}
 
+	return bc.setBlockHead(block, logs, stateSyncLogs, emitHeadEvent)
+}
+
+// setBlockHead applies the written block as the new chain head if it becomes
+// canonical, and sends the events of the block. This function expects the chain
+// mutex to be held.
+func (bc *BlockChain) setBlockHead(block *types.Block, logs []*types.Log, stateSyncLogs []*types.Log, emitHeadEvent bool) (status WriteStatus, err error) {
 	currentBlock := bc.CurrentBlock()
 	reorg, err := bc.forker.ReorgNeeded(currentBlock, block.Header())
 	if err != nil {
@@ -2224,6 +2387,13 @@ func (bc *BlockChain) insertChain(chain types.Blocks, setHead bool) (int, error)
 		}
 	}()
 
+	// The followup block executing on the post-state of the block being written,
+	// discarded if the import is aborted before it's reached
+	var pipelined *pipelinedBlock
+	defer func() {
+		pipelined.discard()
+	}()
+
 	// accumulator for canonical blocks
 	var canonAccum []*types.Block
 
@@ -2340,7 +2510,8 @@ func (bc *BlockChain) insertChain(chain types.Blocks, setHead bool) (int, error)
 
 		// Process block using the parent state as reference point
 		pstart := time.Now()
-		receipts, logs, usedGas, statedb, vtime, err := bc.ProcessBlock(block, parent)
+		receipts, logs, usedGas, statedb, vtime, err := bc.processPipelinedBlock(pipelined, block, parent)
+		pipelined = nil
 		activeState = statedb
 
 		if err != nil {
@@ -2397,9 +2568,22 @@ func (bc *BlockChain) insertChain(chain types.Blocks, setHead bool) (int, error)
 
 		if !setHead {
 			// Don't set the head, only insert the block
-			_, err = bc.writeBlockWithState(block, receipts, logs, statedb)
+			_, err = bc.writeBlockWithState(block, receipts, logs, statedb, nil)
 		} else {
-			status, err = bc.writeBlockAndSetHead(context.Background(), block, receipts, logs, statedb, false)
+			// Once the block itself is written, start executing the followup block
+			// on its post-state, overlapping the execution with the state commit.
+			var stateSyncLogs []*types.Log
+
+			stateSyncLogs, err = bc.writeBlockData(block, receipts, logs, statedb)
+			if err == nil {
+				if followup, ferr := it.peek(); ferr == nil {
+					pipelined = bc.pipelineBlock(followup, block.Header(), statedb)
+				}
+
+				if err = bc.writeBlockState(block, statedb); err == nil {
+					status, err = bc.setBlockHead(block, logs, stateSyncLogs, false)
+				}
+			}
 		}
 
 		followupInterrupt.Store(true)
@@ -2570,9 +2754,9 @@ func (bc *BlockChain) processBlock(block *types.Block, statedb *state.StateDB, s
 	)
 	if !setHead {
 		// Don't set the head, only insert the block
-		_, err = bc.writeBlockWithState(block, receipts, logs, statedb)
+		_, err = bc.writeBlockWithState(block, receipts, logs, statedb, nil)
 	} else {
-		status, err = bc.writeBlockAndSetHead(context.Background(), block, receipts, logs, statedb, false)
+		status, err = bc.writeBlockAndSetHead(context.Background(), block, receipts, logs, statedb, nil, false)
 	}
 	if err != nil {
 		return nil, err
@@ -2809,16 +2993,16 @@ func (bc *BlockChain) collectLogs(b *types.Block, removed bool) []*types.Log {
 		blobGasPrice = nil
 	}
 	receipts := rawdb.ReadRawReceipts(bc.db, b.Hash(), b.NumberU64())
+	if err := receipts.DeriveFields(bc.chainConfig, b.Hash(), b.NumberU64(), b.Time(), b.BaseFee(), blobGasPrice, b.Transactions()); err != nil {
+		log.Error("Failed to derive block receipts fields", "hash", b.Hash(), "number", b.NumberU64(), "err", err)
+	}
 
-	// Append bor receipt
+	// Append bor receipt, its fields are derived after the ones of the block
+	// transactions, as in the logs sent when the block was inserted
 	borReceipt := rawdb.ReadBorReceipt(bc.db, b.Hash(), b.NumberU64(), bc.chainConfig)
 	if borReceipt != nil {
 		receipts = append(receipts, borReceipt)
 	}
-
-	if err := receipts.DeriveFields(bc.chainConfig, b.Hash(), b.NumberU64(), b.Time(), b.BaseFee(), blobGasPrice, b.Transactions()); err != nil {
-		log.Error("Failed to derive block receipts fields", "hash", b.Hash(), "number", b.NumberU64(), "err", err)
-	}
 	var logs []*types.Log
 
 	for _, receipt := range receipts {
@@ -2997,6 +3181,13 @@ func (bc *BlockChain) reorg(oldHead *types.Header, newHead *types.Block) error {
 	for _, tx := range diffs {
 		rawdb.DeleteTxLookupEntry(indexesBatch, tx)
 	}
+	// The state sync tx hashes are derived from the block hash, so the lookups of
+	// the old chain are never reused by the new one
+	for _, block := range oldChain {
+		if stateIDs := rawdb.ReadStateSyncIDs(bc.db, block.Hash(), block.NumberU64()); len(stateIDs) > 0 {
+			rawdb.DeleteStateSyncTxLookupEntries(indexesBatch, block.Hash(), block.NumberU64(), stateIDs)
+		}
+	}
 	// Delete all hash markers that are not part of the new canonical chain.
 	// Because the reorg function does not handle new chain head, all hash
 	// markers greater than or equal to new chain head should be deleted.
@@ -0,0 +1,127 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// pipelinedBlock is a block executing on the in-memory post-state of its parent,
+// while the state of the parent is committed.
+type pipelinedBlock struct {
+	block  *types.Block
+	cancel context.CancelFunc
+	done   chan struct{} // Closed when the execution is finished
+
+	receipts types.Receipts
+	logs     []*types.Log
+	usedGas  uint64
+	statedb  *state.StateDB
+	vtime    time.Duration
+	err      error
+}
+
+// pipelineBlock starts executing the block on the post-state of its parent, which
+// was just processed and written but whose state is not committed yet. It returns
+// nil if the block can't be pipelined, in which case it's executed once the state
+// of the parent is committed.
+//
+// The parent state must not be committed before this function returns.
+func (bc *BlockChain) pipelineBlock(block *types.Block, parent *types.Header, parentState *state.StateDB) *pipelinedBlock {
+	if !bc.cacheConfig.PipelineImport || block == nil || block.ParentHash() != parent.Hash() {
+		return nil
+	}
+	// Live tracers expect the blocks to be reported one after another
+	if bc.logger != nil {
+		return nil
+	}
+	// The path scheme reads the trie nodes from the layer of the state root, while
+	// the pipelined state is read through the layer of the grandparent until it's
+	// rebased on the committed state of its parent
+	if bc.triedb.Scheme() != rawdb.HashScheme {
+		return nil
+	}
+	// The span and the state syncs committed at the start of a sprint are read
+	// from the committed state of the parent
+	if bor := bc.chainConfig.Bor; bor != nil && bor.IsSprintStart(block.NumberU64()) {
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var (
+		base = parentState.Fork()
+		p    = &pipelinedBlock{
+			block:  block,
+			cancel: cancel,
+			done:   make(chan struct{}),
+		}
+	)
+
+	go func() {
+		defer close(p.done)
+
+		p.receipts, p.logs, p.usedGas, p.statedb, p.vtime, p.err = bc.executeBlock(ctx, block, parent, base)
+	}()
+
+	return p
+}
+
+// discard aborts the execution of the pipelined block and waits for it to stop.
+// It's used if the parent fails to be committed or if the import is aborted.
+func (p *pipelinedBlock) discard() {
+	if p == nil {
+		return
+	}
+
+	p.cancel()
+	<-p.done
+
+	blockPipelineDiscardMeter.Mark(1)
+}
+
+// processPipelinedBlock returns the result of the pipelined execution of the
+// block if there was one, otherwise the block is processed on the committed
+// state of its parent. A failed pipelined execution is never reported as is, the
+// block is processed again on the committed state to get the definitive result.
+func (bc *BlockChain) processPipelinedBlock(p *pipelinedBlock, block *types.Block, parent *types.Header) (types.Receipts, []*types.Log, uint64, *state.StateDB, time.Duration, error) {
+	if p == nil || p.block.Hash() != block.Hash() {
+		p.discard()
+		return bc.ProcessBlock(block, parent)
+	}
+
+	defer p.cancel()
+	<-p.done
+
+	if p.err != nil {
+		log.Debug("Pipelined block execution failed", "number", block.Number(), "hash", block.Hash(), "err", p.err)
+		blockPipelineFailMeter.Mark(1)
+
+		return bc.ProcessBlock(block, parent)
+	}
+
+	// The block was executed on the state of its parent before it was committed,
+	// move it on the committed state so that it only commits its own changes
+	if err := p.statedb.Rebase(parent.Root); err != nil {
+		log.Debug("Pipelined block state rebase failed", "number", block.Number(), "hash", block.Hash(), "err", err)
+		blockPipelineFailMeter.Mark(1)
+
+		return bc.ProcessBlock(block, parent)
+	}
+
+	if root := p.statedb.IntermediateRoot(bc.chainConfig.IsEIP158(block.Number())); root != block.Root() {
+		log.Debug("Pipelined block state root mismatch after rebase", "number", block.Number(), "hash", block.Hash(), "have", root, "want", block.Root())
+		blockPipelineFailMeter.Mark(1)
+
+		return bc.ProcessBlock(block, parent)
+	}
+
+	blockPipelineHitMeter.Mark(1)
+	bc.pipelineHits.Add(1)
+
+	return p.receipts, p.logs, p.usedGas, p.statedb, p.vtime, nil
+}
@@ -0,0 +1,280 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// newPipelineTestChain generates a chain whose blocks read the hash of their
+// parent, write the storage of a contract and emit a log.
+func newPipelineTestChain(t *testing.T, n int) (*Genesis, []*types.Block) {
+	t.Helper()
+
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		account = common.HexToAddress("0xaa")
+
+		// slot[number] = blockhash(number - 1), then log0
+		code = []byte{
+			byte(vm.PUSH1), 1, byte(vm.NUMBER), byte(vm.SUB), byte(vm.BLOCKHASH),
+			byte(vm.NUMBER), byte(vm.SSTORE),
+			byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.LOG0),
+		}
+		gspec = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr:    {Balance: big.NewInt(params.Ether)},
+				account: {Code: code},
+			},
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+
+	_, blocks, _ := GenerateChainWithGenesis(gspec, ethash.NewFaker(), n, func(i int, gen *BlockGen) {
+		tx, err := types.SignTx(types.NewTransaction(gen.TxNonce(addr), account, common.Big0, 100000, gen.header.BaseFee, nil), signer, key)
+		if err != nil {
+			t.Fatalf("failed to create tx: %v", err)
+		}
+
+		gen.AddTx(tx)
+	})
+
+	return gspec, blocks
+}
+
+// pipelinedBlocks returns the number of blocks expected to be pipelined when the
+// blocks are imported at once, i.e. all but the first one and the sprint starts.
+func pipelinedBlocks(config *params.ChainConfig, blocks []*types.Block) uint64 {
+	var n uint64
+
+	for _, block := range blocks[1:] {
+		if config.Bor == nil || !config.Bor.IsSprintStart(block.NumberU64()) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// newPipelineTestBlockChain creates a blockchain importing the blocks pipelined.
+func newPipelineTestBlockChain(t *testing.T, gspec *Genesis) *BlockChain {
+	t.Helper()
+
+	cacheConfig := DefaultCacheConfigWithScheme(rawdb.HashScheme)
+	cacheConfig.PipelineImport = true
+
+	blockchain, err := NewBlockChain(rawdb.NewMemoryDatabase(), cacheConfig, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+
+	return blockchain
+}
+
+func TestPipelinedBlockImport(t *testing.T) {
+	t.Parallel()
+
+	gspec, blocks := newPipelineTestChain(t, 8)
+
+	blockchain := newPipelineTestBlockChain(t, gspec)
+	defer blockchain.Stop()
+
+	events := make(chan ChainEvent, len(blocks))
+	sub := blockchain.SubscribeChainEvent(events)
+
+	defer sub.Unsubscribe()
+
+	if n, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert block %d: %v", n, err)
+	}
+
+	if head := blockchain.CurrentBlock(); head.Hash() != blocks[len(blocks)-1].Hash() {
+		t.Fatalf("head mismatch: have %d, want %d", head.Number, blocks[len(blocks)-1].Number())
+	}
+
+	// The blocks are executed while their parent is committed
+	if have, want := blockchain.pipelineHits.Load(), pipelinedBlocks(gspec.Config, blocks); have != want {
+		t.Fatalf("pipelined blocks mismatch: have %d, want %d", have, want)
+	}
+
+	for _, block := range blocks {
+		if !blockchain.HasState(block.Root()) {
+			t.Fatalf("missing state of block %d", block.NumberU64())
+		}
+	}
+
+	// The snapshot layer of each block is based on the one of its parent
+	layers := blockchain.snaps.Snapshots(blocks[len(blocks)-1].Root(), len(blocks), false)
+	if len(layers) != len(blocks) {
+		t.Fatalf("snapshot layers mismatch: have %d, want %d", len(layers), len(blocks))
+	}
+
+	for i, layer := range layers {
+		if want := blocks[len(blocks)-1-i].Root(); layer.Root() != want {
+			t.Fatalf("snapshot layer %d mismatch: have %x, want %x", i, layer.Root(), want)
+		}
+	}
+
+	// The logs of the pipelined blocks must not follow on from the logs of their
+	// parent in the state they were executed on
+	for range blocks {
+		ev := <-events
+		if len(ev.Logs) != 1 || ev.Logs[0].Index != 0 {
+			t.Fatalf("wrong logs of block %d: %v", ev.Block.NumberU64(), ev.Logs)
+		}
+	}
+
+	// Pipeline a block on the state of its parent directly
+	statedb, err := blockchain.StateAt(blocks[0].Root())
+	if err != nil {
+		t.Fatalf("failed to open state: %v", err)
+	}
+
+	p := blockchain.pipelineBlock(blocks[1], blocks[0].Header(), statedb)
+	if p == nil {
+		t.Fatal("block not pipelined")
+	}
+
+	_, _, _, pipelined, _, err := blockchain.processPipelinedBlock(p, blocks[1], blocks[0].Header())
+	if err != nil {
+		t.Fatalf("failed to process pipelined block: %v", err)
+	}
+
+	if root := pipelined.IntermediateRoot(true); root != blocks[1].Root() {
+		t.Fatalf("pipelined state root mismatch: have %x, want %x", root, blocks[1].Root())
+	}
+}
+
+// TestPipelinedBlockImportDestruct tests that the pipelined blocks read the
+// storage deleted and the accounts destructed by their parent as they are once
+// the parent is committed.
+func TestPipelinedBlockImportDestruct(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		store   = common.HexToAddress("0xbb")
+		factory = common.HexToAddress("0xff")
+
+		// slot[0] = number if slot[0] is empty, otherwise slot[0] is deleted
+		storeCode = []byte{
+			byte(vm.PUSH1), 0, byte(vm.SLOAD), byte(vm.PUSH1), 11, byte(vm.JUMPI),
+			byte(vm.NUMBER), byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+			byte(vm.JUMPDEST), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.SSTORE), byte(vm.STOP),
+		}
+		// create2(calldata, salt 0)
+		factoryCode = []byte{
+			byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CALLDATACOPY),
+			byte(vm.PUSH1), 0, byte(vm.CALLDATASIZE), byte(vm.PUSH1), 0, byte(vm.PUSH1), 0, byte(vm.CREATE2),
+			byte(vm.POP), byte(vm.STOP),
+		}
+		// slot[1] = slot[0] + 1, slot[0] = 1, then deploy selfdestruct(caller)
+		initCode = []byte{
+			byte(vm.PUSH1), 0, byte(vm.SLOAD), byte(vm.PUSH1), 1, byte(vm.ADD), byte(vm.PUSH1), 1, byte(vm.SSTORE),
+			byte(vm.PUSH1), 1, byte(vm.PUSH1), 0, byte(vm.SSTORE),
+			byte(vm.PUSH2), byte(vm.CALLER), byte(vm.SELFDESTRUCT), byte(vm.PUSH1), 0, byte(vm.MSTORE),
+			byte(vm.PUSH1), 2, byte(vm.PUSH1), 30, byte(vm.RETURN),
+		}
+		created = crypto.CreateAddress2(factory, common.Hash{}, crypto.Keccak256(initCode))
+
+		gspec = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr:    {Balance: big.NewInt(params.Ether)},
+				store:   {Code: storeCode, Storage: map[common.Hash]common.Hash{{}: {1}}},
+				factory: {Code: factoryCode},
+			},
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+
+	// Every block flips the slot of the store, and either creates the contract
+	// destructed by its parent or destructs the one created by its parent
+	_, blocks, _ := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 8, func(i int, gen *BlockGen) {
+		txs := []*types.Transaction{
+			types.NewTransaction(gen.TxNonce(addr), store, common.Big0, 100000, gen.header.BaseFee, nil),
+			types.NewTransaction(gen.TxNonce(addr)+1, created, common.Big0, 100000, gen.header.BaseFee, nil),
+		}
+		if i%2 == 0 {
+			txs[1] = types.NewTransaction(gen.TxNonce(addr)+1, factory, common.Big0, 200000, gen.header.BaseFee, initCode)
+		}
+
+		for _, tx := range txs {
+			signed, err := types.SignTx(tx, signer, key)
+			if err != nil {
+				t.Fatalf("failed to sign tx: %v", err)
+			}
+
+			gen.AddTx(signed)
+		}
+	})
+
+	blockchain := newPipelineTestBlockChain(t, gspec)
+	defer blockchain.Stop()
+
+	if n, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert block %d: %v", n, err)
+	}
+
+	// The blocks executed on a stale view of their parent would be executed
+	// again once the state root mismatches
+	if have, want := blockchain.pipelineHits.Load(), pipelinedBlocks(gspec.Config, blocks); have != want {
+		t.Fatalf("pipelined blocks mismatch: have %d, want %d", have, want)
+	}
+
+	statedb, err := blockchain.State()
+	if err != nil {
+		t.Fatalf("failed to open state: %v", err)
+	}
+
+	if statedb.Exist(created) {
+		t.Fatal("destructed contract exists")
+	}
+
+	// The last block found the slot deleted by its parent
+	if have, want := statedb.GetState(store, common.Hash{}), common.BigToHash(blocks[len(blocks)-1].Number()); have != want {
+		t.Fatalf("store slot mismatch: have %x, want %x", have, want)
+	}
+}
+
+func TestPipelinedBlockImportInvalid(t *testing.T) {
+	t.Parallel()
+
+	gspec, blocks := newPipelineTestChain(t, 4)
+
+	// Corrupt the state root of the last block, which is executed on the state
+	// of its parent before it's committed
+	header := blocks[3].Header()
+	header.Root = common.Hash{1}
+	blocks[3] = blocks[3].WithSeal(header)
+
+	blockchain := newPipelineTestBlockChain(t, gspec)
+	defer blockchain.Stop()
+
+	n, err := blockchain.InsertChain(blocks)
+	if err == nil {
+		t.Fatal("invalid block imported")
+	}
+
+	if n != 3 {
+		t.Fatalf("wrong failed block: have %d, want %d", n, 3)
+	}
+
+	if head := blockchain.CurrentBlock(); head.Hash() != blocks[2].Hash() {
+		t.Fatalf("head mismatch: have %d, want %d", head.Number, blocks[2].Number())
+	}
+
+	if !blockchain.HasState(blocks[2].Root()) {
+		t.Fatal("missing state of the last valid block")
+	}
+}
@@ -519,6 +519,7 @@ func (bc *BlockChain) DB() ethdb.Database {
 
 type BorStateSyncer interface {
 	SetStateSync(stateData []*types.StateSyncData)
+	SetSystemCalls(calls []types.SystemCall)
 	SubscribeStateSyncEvent(ch chan<- StateSyncEvent) event.Subscription
 }
 
@@ -527,6 +528,12 @@ func (bc *BlockChain) SetStateSync(stateData []*types.StateSyncData) {
 	bc.stateSyncData = stateData
 }
 
+// SetSystemCalls sets the system calls applied by the consensus engine at the end
+// of the block being processed, stored along with the block.
+func (bc *BlockChain) SetSystemCalls(calls []types.SystemCall) {
+	bc.systemCalls = calls
+}
+
 func (bc *BlockChain) GetStateSync() []*types.StateSyncData {
 	return bc.stateSyncData
 }
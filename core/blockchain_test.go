@@ -24,6 +24,7 @@ import (
 	"math/rand"
 	"os"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -286,6 +288,58 @@ func testSuccessfulBlockImportParallelFailed(t *testing.T, scheme string) {
 	}
 }
 
+// recordingParallelStateProcessor records whether the parallel state processor
+// was run.
+type recordingParallelStateProcessor struct {
+	Processor
+	run atomic.Bool
+}
+
+func (p *recordingParallelStateProcessor) Process(block *types.Block, statedb *state.StateDB, cfg vm.Config, interruptCtx context.Context) (types.Receipts, []*types.Log, uint64, error) {
+	p.run.Store(true)
+	return p.Processor.Process(block, statedb, cfg, interruptCtx)
+}
+
+// Tests that a live tracer of the opcodes is attached to the serial processor
+// alone, instead of recording the opcodes of both processors.
+func TestBlockImportOpcodeTracer(t *testing.T) {
+	t.Parallel()
+
+	gspec, blocks := newPipelineTestChain(t, 4)
+
+	var (
+		opcodes, txs int
+		hooks        = &tracing.Hooks{
+			OnTxStart: func(*tracing.VMContext, *types.Transaction, common.Address) { txs++ },
+			OnOpcode: func(uint64, byte, uint64, uint64, tracing.OpContext, []byte, int, error) {
+				opcodes++
+			},
+		}
+	)
+
+	blockchain, err := NewBlockChain(rawdb.NewMemoryDatabase(), DefaultCacheConfigWithScheme(rawdb.HashScheme), gspec, nil, ethash.NewFaker(), vm.Config{Tracer: hooks}, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create blockchain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	parallel := &recordingParallelStateProcessor{Processor: NewParallelStateProcessor(blockchain.chainConfig, blockchain, blockchain.engine)}
+	blockchain.parallelProcessor = parallel
+
+	if n, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert block %d: %v", n, err)
+	}
+
+	if parallel.run.Load() {
+		t.Fatal("parallel processor run with an opcode tracer")
+	}
+
+	// Each transaction runs the opcodes of the contract once
+	if txs != len(blocks) || opcodes == 0 || opcodes%txs != 0 {
+		t.Fatalf("wrong hooks: have %d txs and %d opcodes", txs, opcodes)
+	}
+}
+
 // testHeaderChainImport tries to process a chain of header, writing them into
 // the database if successful.
 func testHeaderChainImport(chain []*types.Header, blockchain *BlockChain) error {
@@ -0,0 +1,305 @@
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/holiman/uint256"
+)
+
+// hookRecorder records the transaction and state hooks emitted by an
+// execution so they can be replayed later on the live tracer. The parallel
+// state processor executes the transactions speculatively and out of order,
+// their hooks are only replayed once the transactions are settled, in the
+// order of the block.
+type hookRecorder struct {
+	// hooks are the hooks recording into the recorder. Only the hooks set
+	// in the live tracer are set, so the EVM skips the others.
+	hooks *tracing.Hooks
+
+	events []func(*tracing.Hooks)
+}
+
+// newHookRecorder returns a recorder for the hooks set in live
+// nolint:gocognit
+func newHookRecorder(live *tracing.Hooks) *hookRecorder {
+	r := &hookRecorder{hooks: &tracing.Hooks{}}
+
+	if live.OnTxStart != nil {
+		r.hooks.OnTxStart = func(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+			vmCopy := *vm
+
+			r.record(func(h *tracing.Hooks) {
+				if h.OnTxStart != nil {
+					h.OnTxStart(&vmCopy, tx, from)
+				}
+			})
+		}
+	}
+
+	if live.OnTxEnd != nil {
+		r.hooks.OnTxEnd = func(receipt *types.Receipt, err error) {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnTxEnd != nil {
+					h.OnTxEnd(receipt, err)
+				}
+			})
+		}
+	}
+
+	if live.OnEnter != nil {
+		r.hooks.OnEnter = func(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+			input, value = common.CopyBytes(input), copyBig(value)
+
+			r.record(func(h *tracing.Hooks) {
+				if h.OnEnter != nil {
+					h.OnEnter(depth, typ, from, to, input, gas, value)
+				}
+			})
+		}
+	}
+
+	if live.OnExit != nil {
+		r.hooks.OnExit = func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+			output = common.CopyBytes(output)
+
+			r.record(func(h *tracing.Hooks) {
+				if h.OnExit != nil {
+					h.OnExit(depth, output, gasUsed, err, reverted)
+				}
+			})
+		}
+	}
+
+	if live.OnOpcode != nil {
+		r.hooks.OnOpcode = func(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+			scope, rData = newOpContextCopy(scope), common.CopyBytes(rData)
+
+			r.record(func(h *tracing.Hooks) {
+				if h.OnOpcode != nil {
+					h.OnOpcode(pc, op, gas, cost, scope, rData, depth, err)
+				}
+			})
+		}
+	}
+
+	if live.OnFault != nil {
+		r.hooks.OnFault = func(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, depth int, err error) {
+			scope = newOpContextCopy(scope)
+
+			r.record(func(h *tracing.Hooks) {
+				if h.OnFault != nil {
+					h.OnFault(pc, op, gas, cost, scope, depth, err)
+				}
+			})
+		}
+	}
+
+	if live.OnGasChange != nil {
+		r.hooks.OnGasChange = func(old, new uint64, reason tracing.GasChangeReason) {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnGasChange != nil {
+					h.OnGasChange(old, new, reason)
+				}
+			})
+		}
+	}
+
+	if live.OnSystemCallStart != nil {
+		r.hooks.OnSystemCallStart = func() {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnSystemCallStart != nil {
+					h.OnSystemCallStart()
+				}
+			})
+		}
+	}
+
+	if live.OnSystemCallEnd != nil {
+		r.hooks.OnSystemCallEnd = func() {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnSystemCallEnd != nil {
+					h.OnSystemCallEnd()
+				}
+			})
+		}
+	}
+
+	if live.OnBalanceChange != nil {
+		r.hooks.OnBalanceChange = func(addr common.Address, prev, new *big.Int, reason tracing.BalanceChangeReason) {
+			prev, new = copyBig(prev), copyBig(new)
+
+			r.record(func(h *tracing.Hooks) {
+				if h.OnBalanceChange != nil {
+					h.OnBalanceChange(addr, prev, new, reason)
+				}
+			})
+		}
+	}
+
+	if live.OnNonceChange != nil {
+		r.hooks.OnNonceChange = func(addr common.Address, prev, new uint64) {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnNonceChange != nil {
+					h.OnNonceChange(addr, prev, new)
+				}
+			})
+		}
+	}
+
+	if live.OnCodeChange != nil {
+		r.hooks.OnCodeChange = func(addr common.Address, prevCodeHash common.Hash, prevCode []byte, codeHash common.Hash, code []byte) {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnCodeChange != nil {
+					h.OnCodeChange(addr, prevCodeHash, prevCode, codeHash, code)
+				}
+			})
+		}
+	}
+
+	if live.OnStorageChange != nil {
+		r.hooks.OnStorageChange = func(addr common.Address, slot common.Hash, prev, new common.Hash) {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnStorageChange != nil {
+					h.OnStorageChange(addr, slot, prev, new)
+				}
+			})
+		}
+	}
+
+	// The log is replayed as it is when the hooks are replayed, the parallel
+	// state processor sets its final index and block fields when settling
+	if live.OnLog != nil {
+		r.hooks.OnLog = func(log *types.Log) {
+			r.record(func(h *tracing.Hooks) {
+				if h.OnLog != nil {
+					h.OnLog(log)
+				}
+			})
+		}
+	}
+
+	return r
+}
+
+func (r *hookRecorder) record(event func(*tracing.Hooks)) {
+	r.events = append(r.events, event)
+}
+
+// reset drops the recorded hooks
+func (r *hookRecorder) reset() {
+	r.events = r.events[:0]
+}
+
+// replay emits the recorded hooks on hooks, in the order they were recorded
+func (r *hookRecorder) replay(hooks *tracing.Hooks) {
+	for _, event := range r.events {
+		event(hooks)
+	}
+}
+
+// truncate drops the hooks recorded after the first n ones
+func (r *hookRecorder) truncate(n int) {
+	r.events = r.events[:n]
+}
+
+// BlockHookRecorder records the hooks of the live tracer of the chain emitted
+// while a block is built. The blocks sealed by the node are written with the
+// state they were built on rather than executed again, the recorded hooks are
+// replayed on the live tracer when they're written.
+type BlockHookRecorder struct {
+	live     *tracing.Hooks
+	recorder *hookRecorder
+}
+
+// NewBlockHookRecorder returns a recorder for the hooks of the live tracer of
+// the chain, or nil if the chain has no live tracer.
+func (bc *BlockChain) NewBlockHookRecorder() *BlockHookRecorder {
+	if bc.logger == nil {
+		return nil
+	}
+
+	return &BlockHookRecorder{live: bc.logger, recorder: newHookRecorder(bc.logger)}
+}
+
+// Hooks returns the hooks recording into the recorder, nil if the recorder is.
+func (r *BlockHookRecorder) Hooks() *tracing.Hooks {
+	if r == nil {
+		return nil
+	}
+
+	return r.recorder.hooks
+}
+
+// Len returns the number of hooks recorded so far.
+func (r *BlockHookRecorder) Len() int {
+	if r == nil {
+		return 0
+	}
+
+	return len(r.recorder.events)
+}
+
+// Truncate drops the hooks recorded after the first n ones, the ones of a
+// transaction which is left out of the block.
+func (r *BlockHookRecorder) Truncate(n int) {
+	if r != nil {
+		r.recorder.truncate(n)
+	}
+}
+
+// Copy returns a recorder with the hooks recorded so far, recording apart from r.
+func (r *BlockHookRecorder) Copy() *BlockHookRecorder {
+	if r == nil {
+		return nil
+	}
+
+	cpy := &BlockHookRecorder{live: r.live, recorder: newHookRecorder(r.live)}
+	cpy.recorder.events = append(cpy.recorder.events, r.recorder.events...)
+
+	return cpy
+}
+
+func copyBig(x *big.Int) *big.Int {
+	if x == nil {
+		return nil
+	}
+
+	return new(big.Int).Set(x)
+}
+
+// opContextCopy is a copy of the scope of an opcode, the memory and stack
+// of the scope change as the execution goes on
+type opContextCopy struct {
+	memory  []byte
+	stack   []uint256.Int
+	caller  common.Address
+	address common.Address
+	value   *uint256.Int
+	input   []byte
+}
+
+func newOpContextCopy(scope tracing.OpContext) *opContextCopy {
+	c := &opContextCopy{
+		memory:  common.CopyBytes(scope.MemoryData()),
+		stack:   append([]uint256.Int(nil), scope.StackData()...),
+		caller:  scope.Caller(),
+		address: scope.Address(),
+		input:   common.CopyBytes(scope.CallInput()),
+	}
+
+	if value := scope.CallValue(); value != nil {
+		c.value = value.Clone()
+	}
+
+	return c
+}
+
+func (c *opContextCopy) MemoryData() []byte       { return c.memory }
+func (c *opContextCopy) StackData() []uint256.Int { return c.stack }
+func (c *opContextCopy) Caller() common.Address   { return c.caller }
+func (c *opContextCopy) Address() common.Address  { return c.address }
+func (c *opContextCopy) CallValue() *uint256.Int  { return c.value }
+func (c *opContextCopy) CallInput() []byte        { return c.input }
@@ -0,0 +1,189 @@
+package core
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+const (
+	// logIndexThrottling is the time to wait between processing two consecutive
+	// index sections.
+	logIndexThrottling = 100 * time.Millisecond
+)
+
+// LogIndexer implements a core.ChainIndexer, building up an inverted index of
+// the addresses and topics of the logs to the blocks having them. Unlike the
+// bloom bits, which saturate on blocks with many logs, the index is exact, so
+// filtering logs only retrieves the receipts of the blocks with matching logs.
+// The logs of the bor block receipts are indexed along with the other logs.
+type LogIndexer struct {
+	db    ethdb.Database
+	batch ethdb.Batch
+}
+
+// NewLogIndexer returns a chain indexer that generates the log index of the
+// canonical chain.
+func NewLogIndexer(db ethdb.Database, size, confirms uint64) *ChainIndexer {
+	backend := &LogIndexer{
+		db: db,
+	}
+	table := rawdb.NewTable(db, string(rawdb.LogIndexIndexPrefix))
+
+	return NewChainIndexer(db, table, backend, size, confirms, logIndexThrottling, "logindex")
+}
+
+// Reset implements core.ChainIndexerBackend, starting a new log index section.
+func (b *LogIndexer) Reset(ctx context.Context, section uint64, lastSectionHead common.Hash) error {
+	b.batch = b.db.NewBatch()
+
+	return nil
+}
+
+// Process implements core.ChainIndexerBackend, adding the logs of a new
+// header's block into the index.
+func (b *LogIndexer) Process(ctx context.Context, header *types.Header) error {
+	var (
+		hash, number = header.Hash(), header.Number.Uint64()
+		sources      = make(map[rawdb.LogIndexKey]uint8)
+	)
+
+	add := func(logs []*types.Log, source uint8) {
+		for _, log := range logs {
+			sources[rawdb.LogIndexAddressKey(log.Address)] |= source
+
+			for i, topic := range log.Topics {
+				sources[rawdb.LogIndexTopicKey(i, topic)] |= source
+			}
+		}
+	}
+
+	for _, receipt := range rawdb.ReadRawReceipts(b.db, hash, number) {
+		add(receipt.Logs, rawdb.LogIndexReceipts)
+	}
+
+	if receipt := rawdb.ReadRawBorReceipt(b.db, hash, number); receipt != nil {
+		add(receipt.Logs, rawdb.LogIndexBorReceipt)
+	}
+
+	for key, source := range sources {
+		rawdb.WriteLogIndexEntry(b.batch, key, &rawdb.LogIndexEntry{Number: number, Hash: hash, Sources: source})
+	}
+
+	// The entries of a canonical block are valid as soon as they're written,
+	// flush them to keep the batch small
+	if b.batch.ValueSize() > ethdb.IdealBatchSize {
+		if err := b.batch.Write(); err != nil {
+			return err
+		}
+
+		b.batch.Reset()
+	}
+
+	return nil
+}
+
+// Commit implements core.ChainIndexerBackend, finalizing the log index section.
+func (b *LogIndexer) Commit() error {
+	return b.batch.Write()
+}
+
+// Prune returns an empty error since we don't support pruning here.
+func (b *LogIndexer) Prune(threshold uint64) error {
+	return nil
+}
+
+// ReadLogIndexMatches returns the canonical blocks between from and to which
+// have logs matching the addresses and topics criteria, along with where their
+// matching logs are. It returns false if the criteria match any log, in which
+// case the index can't narrow the blocks down.
+func ReadLogIndexMatches(db ethdb.Database, from, to uint64, addresses []common.Address, topics [][]common.Hash) ([]*rawdb.LogIndexEntry, bool) {
+	// A log matches if it matches one value of each of the criteria
+	var criteria [][]rawdb.LogIndexKey
+
+	if len(addresses) > 0 {
+		keys := make([]rawdb.LogIndexKey, len(addresses))
+		for i, address := range addresses {
+			keys[i] = rawdb.LogIndexAddressKey(address)
+		}
+
+		criteria = append(criteria, keys)
+	}
+
+	for i, sub := range topics {
+		if len(sub) == 0 {
+			continue
+		}
+
+		keys := make([]rawdb.LogIndexKey, len(sub))
+		for j, topic := range sub {
+			keys[j] = rawdb.LogIndexTopicKey(i, topic)
+		}
+
+		criteria = append(criteria, keys)
+	}
+
+	if len(criteria) == 0 {
+		return nil, false
+	}
+
+	// The index has entries of reorged blocks, only the canonical ones are kept
+	canonical := make(map[uint64]common.Hash)
+
+	var matches map[uint64]*rawdb.LogIndexEntry
+
+	for i, keys := range criteria {
+		blocks := make(map[uint64]*rawdb.LogIndexEntry)
+
+		for _, key := range keys {
+			for _, entry := range rawdb.ReadLogIndexEntries(db, key, from, to) {
+				hash, ok := canonical[entry.Number]
+				if !ok {
+					hash = rawdb.ReadCanonicalHash(db, entry.Number)
+					canonical[entry.Number] = hash
+				}
+
+				if entry.Hash != hash {
+					continue
+				}
+
+				if block, ok := blocks[entry.Number]; ok {
+					block.Sources |= entry.Sources
+				} else {
+					blocks[entry.Number] = entry
+				}
+			}
+		}
+
+		if i == 0 {
+			matches = blocks
+		} else {
+			// The logs of the receipts and the bor receipt are matched separately
+			for number, match := range matches {
+				if block, ok := blocks[number]; ok && match.Sources&block.Sources != 0 {
+					match.Sources &= block.Sources
+				} else {
+					delete(matches, number)
+				}
+			}
+		}
+
+		if len(matches) == 0 {
+			break
+		}
+	}
+
+	entries := make([]*rawdb.LogIndexEntry, 0, len(matches))
+	for _, match := range matches {
+		entries = append(entries, match)
+	}
+
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Number < entries[j].Number })
+
+	return entries, true
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogIndex(t *testing.T) {
+	t.Parallel()
+
+	var (
+		key, _  = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		sender  = crypto.PubkeyToAddress(key.PublicKey)
+		logger  = common.HexToAddress("0x1099e4")
+		topic   = common.HexToHash("0x01")
+		other   = common.HexToHash("0x02")
+		borAddr = common.HexToAddress("0x1001")
+		gspec   = &Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				sender: {Balance: big.NewInt(params.Ether)},
+				// logger emits a log with topic 0x01
+				logger: {Code: common.FromHex("0x600160006000a100")},
+			},
+		}
+		signer = types.LatestSigner(gspec.Config)
+	)
+
+	_, blocks, _ := GenerateChainWithGenesis(gspec, ethash.NewFaker(), 4, func(i int, b *BlockGen) {
+		if i%2 == 1 {
+			return
+		}
+
+		tx, _ := types.SignTx(types.NewTransaction(b.TxNonce(sender), logger, big.NewInt(0), 100000, b.header.BaseFee, nil), signer, key)
+		b.AddTx(tx)
+	})
+
+	db := rawdb.NewMemoryDatabase()
+
+	chain, err := NewBlockChain(db, nil, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	// Blocks 2 and 3 have a bor receipt, with a log of the logger in block 2
+	rawdb.WriteBorReceipt(db, blocks[1].Hash(), 2, &types.ReceiptForStorage{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{{Address: logger, Topics: []common.Hash{other}}},
+	})
+	rawdb.WriteBorReceipt(db, blocks[2].Hash(), 3, &types.ReceiptForStorage{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{{Address: borAddr, Topics: []common.Hash{other}}},
+	})
+
+	indexer := &LogIndexer{db: db}
+
+	require.NoError(t, indexer.Reset(context.Background(), 0, common.Hash{}))
+
+	for number := uint64(1); number <= 4; number++ {
+		require.NoError(t, indexer.Process(context.Background(), chain.GetHeaderByNumber(number)))
+	}
+
+	require.NoError(t, indexer.Commit())
+
+	numbers := func(entries []*rawdb.LogIndexEntry) (numbers []uint64) {
+		for _, entry := range entries {
+			numbers = append(numbers, entry.Number)
+		}
+
+		return numbers
+	}
+
+	// Without criteria the index can't narrow the blocks down
+	_, ok := ReadLogIndexMatches(db, 0, 4, nil, [][]common.Hash{{}})
+	require.False(t, ok)
+
+	entries, ok := ReadLogIndexMatches(db, 0, 4, []common.Address{logger}, nil)
+	require.True(t, ok)
+	require.Equal(t, []uint64{1, 2, 3}, numbers(entries))
+	require.Equal(t, rawdb.LogIndexReceipts, entries[0].Sources)
+	require.Equal(t, rawdb.LogIndexBorReceipt, entries[1].Sources)
+
+	// The criteria are matched within the logs of the same source
+	entries, ok = ReadLogIndexMatches(db, 0, 4, []common.Address{logger}, [][]common.Hash{{topic}})
+	require.True(t, ok)
+	require.Equal(t, []uint64{1, 3}, numbers(entries))
+
+	entries, ok = ReadLogIndexMatches(db, 0, 4, []common.Address{borAddr}, [][]common.Hash{{topic}})
+	require.True(t, ok)
+	require.Empty(t, entries)
+
+	entries, ok = ReadLogIndexMatches(db, 0, 4, []common.Address{logger, borAddr}, [][]common.Hash{{topic, other}})
+	require.True(t, ok)
+	require.Equal(t, []uint64{1, 2, 3}, numbers(entries))
+	require.Equal(t, rawdb.LogIndexBorReceipt, entries[1].Sources)
+	require.Equal(t, rawdb.LogIndexReceipts|rawdb.LogIndexBorReceipt, entries[2].Sources)
+
+	entries, ok = ReadLogIndexMatches(db, 2, 2, []common.Address{logger}, nil)
+	require.True(t, ok)
+	require.Equal(t, []uint64{2}, numbers(entries))
+
+	// The entries of reorged blocks are skipped
+	rawdb.WriteLogIndexEntry(db, rawdb.LogIndexAddressKey(logger), &rawdb.LogIndexEntry{Number: 4, Hash: common.Hash{1}, Sources: rawdb.LogIndexReceipts})
+
+	entries, ok = ReadLogIndexMatches(db, 0, 4, []common.Address{logger}, nil)
+	require.True(t, ok)
+	require.Equal(t, []uint64{1, 2, 3}, numbers(entries))
+}
@@ -40,6 +40,7 @@ import (
 type ParallelEVMConfig struct {
 	Enable               bool
 	SpeculativeProcesses int
+	Pipeline             bool // Whether to execute each imported block while its parent is committed
 }
 
 // StateProcessor is a basic Processor, which takes care of transitioning
@@ -91,6 +92,12 @@ type ExecutionTask struct {
 	dependencies []int
 	coinbase     common.Address
 	blockContext vm.BlockContext
+
+	// txTracer records the hooks of the current incarnation of the task,
+	// they are replayed on blockTracer when the task is settled. Both are
+	// nil when there is no live tracer.
+	txTracer    *hookRecorder
+	blockTracer *hookRecorder
 }
 
 func (task *ExecutionTask) Execute(mvh *blockstm.MVHashMap, incarnation int) (err error) {
@@ -99,6 +106,11 @@ func (task *ExecutionTask) Execute(mvh *blockstm.MVHashMap, incarnation int) (er
 	task.statedb.SetMVHashmap(mvh)
 	task.statedb.SetIncarnation(incarnation)
 
+	if task.txTracer != nil {
+		task.txTracer.reset()
+		task.statedb.SetLogger(task.txTracer.hooks)
+	}
+
 	evm := vm.NewEVM(task.blockContext, vm.TxContext{}, task.statedb, task.config, task.evmConfig)
 
 	// Create a new context to be used in the EVM environment.
@@ -180,12 +192,36 @@ func (task *ExecutionTask) Settle() {
 
 	coinbaseBalance := task.finalStateDB.GetBalance(task.coinbase)
 
+	// The writes and logs of the transaction were already traced during its
+	// execution, they are replayed below once the logs have their final index
+	if task.blockTracer != nil {
+		task.finalStateDB.SetLogger(nil)
+	}
+
 	task.finalStateDB.ApplyMVWriteSet(task.statedb.MVFullWriteList())
 
 	for _, l := range task.statedb.GetLogs(task.tx.Hash(), task.blockNumber.Uint64(), task.blockHash) {
 		task.finalStateDB.AddLog(l)
 	}
 
+	if task.blockTracer != nil {
+		task.finalStateDB.SetLogger(task.blockTracer.hooks)
+
+		if hooks := task.blockTracer.hooks; hooks.OnTxStart != nil {
+			hooks.OnTxStart(&tracing.VMContext{
+				Coinbase:    task.blockContext.Coinbase,
+				BlockNumber: task.blockContext.BlockNumber,
+				Time:        task.blockContext.Time,
+				Random:      task.blockContext.Random,
+				GasPrice:    task.msg.GasPrice,
+				ChainConfig: task.config,
+				StateDB:     task.statedb,
+			}, task.tx, task.msg.From)
+		}
+
+		task.txTracer.replay(task.blockTracer.hooks)
+	}
+
 	if *task.shouldDelayFeeCal {
 		if task.config.IsLondon(task.blockNumber) {
 			task.finalStateDB.AddBalance(task.result.BurntContractAddress, cmath.BigIntToUint256Int(task.result.FeeBurnt), tracing.BalanceChangeTransfer)
@@ -252,6 +288,10 @@ func (task *ExecutionTask) Settle() {
 
 	*task.receipts = append(*task.receipts, receipt)
 	*task.allLogs = append(*task.allLogs, receipt.Logs...)
+
+	if task.blockTracer != nil && task.blockTracer.hooks.OnTxEnd != nil {
+		task.blockTracer.hooks.OnTxEnd(receipt, nil)
+	}
 }
 
 var parallelizabilityTimer = metrics.NewRegisteredTimer("block/parallelizability", nil)
@@ -301,6 +341,19 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 
 	blockContext := NewEVMBlockContext(header, p.bc, nil)
 
+	// The transactions are executed speculatively and out of order, the
+	// hooks of the live tracer are recorded and only emitted once the whole
+	// block is executed, in the order of the block
+	var (
+		hooks       = cfg.Tracer
+		blockTracer *hookRecorder
+	)
+
+	if hooks != nil {
+		blockTracer = newHookRecorder(hooks)
+		statedb.SetLogger(blockTracer.hooks)
+	}
+
 	// Iterate over and process the individual transactions
 	for i, tx := range block.Transactions() {
 		msg, err := TransactionToMessage(tx, types.MakeSigner(p.config, header.Number, header.Time), header.BaseFee)
@@ -338,6 +391,12 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 			blockContext:      blockContext,
 		}
 
+		if blockTracer != nil {
+			task.txTracer = newHookRecorder(hooks)
+			task.blockTracer = blockTracer
+			task.evmConfig.Tracer = task.txTracer.hooks
+		}
+
 		tasks = append(tasks, task)
 	}
 
@@ -363,6 +422,11 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 		if task.shouldRerunWithoutFeeDelay {
 			shouldDelayFeeCal = false
 
+			if blockTracer != nil {
+				blockTracer.reset()
+				backupStateDB.SetLogger(blockTracer.hooks)
+			}
+
 			// nolint
 			*statedb = *backupStateDB
 
@@ -388,6 +452,13 @@ func (p *ParallelStateProcessor) Process(block *types.Block, statedb *state.Stat
 		return nil, nil, 0, err
 	}
 
+	if blockTracer != nil {
+		// The state objects of a rerun block belong to backupStateDB
+		statedb.SetLogger(hooks)
+		backupStateDB.SetLogger(hooks)
+		blockTracer.replay(hooks)
+	}
+
 	// Finalize the block, applying any consensus engine specific extras (e.g. block rewards)
 	p.engine.Finalize(p.bc, header, statedb, block.Body())
 
@@ -408,6 +479,17 @@ func GetDeps(txDependency [][]uint64) map[int][]int {
 	return deps
 }
 
+// InvalidTxDependency reports whether the block carries a transaction dependency
+// metadata not matching its transactions. Blocks without the metadata are valid.
+func InvalidTxDependency(block *types.Block) bool {
+	txDependency := block.GetTxDependency()
+	if txDependency == nil {
+		return false
+	}
+
+	return !VerifyDeps(GetDeps(txDependency)) || len(txDependency) != len(block.Transactions())
+}
+
 // returns true if dependencies are correct
 func VerifyDeps(deps map[int][]int) bool {
 	// number of transactions in the block
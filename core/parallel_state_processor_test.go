@@ -1,9 +1,23 @@
 package core
 
 import (
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMetadata(t *testing.T) {
@@ -28,3 +42,154 @@ func TestMetadata(t *testing.T) {
 	temp = GetDeps(wrongTxDependencyOutOfRange)
 	assert.Equal(t, false, VerifyDeps(temp))
 }
+
+// hookLog records the hooks of a live tracer as strings
+type hookLog struct {
+	events []string
+}
+
+func (l *hookLog) add(format string, args ...interface{}) {
+	l.events = append(l.events, fmt.Sprintf(format, args...))
+}
+
+func (l *hookLog) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnBlockStart: func(event tracing.BlockEvent) { l.add("block start %d", event.Block.NumberU64()) },
+		OnBlockEnd:   func(err error) { l.add("block end %v", err) },
+		OnTxStart: func(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+			l.add("tx start %x %x %d", tx.Hash(), from, vm.BlockNumber)
+		},
+		OnTxEnd: func(receipt *types.Receipt, err error) {
+			l.add("tx end %x %d %d %d %v", receipt.TxHash, receipt.Status, receipt.GasUsed, receipt.CumulativeGasUsed, err)
+		},
+		OnEnter: func(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+			l.add("enter %d %d %x %x %x %d %v", depth, typ, from, to, input, gas, value)
+		},
+		OnExit: func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+			l.add("exit %d %x %d %v %v", depth, output, gasUsed, err, reverted)
+		},
+		OnOpcode: func(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+			l.add("opcode %d %d %d %d %v %x", pc, op, gas, cost, scope.StackData(), scope.Caller())
+		},
+		OnGasChange: func(old, new uint64, reason tracing.GasChangeReason) {
+			l.add("gas %d %d %d", old, new, reason)
+		},
+		OnBalanceChange: func(addr common.Address, prev, new *big.Int, reason tracing.BalanceChangeReason) {
+			l.add("balance %x %v %v %d", addr, prev, new, reason)
+		},
+		OnNonceChange: func(addr common.Address, prev, new uint64) {
+			l.add("nonce %x %d %d", addr, prev, new)
+		},
+		OnStorageChange: func(addr common.Address, slot common.Hash, prev, new common.Hash) {
+			l.add("storage %x %x %x %x", addr, slot, prev, new)
+		},
+		OnLog: func(log *types.Log) {
+			l.add("log %x %d %d %x", log.Address, log.Index, log.TxIndex, log.TxHash)
+		},
+	}
+}
+
+// TestParallelStateProcessorLiveTracing checks the hooks of a live tracer are
+// emitted by the parallel state processor as they are by the serial one
+func TestParallelStateProcessorLiveTracing(t *testing.T) {
+	t.Parallel()
+
+	var (
+		engine   = ethash.NewFaker()
+		contract = common.HexToAddress("0xc0de")
+		keys     = make([]*ecdsa.PrivateKey, 4)
+		alloc    = types.GenesisAlloc{
+			// stores the caller in slot 0 and logs it, the transactions
+			// calling it all conflict
+			contract: {Code: []byte{
+				byte(vm.CALLER), byte(vm.PUSH1), 0, byte(vm.SSTORE),
+				byte(vm.CALLER), byte(vm.PUSH1), 0, byte(vm.MSTORE),
+				byte(vm.PUSH1), 32, byte(vm.PUSH1), 0, byte(vm.LOG0),
+			}},
+		}
+	)
+
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+		alloc[crypto.PubkeyToAddress(keys[i].PublicKey)] = types.Account{Balance: big.NewInt(params.Ether)}
+	}
+
+	gspec := &Genesis{Config: params.TestChainConfig, Alloc: alloc}
+	signer := types.LatestSigner(gspec.Config)
+
+	_, blocks, _ := GenerateChainWithGenesis(gspec, engine, 3, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{1})
+
+		for j, key := range keys {
+			to := contract
+			if j%2 == 1 {
+				to = common.Address{byte(j)}
+			}
+
+			tx, _ := types.SignNewTx(key, signer, &types.DynamicFeeTx{
+				ChainID:   gspec.Config.ChainID,
+				Nonce:     uint64(i),
+				GasTipCap: big.NewInt(1),
+				GasFeeCap: b.header.BaseFee,
+				Gas:       100000,
+				To:        &to,
+				Value:     big.NewInt(int64(j + 1)),
+			})
+			b.AddTx(tx)
+		}
+	})
+
+	serial := &hookLog{}
+
+	chain, err := NewBlockChain(rawdb.NewMemoryDatabase(), nil, gspec, nil, engine, vm.Config{Tracer: serial.hooks()}, nil, nil, nil)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	// the parallel processor alone
+	chain, err = NewParallelBlockChain(rawdb.NewMemoryDatabase(), nil, gspec, nil, engine, vm.Config{}, nil, nil, nil, 8)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	processor := &hookLog{}
+	processor.add("block start %d", 1)
+
+	for i, block := range blocks {
+		statedb, err := state.New(chain.GetHeaderByHash(block.ParentHash()).Root, chain.stateCache, chain.snaps)
+		require.NoError(t, err)
+
+		statedb.SetLogger(processor.hooks())
+
+		_, _, _, err = chain.parallelProcessor.Process(block, statedb, vm.Config{Tracer: processor.hooks()}, nil)
+		require.NoError(t, err)
+
+		processor.add("block end %v", nil)
+
+		if i < len(blocks)-1 {
+			processor.add("block start %d", i+2)
+		}
+
+		_, err = chain.InsertChain(types.Blocks{block})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, serial.events, processor.events)
+
+	// the parallel processor during the import, the serial processor must
+	// not emit any hook
+	parallel := &hookLog{}
+
+	chain, err = NewParallelBlockChain(rawdb.NewMemoryDatabase(), nil, gspec, nil, engine, vm.Config{Tracer: parallel.hooks()}, nil, nil, nil, 8)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	require.Equal(t, serial.events, parallel.events)
+}
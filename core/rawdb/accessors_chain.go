@@ -904,7 +904,16 @@ func writeAncientBlock(op ethdb.AncientWriteOp, block *types.Block, header *type
 		return fmt.Errorf("can't append block %d total difficulty: %v", num, err)
 	}
 
-	if err := op.Append(freezerBorReceiptTable, num, borReceipts); err != nil {
+	// The bor receipt is stored on its own, like in the key-value store
+	var borReceipt []byte
+	if len(borReceipts) > 0 {
+		var err error
+		if borReceipt, err = rlp.EncodeToBytes(borReceipts[0]); err != nil {
+			return fmt.Errorf("can't encode block %d borReceipts: %v", num, err)
+		}
+	}
+
+	if err := op.AppendRaw(freezerBorReceiptTable, num, borReceipt); err != nil {
 		return fmt.Errorf("can't append block %d borReceipts: %v", num, err)
 	}
 
@@ -0,0 +1,123 @@
+package rawdb
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+var (
+	// addressActivityBlockPrefix + block hash -> RLP(addresses involved in the block)
+	addressActivityBlockPrefix = []byte("matic-address-activity-block-")
+
+	// addressActivityIndexPrefix + address + num (uint64 big endian) + tx index (uint64 big endian) -> block hash + kind
+	addressActivityIndexPrefix = []byte("matic-address-activity-index-")
+)
+
+// addressActivityBlockKey = addressActivityBlockPrefix + block hash
+func addressActivityBlockKey(hash common.Hash) []byte {
+	return append(common.CopyBytes(addressActivityBlockPrefix), hash.Bytes()...)
+}
+
+// addressActivityIndexKey = addressActivityIndexPrefix + address + num (uint64 big endian) + tx index (uint64 big endian)
+func addressActivityIndexKey(address common.Address, number uint64, txIndex uint64) []byte {
+	key := append(common.CopyBytes(addressActivityIndexPrefix), address.Bytes()...)
+	key = append(key, encodeBlockNumber(number)...)
+
+	return append(key, encodeBlockNumber(txIndex)...)
+}
+
+// ReadBlockAddressActivity retrieves the addresses involved in the transactions
+// of a block, as recorded when the block was executed.
+func ReadBlockAddressActivity(db ethdb.KeyValueReader, hash common.Hash) []types.AddressActivity {
+	data, _ := db.Get(addressActivityBlockKey(hash))
+	if len(data) == 0 {
+		return nil
+	}
+
+	var activity []types.AddressActivity
+	if err := rlp.DecodeBytes(data, &activity); err != nil {
+		log.Error("Invalid address activity RLP", "hash", hash, "err", err)
+		return nil
+	}
+
+	return activity
+}
+
+// WriteBlockAddressActivity stores the addresses involved in the transactions
+// of a block.
+func WriteBlockAddressActivity(db ethdb.KeyValueWriter, hash common.Hash, activity []types.AddressActivity) {
+	data, err := rlp.EncodeToBytes(activity)
+	if err != nil {
+		log.Crit("Failed to encode address activity", "err", err)
+	}
+
+	if err := db.Put(addressActivityBlockKey(hash), data); err != nil {
+		log.Crit("Failed to store address activity", "err", err)
+	}
+}
+
+// DeleteBlockAddressActivity removes the addresses involved in the transactions
+// of a block.
+func DeleteBlockAddressActivity(db ethdb.KeyValueWriter, hash common.Hash) {
+	if err := db.Delete(addressActivityBlockKey(hash)); err != nil {
+		log.Crit("Failed to delete address activity", "err", err)
+	}
+}
+
+// AddressActivityEntry is an entry of the address activity index, the
+// involvement of an address in the transaction at TxIndex of a block.
+type AddressActivityEntry struct {
+	Number  uint64
+	Hash    common.Hash
+	TxIndex uint64
+	Kind    types.AddressActivityKind
+}
+
+// WriteAddressActivityEntry stores an entry of the address activity index.
+func WriteAddressActivityEntry(db ethdb.KeyValueWriter, address common.Address, entry *AddressActivityEntry) {
+	value := append(entry.Hash.Bytes(), byte(entry.Kind))
+
+	if err := db.Put(addressActivityIndexKey(address, entry.Number, entry.TxIndex), value); err != nil {
+		log.Crit("Failed to store address activity entry", "err", err)
+	}
+}
+
+// ReadAddressActivityEntries retrieves up to limit entries of the address
+// activity index of an address, starting with the transaction at txIndex of
+// block from and ending with block to. The entries of blocks which were
+// reorged out of the chain are included, the caller has to skip them.
+func ReadAddressActivityEntries(db ethdb.Iteratee, address common.Address, from, txIndex, to uint64, limit int) []*AddressActivityEntry {
+	prefix := append(common.CopyBytes(addressActivityIndexPrefix), address.Bytes()...)
+	start := append(encodeBlockNumber(from), encodeBlockNumber(txIndex)...)
+
+	it := db.NewIterator(prefix, start)
+	defer it.Release()
+
+	var entries []*AddressActivityEntry
+
+	for len(entries) < limit && it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(key) != len(prefix)+16 || len(value) != common.HashLength+1 {
+			continue
+		}
+
+		number := binary.BigEndian.Uint64(key[len(prefix):])
+		if number > to {
+			break
+		}
+
+		entries = append(entries, &AddressActivityEntry{
+			Number:  number,
+			Hash:    common.BytesToHash(value[:common.HashLength]),
+			TxIndex: binary.BigEndian.Uint64(key[len(prefix)+8:]),
+			Kind:    types.AddressActivityKind(value[common.HashLength]),
+		})
+	}
+
+	return entries
+}
@@ -17,11 +17,25 @@ var (
 
 	// borTxLookupPrefix + hash -> transaction/receipt lookup metadata
 	borTxLookupPrefix = []byte(borTxLookupPrefixStr)
+
+	// stateSyncTxLookupPrefix + hash -> state sync transaction lookup metadata
+	stateSyncTxLookupPrefix = []byte(stateSyncTxLookupPrefixStr)
+
+	// stateSyncIDsPrefix + num (uint64 big endian) + hash -> ids of the state syncs committed in the block
+	stateSyncIDsPrefix = []byte("matic-state-sync-ids-")
+
+	// systemCallsPrefix + num (uint64 big endian) + hash -> system calls of the block
+	systemCallsPrefix = []byte("matic-system-calls-")
+
+	// borReceiptsSyncStatusKey tracks the bor receipts retrieval of snap sync.
+	borReceiptsSyncStatusKey = []byte("matic-bor-receipts-sync-status")
 )
 
 const (
 	borTxLookupPrefixStr = "matic-bor-tx-lookup-"
 
+	stateSyncTxLookupPrefixStr = "matic-state-sync-tx-lookup-"
+
 	// freezerBorReceiptTable indicates the name of the freezer bor receipts table.
 	freezerBorReceiptTable = "matic-bor-receipts"
 )
@@ -31,6 +45,21 @@ func borTxLookupKey(hash common.Hash) []byte {
 	return append(borTxLookupPrefix, hash.Bytes()...)
 }
 
+// stateSyncTxLookupKey = stateSyncTxLookupPrefix + state sync tx hash
+func stateSyncTxLookupKey(hash common.Hash) []byte {
+	return append(stateSyncTxLookupPrefix, hash.Bytes()...)
+}
+
+// stateSyncIDsKey = stateSyncIDsPrefix + num (uint64 big endian) + hash
+func stateSyncIDsKey(number uint64, hash common.Hash) []byte {
+	return append(append(stateSyncIDsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
+// systemCallsKey = systemCallsPrefix + num (uint64 big endian) + hash
+func systemCallsKey(number uint64, hash common.Hash) []byte {
+	return append(append(systemCallsPrefix, encodeBlockNumber(number)...), hash.Bytes()...)
+}
+
 func ReadBorReceiptRLP(db ethdb.Reader, hash common.Hash, number uint64) rlp.RawValue {
 	var data []byte
 
@@ -38,11 +67,13 @@ func ReadBorReceiptRLP(db ethdb.Reader, hash common.Hash, number uint64) rlp.Raw
 		// Check if the data is in ancients
 		if isCanon(reader, number, hash) {
 			data, _ = reader.Ancient(freezerBorReceiptTable, number)
-
-			return nil
+			if len(data) > 0 {
+				return nil
+			}
 		}
 
-		// If not, try reading from leveldb
+		// If not, try reading from leveldb, where the bor receipts retrieved after
+		// their block was frozen are stored
 		data, _ = db.Get(borReceiptKey(number, hash))
 
 		return nil
@@ -210,3 +241,120 @@ func DeleteBorTxLookupEntryByTxHash(db ethdb.KeyValueWriter, txHash common.Hash)
 		log.Crit("Failed to delete bor transaction lookup entry", "err", err)
 	}
 }
+
+// ReadStateSyncTxLookupEntry retrieves the number of the block which committed the
+// state sync with the derived tx hash.
+func ReadStateSyncTxLookupEntry(db ethdb.Reader, txHash common.Hash) *uint64 {
+	data, _ := db.Get(stateSyncTxLookupKey(txHash))
+	if len(data) == 0 {
+		return nil
+	}
+
+	number := new(big.Int).SetBytes(data).Uint64()
+
+	return &number
+}
+
+// WriteStateSyncTxLookupEntries stores the block number of the state syncs committed
+// in the block, using the derived tx hash of each state sync. The ids are stored
+// with the block to delete the entries if it's reorged out.
+func WriteStateSyncTxLookupEntries(db ethdb.KeyValueWriter, hash common.Hash, number uint64, stateIDs []uint64) {
+	for _, stateID := range stateIDs {
+		txHash := types.GetDerivedStateSyncTxHash(borReceiptKey(number, hash), stateID)
+		if err := db.Put(stateSyncTxLookupKey(txHash), big.NewInt(0).SetUint64(number).Bytes()); err != nil {
+			log.Crit("Failed to store state sync transaction lookup entry", "err", err)
+		}
+	}
+
+	data, err := rlp.EncodeToBytes(stateIDs)
+	if err != nil {
+		log.Crit("Failed to RLP encode state sync ids", "err", err)
+	}
+
+	if err := db.Put(stateSyncIDsKey(number, hash), data); err != nil {
+		log.Crit("Failed to store state sync ids", "err", err)
+	}
+}
+
+// ReadStateSyncIDs retrieves the ids of the state syncs committed in the block.
+func ReadStateSyncIDs(db ethdb.KeyValueReader, hash common.Hash, number uint64) []uint64 {
+	data, _ := db.Get(stateSyncIDsKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+
+	var stateIDs []uint64
+	if err := rlp.DecodeBytes(data, &stateIDs); err != nil {
+		log.Error("Invalid state sync ids RLP", "hash", hash, "err", err)
+		return nil
+	}
+
+	return stateIDs
+}
+
+// DeleteStateSyncTxLookupEntries removes the lookups of the state syncs committed
+// in the block, along with their ids.
+func DeleteStateSyncTxLookupEntries(db ethdb.KeyValueWriter, hash common.Hash, number uint64, stateIDs []uint64) {
+	for _, stateID := range stateIDs {
+		txHash := types.GetDerivedStateSyncTxHash(borReceiptKey(number, hash), stateID)
+		if err := db.Delete(stateSyncTxLookupKey(txHash)); err != nil {
+			log.Crit("Failed to delete state sync transaction lookup entry", "err", err)
+		}
+	}
+
+	if err := db.Delete(stateSyncIDsKey(number, hash)); err != nil {
+		log.Crit("Failed to delete state sync ids", "err", err)
+	}
+}
+
+// ReadBorReceiptsSyncStatus retrieves the serialized status of the bor receipts
+// retrieval of snap sync.
+func ReadBorReceiptsSyncStatus(db ethdb.KeyValueReader) []byte {
+	data, _ := db.Get(borReceiptsSyncStatusKey)
+	return data
+}
+
+// WriteBorReceiptsSyncStatus stores the serialized status of the bor receipts
+// retrieval of snap sync.
+func WriteBorReceiptsSyncStatus(db ethdb.KeyValueWriter, status []byte) {
+	if err := db.Put(borReceiptsSyncStatusKey, status); err != nil {
+		log.Crit("Failed to store bor receipts sync status", "err", err)
+	}
+}
+
+// ReadSystemCalls retrieves the system calls applied by the consensus engine at
+// the end of the block, nil if there were none.
+func ReadSystemCalls(db ethdb.KeyValueReader, hash common.Hash, number uint64) []types.SystemCall {
+	data, _ := db.Get(systemCallsKey(number, hash))
+	if len(data) == 0 {
+		return nil
+	}
+
+	var calls []types.SystemCall
+	if err := rlp.DecodeBytes(data, &calls); err != nil {
+		log.Error("Invalid system calls RLP", "hash", hash, "err", err)
+		return nil
+	}
+
+	return calls
+}
+
+// WriteSystemCalls stores the system calls applied by the consensus engine at the
+// end of the block.
+func WriteSystemCalls(db ethdb.KeyValueWriter, hash common.Hash, number uint64, calls []types.SystemCall) {
+	data, err := rlp.EncodeToBytes(calls)
+	if err != nil {
+		log.Crit("Failed to RLP encode system calls", "err", err)
+	}
+
+	if err := db.Put(systemCallsKey(number, hash), data); err != nil {
+		log.Crit("Failed to store system calls", "err", err)
+	}
+}
+
+// DeleteSystemCalls removes the system calls of the block.
+func DeleteSystemCalls(db ethdb.KeyValueWriter, hash common.Hash, number uint64) {
+	if err := db.Delete(systemCallsKey(number, hash)); err != nil {
+		log.Crit("Failed to delete system calls", "err", err)
+	}
+}
@@ -0,0 +1,90 @@
+package rawdb
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// logIndexPrefix + position + value + num (uint64 big endian) -> block hash + sources
+var logIndexPrefix = []byte("matic-log-index-")
+
+const (
+	LogIndexReceipts   uint8 = 1 << iota // The value is in the logs of the block receipts
+	LogIndexBorReceipt                   // The value is in the logs of the bor block receipt
+)
+
+// LogIndexKey is a value of the log index, the address of logs (position 0) or
+// their topic at position-1.
+type LogIndexKey struct {
+	Position uint8
+	Value    common.Hash
+}
+
+// LogIndexAddressKey returns the log index key of the logs of an address.
+func LogIndexAddressKey(address common.Address) LogIndexKey {
+	return LogIndexKey{Value: common.BytesToHash(address.Bytes())}
+}
+
+// LogIndexTopicKey returns the log index key of the logs having the topic at
+// the index.
+func LogIndexTopicKey(index int, topic common.Hash) LogIndexKey {
+	return LogIndexKey{Position: uint8(index + 1), Value: topic}
+}
+
+// LogIndexEntry is an entry of the log index, a block having logs with a value
+// in its receipts or bor receipt.
+type LogIndexEntry struct {
+	Number  uint64
+	Hash    common.Hash
+	Sources uint8
+}
+
+// logIndexKeyPrefix = logIndexPrefix + position + value
+func logIndexKeyPrefix(key LogIndexKey) []byte {
+	prefix := append(common.CopyBytes(logIndexPrefix), key.Position)
+	return append(prefix, key.Value.Bytes()...)
+}
+
+// WriteLogIndexEntry stores an entry of the log index.
+func WriteLogIndexEntry(db ethdb.KeyValueWriter, key LogIndexKey, entry *LogIndexEntry) {
+	value := append(entry.Hash.Bytes(), entry.Sources)
+
+	if err := db.Put(append(logIndexKeyPrefix(key), encodeBlockNumber(entry.Number)...), value); err != nil {
+		log.Crit("Failed to store log index entry", "err", err)
+	}
+}
+
+// ReadLogIndexEntries retrieves the entries of the log index of a value between
+// blocks from and to. The entries of blocks which were reorged out of the chain
+// are included, the caller has to skip them.
+func ReadLogIndexEntries(db ethdb.Iteratee, key LogIndexKey, from, to uint64) []*LogIndexEntry {
+	prefix := logIndexKeyPrefix(key)
+
+	it := db.NewIterator(prefix, encodeBlockNumber(from))
+	defer it.Release()
+
+	var entries []*LogIndexEntry
+
+	for it.Next() {
+		key, value := it.Key(), it.Value()
+		if len(key) != len(prefix)+8 || len(value) != common.HashLength+1 {
+			continue
+		}
+
+		number := binary.BigEndian.Uint64(key[len(prefix):])
+		if number > to {
+			break
+		}
+
+		entries = append(entries, &LogIndexEntry{
+			Number:  number,
+			Hash:    common.BytesToHash(value[:common.HashLength]),
+			Sources: value[common.HashLength],
+		})
+	}
+
+	return entries
+}
@@ -0,0 +1,21 @@
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+var peerReputationKey = []byte("PeerReputation")
+
+// ReadPeerReputation retrieves the encoded peer reputation records.
+func ReadPeerReputation(db ethdb.KeyValueReader) []byte {
+	data, _ := db.Get(peerReputationKey)
+	return data
+}
+
+// WritePeerReputation stores the encoded peer reputation records.
+func WritePeerReputation(db ethdb.KeyValueWriter, data []byte) {
+	if err := db.Put(peerReputationKey, data); err != nil {
+		log.Crit("Failed to store peer reputation", "err", err)
+	}
+}
@@ -130,6 +130,12 @@ var (
 	// BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 	BloomBitsIndexPrefix = []byte("iB")
 
+	// AddressActivityIndexPrefix is the data table of the address activity chain indexer to track its progress
+	AddressActivityIndexPrefix = []byte("iA")
+
+	// LogIndexIndexPrefix is the data table of the log chain indexer to track its progress
+	LogIndexIndexPrefix = []byte("iL")
+
 	ChtPrefix           = []byte("chtRootV2-") // ChtPrefix + chtNum (uint64 big endian) -> trie root hash
 	ChtTablePrefix      = []byte("cht-")
 	ChtIndexTablePrefix = []byte("chtIndexV2-")
@@ -135,7 +135,7 @@ func (s *stateObject) touch() {
 // subsequent reads to expand the same trie instead of reloading from disk.
 func (s *stateObject) getTrie() (Trie, error) {
 	if s.trie == nil {
-		tr, err := s.db.db.OpenStorageTrie(s.db.originalRoot, s.address, s.data.Root, s.db.trie)
+		tr, err := s.db.db.OpenStorageTrie(s.db.trieRoot(), s.address, s.data.Root, s.db.trie)
 		if err != nil {
 			return nil, err
 		}
@@ -203,6 +203,11 @@ func (s *stateObject) GetCommittedState(key common.Hash) common.Hash {
 		s.originStorage[key] = common.Hash{} // track the empty slot as origin value
 		return common.Hash{}
 	}
+	// The same goes for the parent block of a forked state
+	if _, destructed := s.db.parentDestruct[s.address]; destructed {
+		s.originStorage[key] = common.Hash{}
+		return common.Hash{}
+	}
 	// If no live objects are available, attempt to use snapshots
 	var (
 		enc   []byte
@@ -500,6 +505,38 @@ func (s *stateObject) commit() (*accountUpdate, *trienode.NodeSet, error) {
 	return op, nodes, nil
 }
 
+// fork settles the changes of the current block as if they were committed, for
+// a copy of the object executing the next block on top of them.
+func (s *stateObject) fork() {
+	for key, value := range s.pendingStorage {
+		s.originStorage[key] = value
+	}
+
+	s.pendingStorage = make(Storage)
+	s.uncommittedStorage = make(Storage)
+	s.dirtyCode = false
+	s.origin = s.data.Copy()
+}
+
+// rebase drops the storage trie of a forked object, to be reopened on the
+// committed state of the parent block, and marks the storage slots changed
+// since the fork to be applied to it again.
+func (s *stateObject) rebase() {
+	s.trie = nil
+
+	if s.origin != nil {
+		s.data.Root = s.origin.Root
+	} else {
+		s.data.Root = types.EmptyRootHash
+	}
+
+	for key, value := range s.pendingStorage {
+		if origin := s.originStorage[key]; origin != value {
+			s.uncommittedStorage[key] = origin
+		}
+	}
+}
+
 // AddBalance adds amount to s's balance.
 // It is used to add funds to the destination account of a transfer.
 func (s *stateObject) AddBalance(amount *uint256.Int, reason tracing.BalanceChangeReason) {
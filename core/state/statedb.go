@@ -99,6 +99,11 @@ type StateDB struct {
 	// It will be updated when the Commit is called.
 	originalRoot common.Hash
 
+	// forkRoot is the committed root the storage tries of a forked state are
+	// opened on until it's rebased, as the original root of a forked state isn't
+	// committed yet.
+	forkRoot common.Hash
+
 	// This map holds 'live' objects, which will get modified while
 	// processing a state transition.
 	stateObjects map[common.Address]*stateObject
@@ -110,6 +115,11 @@ type StateDB struct {
 	// boundaries.
 	stateObjectsDestruct map[common.Address]*stateObject
 
+	// This map holds the addresses of the objects destructed by the block a
+	// forked state was forked from, until the state is rebased on the committed
+	// state of that block. It's nil if the state isn't forked.
+	parentDestruct map[common.Address]struct{}
+
 	// This map tracks the account mutations that occurred during the
 	// transition. Uncommitted mutations belonging to the same account
 	// can be merged into a single one which is equivalent from database's
@@ -495,6 +505,11 @@ func (s *StateDB) SetLogger(l *tracing.Hooks) {
 	s.logger = l
 }
 
+// Logger returns the logger for account update hooks.
+func (s *StateDB) Logger() *tracing.Hooks {
+	return s.logger
+}
+
 // StartPrefetcher initializes a new trie prefetcher to pull in nodes from the
 // state trie concurrently while the state is mutated so that when we reach the
 // commit phase, most of the needed data is already hot.
@@ -990,6 +1005,10 @@ func (s *StateDB) getStateObject(addr common.Address) *stateObject {
 		if _, ok := s.stateObjectsDestruct[addr]; ok {
 			return nil
 		}
+		// Or in the parent block of a forked state, which isn't committed yet
+		if _, ok := s.parentDestruct[addr]; ok {
+			return nil
+		}
 		// If no live objects are available, attempt to use snapshots
 		var data *types.StateAccount
 		if s.snap != nil {
@@ -1129,6 +1148,7 @@ func (s *StateDB) Copy() *StateDB {
 		trie:                 s.db.CopyTrie(s.trie),
 		hasher:               crypto.NewKeccakState(),
 		originalRoot:         s.originalRoot,
+		forkRoot:             s.forkRoot,
 		stateObjects:         make(map[common.Address]*stateObject, len(s.stateObjects)),
 		stateObjectsDestruct: make(map[common.Address]*stateObject, len(s.stateObjectsDestruct)),
 		revertedKeys:         make(map[blockstm.Key]struct{}),
@@ -1154,6 +1174,9 @@ func (s *StateDB) Copy() *StateDB {
 	if s.witness != nil {
 		state.witness = s.witness.Copy()
 	}
+	if s.parentDestruct != nil {
+		state.parentDestruct = maps.Clone(s.parentDestruct)
+	}
 	// Deep copy cached state objects.
 	for addr, obj := range s.stateObjects {
 		state.stateObjects[addr] = obj.deepCopy(state)
@@ -1196,6 +1219,103 @@ func (s *StateDB) Copy() *StateDB {
 	return state
 }
 
+// Fork returns a copy of the state to execute the next block on, before the
+// state of the current block is committed. Unlike Copy, the copy doesn't share
+// the trie prefetcher of the original, which is bound to the original root, and
+// the fields scoped to the current block, like its logs, are reset.
+//
+// The changes of the current block are left to the original state to commit,
+// the copy only tracks the changes made on top of them. It must be rebased on
+// the committed state of the current block before it's committed itself. The
+// state must be hashed, e.g. by validating the block, before it's forked.
+func (s *StateDB) Fork() *StateDB {
+	state := s.Copy()
+	state.prefetcher = nil
+	state.mvHashmap = nil
+
+	state.thash = common.Hash{}
+	state.txIndex = 0
+	state.logs = make(map[common.Hash][]*types.Log)
+	state.logSize = 0
+
+	// A forked state forked again keeps reading through the last committed root
+	if state.parentDestruct == nil {
+		state.forkRoot = state.originalRoot
+	}
+
+	state.originalRoot = state.trie.Hash()
+	state.mutations = make(map[common.Address]*mutation)
+
+	// The destructed objects are still hidden from the snapshot of the parent
+	// block, until the state is rebased
+	if state.parentDestruct == nil {
+		state.parentDestruct = make(map[common.Address]struct{}, len(state.stateObjectsDestruct))
+	}
+
+	for addr := range state.stateObjectsDestruct {
+		state.parentDestruct[addr] = struct{}{}
+	}
+
+	state.stateObjectsDestruct = make(map[common.Address]*stateObject)
+
+	for _, obj := range state.stateObjects {
+		obj.fork()
+	}
+
+	return state
+}
+
+// Rebase moves a forked state on the committed state of the block it was forked
+// from, committed with the given root. The tries are reopened on the committed
+// state and the changes made since the fork are marked to be applied again, so
+// that the state only commits them, on top of the committed state.
+func (s *StateDB) Rebase(root common.Hash) error {
+	if s.parentDestruct == nil {
+		return errors.New("state is not forked")
+	}
+
+	if root != s.originalRoot {
+		return fmt.Errorf("rebase on %x, forked from %x", root, s.originalRoot)
+	}
+
+	tr, err := s.db.OpenTrie(root)
+	if err != nil {
+		return err
+	}
+
+	s.trie = tr
+	s.parentDestruct = nil
+	s.forkRoot = common.Hash{}
+
+	if s.snaps != nil {
+		s.snap = s.snaps.Snapshot(root)
+	}
+
+	for _, obj := range s.stateObjects {
+		obj.rebase()
+	}
+
+	for _, op := range s.mutations {
+		op.applied = false
+	}
+
+	// The changes are counted again once applied
+	s.AccountUpdated, s.AccountDeleted = 0, 0
+	s.StorageUpdated.Store(0)
+	s.StorageDeleted.Store(0)
+
+	return nil
+}
+
+// trieRoot returns the state root the storage tries are opened on.
+func (s *StateDB) trieRoot() common.Hash {
+	if s.parentDestruct != nil {
+		return s.forkRoot
+	}
+
+	return s.originalRoot
+}
+
 // Snapshot returns an identifier for the current revision of the state.
 func (s *StateDB) Snapshot() int {
 	id := s.nextRevisionId
@@ -1623,6 +1743,10 @@ func (s *StateDB) commit(deleteEmptyObjects bool) (*stateUpdate, error) {
 	if s.dbErr != nil {
 		return nil, fmt.Errorf("commit aborted due to earlier error: %v", s.dbErr)
 	}
+	// A forked state would commit the changes of its parent block again
+	if s.parentDestruct != nil {
+		return nil, errors.New("commit aborted, forked state isn't rebased")
+	}
 	// Finalize any pending changes and merge everything into the tries
 	s.IntermediateRoot(deleteEmptyObjects)
 
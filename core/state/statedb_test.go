@@ -2006,3 +2006,159 @@ func TestStorageDirtiness(t *testing.T) {
 	state.RevertToSnapshot(snap)
 	checkDirty(common.Hash{0x1}, common.Hash{0x1}, true)
 }
+
+// TestForkRebase tests that a state forked from the uncommitted state of a block
+// commits only its own changes, on top of the committed state of the block,
+// once rebased.
+func TestForkRebase(t *testing.T) {
+	var (
+		disk     = rawdb.NewMemoryDatabase()
+		tdb      = triedb.NewDatabase(disk, nil)
+		db       = NewDatabaseWithNodeDB(disk, tdb)
+		snaps, _ = snapshot.New(snapshot.Config{CacheSize: 10}, disk, tdb, types.EmptyRootHash)
+		state, _ = New(types.EmptyRootHash, db, snaps)
+
+		addrA = common.HexToAddress("0xa")
+		addrB = common.HexToAddress("0xb")
+		addrC = common.HexToAddress("0xc")
+		slot1 = common.HexToHash("0x1")
+		slot2 = common.HexToHash("0x2")
+	)
+
+	for _, addr := range []common.Address{addrA, addrB, addrC} {
+		state.SetBalance(addr, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+	}
+
+	state.SetState(addrA, slot1, common.HexToHash("0x11"))
+
+	root0, _ := state.Commit(0, true)
+
+	// The parent block changes a and deletes c
+	parent, _ := New(root0, db, snaps)
+	parent.SetBalance(addrA, uint256.NewInt(2), tracing.BalanceChangeUnspecified)
+	parent.SetState(addrA, slot1, common.HexToHash("0x12"))
+	parent.SetBalance(addrC, uint256.NewInt(0), tracing.BalanceChangeUnspecified)
+	parent.Finalise(true)
+	parent.IntermediateRoot(true)
+
+	// The child block changes a and b on the uncommitted state of the parent
+	child := parent.Fork()
+	if child.Exist(addrC) {
+		t.Fatal("account deleted by the parent block exists in the forked state")
+	}
+
+	if have := child.GetState(addrA, slot1); have != common.HexToHash("0x12") {
+		t.Fatalf("slot written by the parent block mismatch: have %x", have)
+	}
+
+	apply := func(s *StateDB) {
+		s.SetBalance(addrB, uint256.NewInt(3), tracing.BalanceChangeUnspecified)
+		s.SetState(addrA, slot2, common.HexToHash("0x22"))
+		s.Finalise(true)
+	}
+
+	apply(child)
+	root := child.IntermediateRoot(true)
+
+	root1, err := parent.Commit(1, true)
+	if err != nil {
+		t.Fatalf("failed to commit parent: %v", err)
+	}
+
+	if _, err := child.Copy().Commit(2, true); err == nil {
+		t.Fatal("forked state committed before it's rebased")
+	}
+
+	if err := child.Rebase(root1); err != nil {
+		t.Fatalf("failed to rebase: %v", err)
+	}
+
+	if have := child.IntermediateRoot(true); have != root {
+		t.Fatalf("root mismatch after rebase: have %x, want %x", have, root)
+	}
+
+	// The same changes applied on the committed state of the parent
+	reference, _ := New(root1, db, nil)
+	apply(reference)
+
+	want, err := reference.commit(true)
+	if err != nil {
+		t.Fatalf("failed to commit reference: %v", err)
+	}
+
+	have, err := child.commitAndFlush(2, true)
+	if err != nil {
+		t.Fatalf("failed to commit child: %v", err)
+	}
+
+	if have.root != root || have.originRoot != root1 {
+		t.Fatalf("commit roots mismatch: have %x on %x, want %x on %x", have.root, have.originRoot, root, root1)
+	}
+
+	if len(have.accounts) != 2 || len(have.accounts) != len(want.accounts) || len(have.destructs) != 0 {
+		t.Fatalf("committed accounts mismatch: have %d, want %d, destructs %d", len(have.accounts), len(want.accounts), len(have.destructs))
+	}
+
+	countNodes := func(set *trienode.MergedNodeSet) (n int) {
+		for _, nodes := range set.Flatten() {
+			n += len(nodes)
+		}
+
+		return n
+	}
+
+	if n, m := countNodes(have.nodes), countNodes(want.nodes); n != m {
+		t.Fatalf("committed trie nodes mismatch: have %d, want %d", n, m)
+	}
+
+	// The snapshot of the child is layered on the one of the parent
+	layers := snaps.Snapshots(root, 3, false)
+	if len(layers) != 3 || layers[1].Root() != root1 || layers[2].Root() != root0 {
+		t.Fatalf("snapshot layers mismatch: %v", layers)
+	}
+}
+
+// TestForkStorageTrie tests that the storage tries of a forked state are opened
+// on the committed state until the state is rebased, since the root of the block
+// it was forked from isn't committed yet.
+func TestForkStorageTrie(t *testing.T) {
+	var (
+		db       = NewDatabase(rawdb.NewMemoryDatabase())
+		state, _ = New(types.EmptyRootHash, db, nil)
+
+		addrA = common.HexToAddress("0xa")
+		addrB = common.HexToAddress("0xb")
+		slot  = common.HexToHash("0x1")
+	)
+
+	state.SetBalance(addrA, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+	state.SetBalance(addrB, uint256.NewInt(1), tracing.BalanceChangeUnspecified)
+	state.SetState(addrB, slot, common.HexToHash("0x11"))
+
+	root0, _ := state.Commit(0, true)
+
+	// The parent block doesn't load b
+	parent, _ := New(root0, db, nil)
+	parent.SetBalance(addrA, uint256.NewInt(2), tracing.BalanceChangeUnspecified)
+	parent.Finalise(true)
+	parent.IntermediateRoot(true)
+
+	child := parent.Fork()
+
+	if have := child.GetState(addrB, slot); have != common.HexToHash("0x11") || child.Error() != nil {
+		t.Fatalf("slot untouched by the parent block mismatch: have %x, err %v", have, child.Error())
+	}
+
+	root1, err := parent.Commit(1, true)
+	if err != nil {
+		t.Fatalf("failed to commit parent: %v", err)
+	}
+
+	if err := child.Rebase(root1); err != nil {
+		t.Fatalf("failed to rebase: %v", err)
+	}
+
+	if have := child.GetState(addrB, slot); have != common.HexToHash("0x11") || child.Error() != nil {
+		t.Fatalf("slot mismatch after rebase: have %x, err %v", have, child.Error())
+	}
+}
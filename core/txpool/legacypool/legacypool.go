@@ -466,6 +466,33 @@ func (pool *LegacyPool) SetGasTip(tip *big.Int) {
 	log.Info("Legacy pool tip threshold updated", "tip", newTip)
 }
 
+// GasTip returns the minimum gas tip required by the transaction pool for a new
+// transaction.
+func (pool *LegacyPool) GasTip() *big.Int {
+	return pool.gasTip.Load().ToBig()
+}
+
+// SetLimits updates the price bump, slot and lifetime limits of a running pool.
+// The values are sanitized the same way as the startup configuration, excess
+// transactions are dropped on the next pool maintenance run.
+func (pool *LegacyPool) SetLimits(config Config) {
+	conf := config.sanitize()
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	pool.config.PriceBump = conf.PriceBump
+	pool.config.AccountSlots = conf.AccountSlots
+	pool.config.GlobalSlots = conf.GlobalSlots
+	pool.config.AccountQueue = conf.AccountQueue
+	pool.config.GlobalQueue = conf.GlobalQueue
+	pool.config.Lifetime = conf.Lifetime
+
+	log.Info("Transaction pool limits updated", "pricebump", conf.PriceBump,
+		"accountslots", conf.AccountSlots, "globalslots", conf.GlobalSlots,
+		"accountqueue", conf.AccountQueue, "globalqueue", conf.GlobalQueue, "lifetime", conf.Lifetime)
+}
+
 // Nonce returns the next nonce of an account, with all transactions executable
 // by the pool already applied on top.
 func (pool *LegacyPool) Nonce(addr common.Address) uint64 {
@@ -1108,6 +1135,21 @@ func (pool *LegacyPool) Has(hash common.Hash) bool {
 	return pool.all.Get(hash) != nil
 }
 
+// Remove evicts a single transaction from the pool. Any pending transactions of
+// the same account with higher nonces are demoted back to the future queue.
+// It returns whether the transaction was found in the pool.
+func (pool *LegacyPool) Remove(hash common.Hash) bool {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+
+	if pool.all.Get(hash) == nil {
+		return false
+	}
+	pool.removeTx(hash, true, true)
+
+	return true
+}
+
 // removeTx removes a single transaction from the queue, moving all subsequent
 // transactions back to the future queue.
 //
@@ -803,6 +803,49 @@ func TestDropping(t *testing.T) {
 	}
 }
 
+// Tests that explicitly removing a pending transaction evicts it from the pool
+// and demotes the subsequent transactions of the same account to the queue.
+func TestRemove(t *testing.T) {
+	t.Parallel()
+
+	pool, key := setupPool()
+	defer pool.Close()
+
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	testAddBalance(pool, account, big.NewInt(1000000))
+
+	txs := []*types.Transaction{
+		transaction(0, 100000, key),
+		transaction(1, 100000, key),
+		transaction(2, 100000, key),
+	}
+	for _, err := range pool.addRemotesSync(txs) {
+		if err != nil {
+			t.Fatalf("failed to add transaction: %v", err)
+		}
+	}
+
+	if !pool.Remove(txs[1].Hash()) {
+		t.Fatalf("failed to remove pooled transaction")
+	}
+	if pool.Remove(txs[1].Hash()) {
+		t.Fatalf("removed transaction twice")
+	}
+	if pool.Has(txs[1].Hash()) {
+		t.Errorf("removed transaction still present")
+	}
+	pending, queued := pool.Stats()
+	if pending != 1 {
+		t.Errorf("pending transactions mismatched: have %d, want %d", pending, 1)
+	}
+	if queued != 1 {
+		t.Errorf("queued transactions mismatched: have %d, want %d", queued, 1)
+	}
+	if err := validatePoolInternals(pool); err != nil {
+		t.Fatalf("pool internal state corrupted: %v", err)
+	}
+}
+
 // Tests that if a transaction is dropped from the current pending pool (e.g. out
 // of fund), all consecutive (still valid, but not executable) transactions are
 // postponed back into the future queue to prevent broadcasting them.
@@ -305,6 +305,22 @@ func (p *TxPool) Get(hash common.Hash) *types.Transaction {
 	return nil
 }
 
+// Remove evicts a transaction from the subpool holding it. Only subpools which
+// support explicit eviction are consulted, others are skipped. It returns whether
+// the transaction was found and removed.
+func (p *TxPool) Remove(hash common.Hash) bool {
+	for _, subpool := range p.subpools {
+		remover, ok := subpool.(interface{ Remove(hash common.Hash) bool })
+		if !ok {
+			continue
+		}
+		if remover.Remove(hash) {
+			return true
+		}
+	}
+	return false
+}
+
 // Add enqueues a batch of transactions into the pool if they are valid. Due
 // to the large transaction churn, add may postpone fully integrating the tx
 // to a later point to batch multiple ones together.
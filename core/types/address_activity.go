@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AddressActivityKind is a bitmask of the ways an address is involved in a
+// transaction.
+type AddressActivityKind uint8
+
+const (
+	AddressActivityFrom      AddressActivityKind = 1 << iota // Sender of the transaction
+	AddressActivityTo                                        // Recipient of the transaction, or the contract it created
+	AddressActivityInternal                                  // Sender or recipient of an internal call
+	AddressActivityStateSync                                 // Contract called by a state sync
+)
+
+var addressActivityKindNames = []string{"from", "to", "internal", "stateSync"}
+
+// Names returns the names of the kinds in the bitmask.
+func (k AddressActivityKind) Names() []string {
+	names := make([]string, 0, len(addressActivityKindNames))
+
+	for i, name := range addressActivityKindNames {
+		if k&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// AddressActivity is the involvement of an address in a transaction of a
+// block. The state syncs of a block have the index of its bor transaction.
+type AddressActivity struct {
+	Address common.Address
+	TxIndex uint64
+	Kind    AddressActivityKind
+}
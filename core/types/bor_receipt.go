@@ -34,6 +34,16 @@ func GetDerivedBorTxHash(receiptKey []byte) common.Hash {
 	return common.BytesToHash(crypto.Keccak256(receiptKey))
 }
 
+// GetDerivedStateSyncTxHash get derived tx hash of the state sync with the id
+// committed in the block of the receipt key. Each state sync committed by the
+// bor transaction of a block can be traced as a transaction with this hash.
+func GetDerivedStateSyncTxHash(receiptKey []byte, stateID uint64) common.Hash {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, stateID)
+
+	return common.BytesToHash(crypto.Keccak256(receiptKey, enc))
+}
+
 // NewBorTransaction create new bor transaction for bor receipt
 func NewBorTransaction() *Transaction {
 	return NewTransaction(0, common.Address{}, big.NewInt(0), 0, big.NewInt(0), make([]byte, 0))
@@ -9,3 +9,10 @@ type StateSyncData struct {
 	Data     string
 	TxHash   common.Hash
 }
+
+// SystemCall is a call applied on the state by the consensus engine after the
+// transactions of a block, like the commits of the span and the state syncs.
+type SystemCall struct {
+	To   common.Address
+	Data []byte
+}
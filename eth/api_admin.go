@@ -26,6 +26,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/reputation"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -141,3 +142,9 @@ func (api *AdminAPI) ImportChain(file string) (bool, error) {
 	}
 	return true, nil
 }
+
+// PeerScores returns the reputation of the misbehaving nodes and IP addresses,
+// the highest scores first.
+func (api *AdminAPI) PeerScores() []*reputation.PeerScore {
+	return api.eth.Reputation().Scores()
+}
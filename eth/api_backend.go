@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -49,6 +50,8 @@ type EthAPIBackend struct {
 	allowUnprotectedTxs bool
 	eth                 *Ethereum
 	gpo                 *gasprice.Oracle
+
+	rpcReturnDataLimit atomic.Uint64
 }
 
 // ChainConfig returns the active chain configuration.
@@ -441,8 +444,22 @@ func (b *EthAPIBackend) RPCGasCap() uint64 {
 	return b.eth.config.RPCGasCap
 }
 
+func (b *EthAPIBackend) RPCTraceFilterRange() uint64 {
+	return b.eth.config.RPCTraceFilterRange
+}
+
 func (b *EthAPIBackend) RPCRpcReturnDataLimit() uint64 {
-	return b.eth.config.RPCReturnDataLimit
+	return b.rpcReturnDataLimit.Load()
+}
+
+// SetRPCRpcReturnDataLimit changes the maximum size of an eth_call result.
+func (b *EthAPIBackend) SetRPCRpcReturnDataLimit(limit uint64) {
+	b.rpcReturnDataLimit.Store(limit)
+}
+
+// SetGasPriceOracleConfig replaces the sampling parameters of the gas price oracle.
+func (b *EthAPIBackend) SetGasPriceOracleConfig(config gasprice.Config) {
+	b.gpo.SetConfig(config)
 }
 
 func (b *EthAPIBackend) RPCEVMTimeout() time.Duration {
@@ -458,6 +475,16 @@ func (b *EthAPIBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, sections
 }
 
+func (b *EthAPIBackend) LogIndexStatus() (uint64, uint64) {
+	if b.eth.logIndexer == nil {
+		return params.LogIndexBlocks, 0
+	}
+
+	sections, _, _ := b.eth.logIndexer.Sections()
+
+	return params.LogIndexBlocks, sections
+}
+
 func (b *EthAPIBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
 	for i := 0; i < bloomFilterThreads; i++ {
 		go session.Multiplex(bloomRetrievalBatch, bloomRetrievalWait, b.eth.bloomRequests)
@@ -468,6 +495,11 @@ func (b *EthAPIBackend) Engine() consensus.Engine {
 	return b.eth.engine
 }
 
+// TraceCache returns the cache of transaction trace results, nil if disabled.
+func (b *EthAPIBackend) TraceCache() *tracers.TraceCache {
+	return b.eth.traceCache
+}
+
 func (b *EthAPIBackend) CurrentHeader() *types.Header {
 	return b.eth.blockchain.CurrentHeader()
 }
@@ -34,6 +34,8 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/beacon"
 	"github.com/ethereum/go-ethereum/consensus/bor"
 	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/consensus/bor/light"
 	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
@@ -48,8 +50,10 @@ import (
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	borproto "github.com/ethereum/go-ethereum/eth/protocols/bor"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
+	"github.com/ethereum/go-ethereum/eth/reputation"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
@@ -76,7 +80,8 @@ type Ethereum struct {
 	config *ethconfig.Config
 
 	// Handlers
-	txPool *txpool.TxPool
+	txPool     *txpool.TxPool
+	legacyPool *legacypool.LegacyPool
 
 	blockchain         *core.BlockChain
 	handler            *handler
@@ -95,6 +100,9 @@ type Ethereum struct {
 	bloomIndexer      *core.ChainIndexer             // Bloom indexer operating during block imports
 	closeBloomHandler chan struct{}
 
+	addressIndexer *core.ChainIndexer // Address activity indexer operating during block imports, nil if disabled
+	logIndexer     *core.ChainIndexer // Log indexer operating during block imports, nil if disabled
+
 	APIBackend *EthAPIBackend
 
 	miner     *miner.Miner
@@ -104,13 +112,18 @@ type Ethereum struct {
 	networkID     uint64
 	netRPCService *ethapi.NetAPI
 
-	p2pServer *p2p.Server
+	p2pServer  *p2p.Server
+	reputation *reputation.Store  // Reputation store of the misbehaving peers
+	borENR     *borproto.ENREntry // ENR entry advertising the bor chain and the role of the node
 
 	lock sync.RWMutex // Protects the variadic fields (e.g. gas price and etherbase)
 
 	closeCh chan struct{} // Channel to signal the background processes to exit
 
 	shutdownTracker *shutdowncheck.ShutdownTracker // Tracks if and when the node has shutdown ungracefully
+
+	traceCache        *tracers.TraceCache // Cache of transaction trace results, nil if disabled
+	traceCachePrefill chan struct{}       // Channel closed when the trace cache prefill exits
 }
 
 // New creates a new Ethereum object (including the initialisation of the common Ethereum object),
@@ -172,7 +185,13 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		closeCh:           make(chan struct{}),
 	}
 
-	eth.APIBackend = &EthAPIBackend{stack.Config().ExtRPCEnabled(), stack.Config().AllowUnprotectedTxs, eth, nil}
+	eth.APIBackend = &EthAPIBackend{
+		extRPCEnabled:       stack.Config().ExtRPCEnabled(),
+		allowUnprotectedTxs: stack.Config().AllowUnprotectedTxs,
+		eth:                 eth,
+	}
+	eth.APIBackend.rpcReturnDataLimit.Store(config.RPCReturnDataLimit)
+
 	if eth.APIBackend.allowUnprotectedTxs {
 		log.Info("------Unprotected transactions allowed-------")
 		config.TxPool.AllowUnprotectedTxs = true
@@ -238,6 +257,7 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 			StateHistory:        config.StateHistory,
 			StateScheme:         scheme,
 			TriesInMemory:       config.TriesInMemory,
+			PipelineImport:      config.ParallelEVM.Pipeline,
 		}
 	)
 
@@ -253,6 +273,27 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		vmConfig.Tracer = t
 	}
 
+	// The address activity tracer records the internal calls and state syncs
+	// of the imported blocks along with the configured live tracer
+	if config.AddressIndex {
+		eth.addressIndexer = core.NewAddressIndexer(chainDb, chainConfig, params.AddressActivityBlocks, params.AddressActivityConfirms)
+		vmConfig.Tracer = core.NewAddressActivityTracer(chainDb, chainConfig).Hooks(vmConfig.Tracer)
+	}
+
+	// The log index is used by the filters for the blocks it covers, the bloom
+	// bits are still built for the ones it doesn't cover yet
+	if config.LogIndex {
+		eth.logIndexer = core.NewLogIndexer(chainDb, params.LogIndexBlocks, params.LogIndexConfirms)
+	}
+
+	if config.TraceCache > 0 {
+		traceDb, err := stack.OpenDatabase("tracecache", 0, 0, "eth/db/tracecache/", false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open trace cache database: %v", err)
+		}
+		eth.traceCache = tracers.NewTraceCache(traceDb, uint64(config.TraceCache)*1024*1024)
+	}
+
 	checker := whitelist.NewService(chainDb)
 
 	// check if Parallel EVM is enabled
@@ -276,6 +317,14 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 
 	eth.bloomIndexer.Start(eth.blockchain)
 
+	if eth.addressIndexer != nil {
+		eth.addressIndexer.Start(eth.blockchain)
+	}
+
+	if eth.logIndexer != nil {
+		eth.logIndexer.Start(eth.blockchain)
+	}
+
 	if config.BlobPool.Datadir != "" {
 		config.BlobPool.Datadir = stack.ResolvePath(config.BlobPool.Datadir)
 	}
@@ -283,11 +332,11 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 	if config.TxPool.Journal != "" {
 		config.TxPool.Journal = stack.ResolvePath(config.TxPool.Journal)
 	}
-	legacyPool := legacypool.New(config.TxPool, eth.blockchain)
+	eth.legacyPool = legacypool.New(config.TxPool, eth.blockchain)
 
 	// BOR changes
 	// Blob pool is removed from Subpool for Bor
-	eth.txPool, err = txpool.New(config.TxPool.PriceLimit, eth.blockchain, []txpool.SubPool{legacyPool})
+	eth.txPool, err = txpool.New(config.TxPool.PriceLimit, eth.blockchain, []txpool.SubPool{eth.legacyPool})
 	if err != nil {
 		return nil, err
 	}
@@ -298,6 +347,34 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := cacheConfig.TrieCleanLimit + cacheConfig.TrieDirtyLimit + cacheConfig.SnapshotLimit
+
+	// Ban the peers repeatedly serving invalid chains from the p2p server
+	eth.reputation = reputation.NewStore(chainDb)
+	eth.p2pServer.PeerBans = eth.reputation
+
+	// Connect to the upcoming block producers among the configured validator nodes,
+	// or the nodes attesting them on the discovery
+	var mesh *validatorMesh
+
+	if chainConfig.Bor != nil {
+		role, dialRoles, err := borNodeRoles(config)
+		if err != nil {
+			return nil, err
+		}
+
+		borEngine, ok := eth.engine.(*bor.Bor)
+		// A validator node behind its sentry nodes leaves the mesh to them
+		if ok && !eth.p2pServer.TrustedOnly && (len(config.ValidatorNodes) > 0 || role == borproto.RoleValidator || role == borproto.RoleSentry) {
+			producers := func(header *types.Header, backups int) ([]common.Address, error) {
+				return borEngine.UpcomingProducers(eth.blockchain, header, backups)
+			}
+			mesh = newValidatorMesh(eth.p2pServer, eth.blockchain, producers, config.ValidatorNodes)
+		}
+
+		eth.borENR = borproto.NewENREntry(chainConfig.ChainID.Uint64(), role)
+		eth.p2pServer.DialFilter = borDialFilter(eth.borENR.ChainID, dialRoles, mesh)
+	}
+
 	if eth.handler, err = newHandler(&handlerConfig{
 		Database:            chainDb,
 		Chain:               eth.blockchain,
@@ -308,12 +385,35 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		EventMux:            eth.eventMux,
 		RequiredBlocks:      config.RequiredBlocks,
 		EthAPI:              blockChainAPI,
+		Reputation:          eth.reputation,
+		ValidatorMesh:       mesh,
+		PrivatePeers:        eth.p2pServer.PrivateNodes,
 		checker:             checker,
 		enableBlockTracking: eth.config.EnableBlockTracking,
 	}); err != nil {
 		return nil, err
 	}
 
+	// Checkpoint sync is anchored on the checkpoints fetched from heimdall
+	if config.SyncMode == downloader.CheckpointSync {
+		borEngine, ok := eth.engine.(*bor.Bor)
+		if !ok {
+			return nil, fmt.Errorf("checkpoint sync: %w", ErrNotBorConsensus)
+		}
+
+		if borEngine.HeimdallClient == nil {
+			return nil, fmt.Errorf("checkpoint sync: %w", ErrBorConsensusWithoutHeimdall)
+		}
+
+		producers := light.SpanProducers(borEngine.HeimdallClient)
+
+		eth.handler.downloader.SetCheckpointFetcher(func(ctx context.Context) (*checkpoint.Checkpoint, error) {
+			return borEngine.HeimdallClient.FetchCheckpoint(ctx, -1)
+		}, func(header *types.Header) error {
+			return bor.VerifySeal(eth.blockchain.Config(), header, producers)
+		})
+	}
+
 	eth.miner = miner.New(eth, &config.Miner, eth.blockchain.Config(), eth.EventMux(), eth.engine, eth.isLocalBlock)
 	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
 
@@ -540,6 +640,8 @@ func (s *Ethereum) StartMining() error {
 			}
 		}
 
+		s.attestValidator(eb)
+
 		// If mining is started, we can disable the transaction rejection mechanism
 		// introduced to speed sync times.
 		s.handler.enableSyncedFeatures()
@@ -569,20 +671,24 @@ func (s *Ethereum) StopMining() {
 func (s *Ethereum) IsMining() bool      { return s.miner.Mining() }
 func (s *Ethereum) Miner() *miner.Miner { return s.miner }
 
-func (s *Ethereum) AccountManager() *accounts.Manager { return s.accountManager }
-func (s *Ethereum) BlockChain() *core.BlockChain      { return s.blockchain }
-func (s *Ethereum) TxPool() *txpool.TxPool            { return s.txPool }
-func (s *Ethereum) EventMux() *event.TypeMux          { return s.eventMux }
-func (s *Ethereum) Engine() consensus.Engine          { return s.engine }
+func (s *Ethereum) AccountManager() *accounts.Manager  { return s.accountManager }
+func (s *Ethereum) BlockChain() *core.BlockChain       { return s.blockchain }
+func (s *Ethereum) TxPool() *txpool.TxPool             { return s.txPool }
+func (s *Ethereum) LegacyPool() *legacypool.LegacyPool { return s.legacyPool }
+func (s *Ethereum) EventMux() *event.TypeMux           { return s.eventMux }
+func (s *Ethereum) Engine() consensus.Engine           { return s.engine }
 func (s *Ethereum) ChainDb() ethdb.Database {
 	return s.chainDb
 }
 func (s *Ethereum) IsListening() bool                  { return true } // Always listening
 func (s *Ethereum) Downloader() *downloader.Downloader { return s.handler.downloader }
+func (s *Ethereum) Reputation() *reputation.Store      { return s.reputation }
 func (s *Ethereum) Synced() bool                       { return s.handler.synced.Load() }
 func (s *Ethereum) SetSynced()                         { s.handler.enableSyncedFeatures() }
 func (s *Ethereum) ArchiveMode() bool                  { return s.config.NoPruning }
 func (s *Ethereum) BloomIndexer() *core.ChainIndexer   { return s.bloomIndexer }
+func (s *Ethereum) AddressIndexer() *core.ChainIndexer { return s.addressIndexer }
+func (s *Ethereum) LogIndexer() *core.ChainIndexer     { return s.logIndexer }
 
 // SetAuthorized sets the authorized bool variable
 // denoting that consensus has been authorized while creation
@@ -595,11 +701,15 @@ func (s *Ethereum) SetAuthorized(authorized bool) {
 // Protocols returns all the currently configured
 // network protocols to start.
 func (s *Ethereum) Protocols() []p2p.Protocol {
-	protos := eth.MakeProtocols((*ethHandler)(s.handler), s.networkID, s.ethDialCandidates)
+	protos := eth.MakeProtocols((*ethHandler)(s.handler), s.networkID, s.ethDialCandidates, eth.NewServeLimiter(s.config.Serve, s.handler.quitSync))
 	if s.config.SnapshotCache > 0 {
 		protos = append(protos, snap.MakeProtocols((*snapHandler)(s.handler), s.snapDialCandidates)...)
 	}
 
+	if s.blockchain.Config().Bor != nil {
+		protos = append(protos, borproto.MakeProtocols((*borReceiptsHandler)(s.handler), s.borENR)...)
+	}
+
 	return protos
 }
 
@@ -633,9 +743,63 @@ func (s *Ethereum) Start() error {
 	go s.startNoAckMilestoneService()
 	go s.startNoAckMilestoneByIDService()
 
+	if s.traceCache != nil && len(s.config.TraceCachePrefill) > 0 {
+		s.traceCachePrefill = make(chan struct{})
+		go s.prefillTraceCache()
+	}
+
 	return nil
 }
 
+// prefillTraceCache traces the new canonical blocks with the configured tracers
+// to fill the trace cache. Blocks are skipped while the tracers are busy so that
+// the block import isn't held back.
+func (s *Ethereum) prefillTraceCache() {
+	defer close(s.traceCachePrefill)
+
+	var (
+		api    = tracers.NewAPI(s.APIBackend)
+		events = make(chan core.ChainEvent, 16)
+		blocks = make(chan *types.Block, 16)
+		done   = make(chan struct{})
+		sub    = s.blockchain.SubscribeChainEvent(events)
+	)
+
+	defer sub.Unsubscribe()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		defer close(done)
+
+		for block := range blocks {
+			api.PrefillTraceCache(ctx, block, s.config.TraceCachePrefill)
+		}
+	}()
+
+	defer func() {
+		cancel()
+		close(blocks)
+		<-done
+	}()
+
+	for {
+		select {
+		case ev := <-events:
+			select {
+			case blocks <- ev.Block:
+			default:
+				log.Debug("Skipping trace cache prefill, tracers are busy", "number", ev.Block.NumberU64(), "hash", ev.Hash)
+			}
+		case <-sub.Err():
+			return
+		case <-s.closeCh:
+			return
+		}
+	}
+}
+
 var (
 	ErrNotBorConsensus             = errors.New("not bor consensus was given")
 	ErrBorConsensusWithoutHeimdall = errors.New("bor consensus without heimdall")
@@ -837,12 +1001,26 @@ func (s *Ethereum) Stop() error {
 	s.bloomIndexer.Close()
 	close(s.closeBloomHandler)
 
+	if s.addressIndexer != nil {
+		s.addressIndexer.Close()
+	}
+
+	if s.logIndexer != nil {
+		s.logIndexer.Close()
+	}
+
 	// Close all bg processes
 	close(s.closeCh)
 
+	// Wait for the trace cache prefill before the node closes its database
+	if s.traceCachePrefill != nil {
+		<-s.traceCachePrefill
+	}
+
 	s.txPool.Close()
 	s.miner.Close()
 	s.blockchain.Stop()
+	s.reputation.Close()
 
 	// Clean shutdown marker as the last thing before closing db
 	s.shutdownTracker.Stop()
@@ -865,6 +1043,10 @@ func (s *Ethereum) SetBlockchain(blockchain *core.BlockChain) {
 // SyncMode retrieves the current sync mode, either explicitly set, or derived
 // from the chain status.
 func (s *Ethereum) SyncMode() downloader.SyncMode {
+	// If we're in checkpoint sync mode, return that directly
+	if s.handler.checkpointSync.Load() {
+		return downloader.CheckpointSync
+	}
 	// If we're in snap sync mode, return that directly
 	if s.handler.snapSync.Load() {
 		return downloader.SnapSync
@@ -12,10 +12,14 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
-var errBorEngineNotAvailable error = errors.New("Only available in Bor engine")
+var (
+	errBorEngineNotAvailable error = errors.New("Only available in Bor engine")
+	errAddressIndexDisabled  error = errors.New("address activity index is not enabled")
+)
 
 // GetRootHash returns root hash for given start and end block
 func (b *EthAPIBackend) GetRootHash(ctx context.Context, starBlockNr uint64, endBlockNr uint64) (string, error) {
@@ -39,6 +43,29 @@ func (b *EthAPIBackend) GetRootHash(ctx context.Context, starBlockNr uint64, end
 	return root, nil
 }
 
+// GetRootHashProof returns the root hash for given start and end block, along
+// with the merkle proof of the inclusion of the block header
+func (b *EthAPIBackend) GetRootHashProof(ctx context.Context, starBlockNr uint64, endBlockNr uint64, number uint64) (common.Hash, []common.Hash, error) {
+	var api *bor.API
+
+	for _, _api := range b.eth.Engine().APIs(b.eth.BlockChain()) {
+		if _api.Namespace == "bor" {
+			api = _api.Service.(*bor.API)
+		}
+	}
+
+	if api == nil {
+		return common.Hash{}, nil, errBorEngineNotAvailable
+	}
+
+	proof, err := api.GetRootHashProof(starBlockNr, endBlockNr, number)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	return proof.RootHash, proof.Proof, nil
+}
+
 // GetVoteOnHash returns the vote on hash
 func (b *EthAPIBackend) GetVoteOnHash(ctx context.Context, starBlockNr uint64, endBlockNr uint64, hash string, milestoneId string) (bool, error) {
 	var api *bor.API
@@ -128,3 +155,23 @@ func (b *EthAPIBackend) SubscribeStateSyncEvent(ch chan<- core.StateSyncEvent) e
 func (b *EthAPIBackend) SubscribeChain2HeadEvent(ch chan<- core.Chain2HeadEvent) event.Subscription {
 	return b.eth.BlockChain().SubscribeChain2HeadEvent(ch)
 }
+
+// GetAddressActivity returns up to limit entries of the activity of the address,
+// starting with the transaction at txIndex of block from and ending with block to
+func (b *EthAPIBackend) GetAddressActivity(ctx context.Context, address common.Address, from uint64, txIndex uint64, to uint64, limit int) ([]*rawdb.AddressActivityEntry, error) {
+	indexer := b.eth.AddressIndexer()
+	if indexer == nil {
+		return nil, errAddressIndexDisabled
+	}
+
+	sections, _, _ := indexer.Sections()
+	indexed := sections * params.AddressActivityBlocks
+
+	// The blocks after the index are read one by one, which is only practical
+	// once the index has caught up with the chain
+	if head := b.eth.blockchain.CurrentBlock().Number.Uint64(); to >= indexed && head > indexed+2*params.AddressActivityBlocks {
+		return nil, fmt.Errorf("address activity index is being built, indexed up to block %d", indexed)
+	}
+
+	return core.ReadAddressActivity(b.eth.ChainDb(), b.ChainConfig(), address, from, txIndex, to, indexed, limit), nil
+}
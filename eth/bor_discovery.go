@@ -0,0 +1,89 @@
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	borproto "github.com/ethereum/go-ethereum/eth/protocols/bor"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// borNodeRoles parses the role of the node and the roles of the nodes to dial.
+func borNodeRoles(config *ethconfig.Config) (borproto.Role, []borproto.Role, error) {
+	role := borproto.RoleFull
+	if config.NoPruning {
+		role = borproto.RoleArchive
+	}
+
+	if config.NodeRole != "" {
+		var err error
+		if role, err = borproto.ParseRole(config.NodeRole); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	dialRoles := make([]borproto.Role, 0, len(config.DialRoles))
+
+	for _, name := range config.DialRoles {
+		dialRole, err := borproto.ParseRole(name)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		dialRoles = append(dialRoles, dialRole)
+	}
+
+	return role, dialRoles, nil
+}
+
+// borDialFilter returns the filter of the nodes found by discovery, rejecting
+// the nodes of other bor chains and the nodes without the requested roles. The
+// validator nodes attested by the nodes are recorded in the mesh if enabled.
+func borDialFilter(chainID uint64, roles []borproto.Role, mesh *validatorMesh) func(*enode.Node) bool {
+	filter := borproto.NewNodeFilter(chainID, roles)
+
+	return func(n *enode.Node) bool {
+		if !filter(n) {
+			return false
+		}
+
+		if mesh != nil {
+			mesh.observe(n)
+		}
+
+		return true
+	}
+}
+
+// attestValidator signs the validator signer address of the node into its bor
+// ENR entry, for the validator meshes of the other producers to find it.
+func (s *Ethereum) attestValidator(signer common.Address) {
+	if s.borENR == nil || s.borENR.Role != borproto.RoleValidator {
+		return
+	}
+
+	account := accounts.Account{Address: signer}
+
+	wallet, err := s.accountManager.Find(account)
+	if err != nil {
+		log.Warn("Validator attestation skipped, signer unavailable", "signer", signer, "err", err)
+		return
+	}
+
+	id := s.p2pServer.LocalNode().ID()
+
+	attestation, err := wallet.SignData(account, accounts.MimetypeBor, borproto.AttestationData(id, s.borENR.ChainID))
+	if err != nil {
+		log.Warn("Failed to sign validator attestation", "signer", signer, "err", err)
+		return
+	}
+
+	entry := *s.borENR
+	entry.Attestation = attestation
+
+	s.borENR = &entry
+	s.p2pServer.LocalNode().Set(&entry)
+
+	log.Info("Attested validator in node record", "signer", signer, "id", id)
+}
@@ -0,0 +1,124 @@
+package downloader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// checkpointFetchTimeout is the maximum time to wait for the latest checkpoint.
+const checkpointFetchTimeout = 30 * time.Second
+
+var (
+	errNoCheckpointFetcher = errors.New("checkpoint sync without heimdall")
+	errCheckpointFetch     = errors.New("failed to fetch latest checkpoint")
+	errInvalidCheckpoint   = errors.New("invalid checkpoint")
+)
+
+// CheckpointFetcher retrieves the latest checkpoint, anchoring checkpoint syncs.
+type CheckpointFetcher func(ctx context.Context) (*checkpoint.Checkpoint, error)
+
+// AnchorVerifier authenticates the end block header of a checkpoint on its own,
+// usually by checking its seal against the producers of its span.
+type AnchorVerifier func(header *types.Header) error
+
+// SetCheckpointFetcher sets the fetcher of the checkpoints anchoring checkpoint
+// syncs and the verifier of their end block headers. It must be called before the
+// sync starts.
+func (d *Downloader) SetCheckpointFetcher(fetcher CheckpointFetcher, verifier AnchorVerifier) {
+	d.checkpoints = fetcher
+	d.anchorVerifier = verifier
+}
+
+// fetchCheckpointAnchor retrieves the latest checkpoint and downloads the headers
+// of its range backwards from its end block, checking that they are linked and
+// that their merkle root matches the checkpoint's root hash. The end block header
+// is returned as the anchor of the sync.
+//
+// The checkpoint only commits to the number, time, transactions root and receipts
+// root of the headers. The other fields of the headers are covered by the parent
+// hash links of their children, except for the end block header which has none,
+// so its seal is verified on top.
+func (d *Downloader) fetchCheckpointAnchor(p *peerConnection) (*types.Header, error) {
+	if d.checkpoints == nil || d.anchorVerifier == nil {
+		return nil, errNoCheckpointFetcher
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), checkpointFetchTimeout)
+	defer cancel()
+
+	cp, err := d.checkpoints(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", errCheckpointFetch, err)
+	}
+
+	start, end := cp.StartBlock.Uint64(), cp.EndBlock.Uint64()
+	if start > end || end-start+1 > bor.MaxCheckpointLength {
+		return nil, fmt.Errorf("%w: range %d-%d", errInvalidCheckpoint, start, end)
+	}
+
+	log.Info("Anchoring sync on checkpoint", "start", start, "end", end, "root", cp.RootHash)
+
+	// Download the headers backwards from the end block, linking each of them
+	// to its child
+	var (
+		count   = int(end - start + 1)
+		headers = make([]*types.Header, count)
+		next    = count - 1
+	)
+
+	for next >= 0 {
+		amount := next + 1
+		if amount > MaxHeaderFetch {
+			amount = MaxHeaderFetch
+		}
+
+		batch, hashes, err := d.fetchHeadersByNumber(p, start+uint64(next), amount, 0, true)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(batch) == 0 {
+			return nil, fmt.Errorf("%w: no checkpoint headers from #%d", errStallingPeer, start+uint64(next))
+		}
+
+		for i, header := range batch {
+			if next < 0 {
+				return nil, fmt.Errorf("%w: too many checkpoint headers", errBadPeer)
+			}
+
+			if header.Number.Uint64() != start+uint64(next) {
+				return nil, fmt.Errorf("%w: checkpoint header #%d, want #%d", errInvalidChain, header.Number, start+uint64(next))
+			}
+
+			if next < count-1 && headers[next+1].ParentHash != hashes[i] {
+				return nil, fmt.Errorf("%w: checkpoint header #%d not linked", errInvalidChain, header.Number)
+			}
+
+			headers[next] = header
+			next--
+		}
+	}
+
+	root, err := bor.RootHash(headers)
+	if err != nil {
+		return nil, err
+	}
+
+	if root != cp.RootHash {
+		return nil, fmt.Errorf("%w: checkpoint root hash mismatch: have %x, want %x", errInvalidChain, root, cp.RootHash)
+	}
+
+	anchor := headers[count-1]
+	if err := d.anchorVerifier(anchor); err != nil {
+		return nil, fmt.Errorf("%w: checkpoint end header #%d: %v", errInvalidChain, anchor.Number, err)
+	}
+
+	return anchor, nil
+}
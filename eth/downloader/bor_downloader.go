@@ -138,6 +138,16 @@ type Downloader struct {
 	SnapSyncer     *snap.Syncer // TODO(karalabe): make private! hack for now
 	stateSyncStart chan *stateSync
 
+	borReceipts        BorReceiptsFetcher // Fetcher of the bor receipts of snap synced blocks
+	borReceiptsTarget  atomic.Uint64      // Last snap synced block whose bor receipt is to be retrieved
+	borReceiptsWakeCh  chan struct{}      // Channel to wake the retrieval of the bor receipts
+	borReceiptsLock    sync.Mutex         // Lock serializing the retrievals of the bor receipts
+	borReceiptsRetried time.Time          // Time of the last retrieval of the missing bor receipts
+
+	checkpoints    CheckpointFetcher // Fetcher of the checkpoints anchoring checkpoint syncs
+	anchorVerifier AnchorVerifier    // Verifier of the end block headers of the checkpoints
+	anchor         *types.Header     // Checkpoint end block header anchoring the current sync
+
 	// Cancellation and termination
 	cancelPeer string         // Identifier of the peer currently being used as the master (cancel on drop)
 	cancelCh   chan struct{}  // Channel to cancel mid-flight syncs
@@ -197,6 +207,9 @@ type BlockChain interface {
 	// GetBlockByHash retrieves a block from the local chain.
 	GetBlockByHash(common.Hash) *types.Block
 
+	// GetHeaderByNumber retrieves a canonical header from the local chain.
+	GetHeaderByNumber(uint64) *types.Header
+
 	// CurrentBlock retrieves the head block from the local chain.
 	CurrentBlock() *types.Header
 
@@ -239,6 +252,7 @@ func New(stateDb ethdb.Database, mux *event.TypeMux, chain BlockChain, lightchai
 		dropPeer:               dropPeer,
 		headerProcCh:           make(chan *headerTask, 1),
 		quitCh:                 make(chan struct{}),
+		borReceiptsWakeCh:      make(chan struct{}, 1),
 		SnapSyncer:             snap.NewSyncer(stateDb, chain.TrieDB().Scheme()),
 		stateSyncStart:         make(chan *stateSync),
 		syncStartBlock:         chain.CurrentSnapBlock().Number.Uint64(),
@@ -420,6 +434,12 @@ func (d *Downloader) synchronise(id string, hash common.Hash, td, ttd *big.Int,
 		log.Info("Block synchronisation started")
 	}
 
+	// Checkpoint sync is a snap sync anchored on the latest checkpoint
+	anchored := mode == CheckpointSync
+	if anchored {
+		mode = SnapSync
+	}
+
 	if mode == SnapSync {
 		// Snap sync will directly modify the persistent state, making the entire
 		// trie database unusable until the state is fully synced. To prevent any
@@ -479,6 +499,22 @@ func (d *Downloader) synchronise(id string, hash common.Hash, td, ttd *big.Int,
 		close(beaconPing)
 	}
 
+	// Anchor the sync on the latest checkpoint, which the remote chain must contain
+	d.anchor = nil
+
+	if anchored && p != nil {
+		anchor, err := d.fetchCheckpointAnchor(p)
+		if err != nil {
+			return err
+		}
+
+		if d.ChainValidator != nil {
+			d.ProcessCheckpoint(anchor.Number.Uint64(), anchor.Hash())
+		}
+
+		d.anchor = anchor
+	}
+
 	return d.syncWithPeer(p, hash, td, ttd, beaconMode)
 }
 
@@ -561,6 +597,11 @@ func (d *Downloader) syncWithPeer(p *peerConnection, hash common.Hash, td, ttd *
 	if mode == SnapSync && pivot == nil {
 		pivot = d.blockchain.CurrentBlock()
 	}
+	// In checkpoint sync, the state is synced at the anchor first, the pivot
+	// moving forward like any other if it becomes stale.
+	if mode == SnapSync && d.anchor != nil && d.anchor.Number.Cmp(pivot.Number) < 0 {
+		pivot = d.anchor
+	}
 
 	height := latest.Number.Uint64()
 
@@ -1867,6 +1908,8 @@ func (d *Downloader) commitSnapSyncData(results []*fetchResult, stateSync *state
 		return fmt.Errorf("%w: %v", errInvalidChain, err)
 	}
 
+	d.queueBorReceipts(last.Number.Uint64())
+
 	return nil
 }
 
@@ -1887,6 +1930,7 @@ func (d *Downloader) commitPivotBlock(result *fetchResult) error {
 		return err
 	}
 
+	d.queueBorReceipts(block.NumberU64())
 	d.committed.Store(true)
 
 	return nil
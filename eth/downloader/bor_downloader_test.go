@@ -17,6 +17,8 @@
 package downloader
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"math/big"
 	"os"
@@ -28,6 +30,8 @@ import (
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall/checkpoint"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -138,6 +142,7 @@ func (dl *downloadTester) newPeer(id string, version uint, blocks []*types.Block
 		id:              id,
 		chain:           newTestBlockchain(blocks),
 		withholdHeaders: make(map[common.Hash]struct{}),
+		forgeHeaders:    make(map[uint64]*types.Header),
 	}
 	dl.peers[id] = peer
 
@@ -168,6 +173,7 @@ type downloadTesterPeer struct {
 	chain *core.BlockChain
 
 	withholdHeaders map[common.Hash]struct{}
+	forgeHeaders    map[uint64]*types.Header // Headers served in place of the genuine ones by number
 }
 
 // Head constructs a function to retrieve a peer's current head hash
@@ -215,6 +221,12 @@ func (dlp *downloadTesterPeer) RequestHeadersByHash(origin common.Hash, amount i
 			}
 		}
 	}
+	// If a malicious peer is simulated forging headers, replace them
+	for i, header := range headers {
+		if forged, ok := dlp.forgeHeaders[header.Number.Uint64()]; ok {
+			headers[i] = forged
+		}
+	}
 
 	hashes := make([]common.Hash, len(headers))
 	for i, header := range headers {
@@ -262,6 +274,12 @@ func (dlp *downloadTesterPeer) RequestHeadersByNumber(origin uint64, amount int,
 			}
 		}
 	}
+	// If a malicious peer is simulated forging headers, replace them
+	for i, header := range headers {
+		if forged, ok := dlp.forgeHeaders[header.Number.Uint64()]; ok {
+			headers[i] = forged
+		}
+	}
 
 	hashes := make([]common.Hash, len(headers))
 	for i, header := range headers {
@@ -500,6 +518,273 @@ func testCanonSync(t *testing.T, protocol uint, mode SyncMode) {
 	assertOwnChain(t, tester, len(chain.blocks))
 }
 
+// Tests that a checkpoint sync anchors the sync on the end block of the latest
+// checkpoint, rejecting peers whose headers don't match its root hash or whose
+// end block header isn't authentic.
+func TestCheckpointSync(t *testing.T) {
+	chain := testChainBase.shorten(blockCacheMaxItems - 15)
+
+	headers := make([]*types.Header, 0, 100)
+	for _, block := range chain.blocks[1:101] {
+		headers = append(headers, block.Header())
+	}
+
+	root, err := bor.RootHash(headers)
+	if err != nil {
+		t.Fatalf("failed to compute root hash: %v", err)
+	}
+
+	// The test chain isn't sealed, the genuine end block header stands for the
+	// headers signed by the span producers
+	verifier := func(header *types.Header) error {
+		if header.Hash() != chain.blocks[100].Hash() {
+			return errors.New("unauthorized signer")
+		}
+
+		return nil
+	}
+
+	checkpointSync := func(root common.Hash, forged *types.Header) (*downloadTester, error) {
+		tester := newTester(t)
+		tester.downloader.SetCheckpointFetcher(func(ctx context.Context) (*checkpoint.Checkpoint, error) {
+			return &checkpoint.Checkpoint{StartBlock: big.NewInt(1), EndBlock: big.NewInt(100), RootHash: root}, nil
+		}, verifier)
+
+		peer := tester.newPeer("peer", eth.ETH68, chain.blocks[1:])
+		if forged != nil {
+			peer.forgeHeaders[forged.Number.Uint64()] = forged
+		}
+
+		return tester, tester.sync("peer", nil, CheckpointSync)
+	}
+
+	tester, err := checkpointSync(root, nil)
+	defer tester.terminate()
+
+	if err != nil {
+		t.Fatalf("failed to synchronise blocks: %v", err)
+	}
+
+	if have, want := tester.downloader.anchor.Hash(), chain.blocks[100].Hash(); have != want {
+		t.Fatalf("anchor mismatch: have %x, want %x", have, want)
+	}
+
+	assertOwnChain(t, tester, len(chain.blocks))
+
+	tester, err = checkpointSync(common.Hash{1}, nil)
+	defer tester.terminate()
+
+	if !errors.Is(err, errInvalidChain) {
+		t.Fatalf("checkpoint root mismatch error: have %v, want %v", err, errInvalidChain)
+	}
+
+	// The state root of the end block header isn't covered by the checkpoint root
+	// hash, a forged one is caught by the verifier
+	forged := chain.blocks[100].Header()
+	forged.Root = common.Hash{1}
+
+	tester, err = checkpointSync(root, forged)
+	defer tester.terminate()
+
+	if !errors.Is(err, errInvalidChain) {
+		t.Fatalf("forged anchor error: have %v, want %v", err, errInvalidChain)
+	}
+
+	if tester.downloader.anchor != nil {
+		t.Fatal("forged anchor accepted")
+	}
+}
+
+// testBorReceiptsFetcher serves the bor receipts of the blocks committing state
+// syncs, if enabled.
+type testBorReceiptsFetcher struct {
+	stateSyncs     map[uint64][]uint64 // Ids of the state syncs committed by the blocks
+	serve          bool                // Whether the bor receipts are served
+	failStateSyncs bool                // Whether the state syncs can't be retrieved from heimdall
+	lastIDs        []uint64            // Ids of the last state syncs the retrievals followed
+}
+
+func (f *testBorReceiptsFetcher) StateSyncs(ctx context.Context, headers []*types.Header, lastStateID uint64) ([][]uint64, error) {
+	f.lastIDs = append(f.lastIDs, lastStateID)
+
+	if f.failStateSyncs {
+		return nil, errors.New("heimdall unavailable")
+	}
+
+	stateSyncs := make([][]uint64, len(headers))
+	for i, header := range headers {
+		stateSyncs[i] = f.stateSyncs[header.Number.Uint64()]
+	}
+
+	return stateSyncs, nil
+}
+
+func (f *testBorReceiptsFetcher) FetchBorReceipts(ctx context.Context, headers []*types.Header, stateSyncs [][]uint64) ([]*types.Receipt, error) {
+	if !f.serve {
+		return nil, ErrNoBorReceiptsPeers
+	}
+
+	receipts := make([]*types.Receipt, len(headers))
+	for i := range headers {
+		if len(stateSyncs[i]) > 0 {
+			receipts[i] = &types.Receipt{Status: types.ReceiptStatusSuccessful, Logs: []*types.Log{}}
+		}
+	}
+
+	return receipts, nil
+}
+
+// newBorReceiptsTester creates a tester whose chain has the headers of the first
+// blocks of the test chain, retrieving their bor receipts with the given fetcher.
+func newBorReceiptsTester(t *testing.T, fetcher *testBorReceiptsFetcher) (*downloadTester, []*types.Block) {
+	t.Helper()
+
+	tester := newTester(t)
+	blocks := testChainBase.shorten(21).blocks[1:]
+
+	headers := make([]*types.Header, 0, len(blocks))
+	for _, block := range blocks {
+		headers = append(headers, block.Header())
+	}
+
+	if _, err := tester.chain.InsertHeaderChain(headers); err != nil {
+		t.Fatalf("failed to insert headers: %v", err)
+	}
+
+	tester.downloader.SetBorReceiptsFetcher(fetcher)
+
+	return tester, blocks
+}
+
+// waitBorReceiptsStatus waits until the bor receipts are retrieved in the
+// background up to the given block.
+func waitBorReceiptsStatus(t *testing.T, d *Downloader, number uint64) *borReceiptsStatus {
+	t.Helper()
+
+	for start := time.Now(); time.Since(start) < 5*time.Second; time.Sleep(10 * time.Millisecond) {
+		if status := d.readBorReceiptsStatus(); status.Number == number {
+			// Wait for the retrieval to finish before touching the fetcher
+			d.borReceiptsLock.Lock()
+			defer d.borReceiptsLock.Unlock()
+
+			return d.readBorReceiptsStatus()
+		}
+	}
+
+	t.Fatalf("bor receipts not retrieved up to block %d", number)
+
+	return nil
+}
+
+// Tests that the bor receipts which can't be retrieved during snap sync are
+// retrieved again later, following the state syncs committed before them.
+func TestFetchBorReceiptsMissing(t *testing.T) {
+	fetcher := &testBorReceiptsFetcher{stateSyncs: map[uint64][]uint64{4: {1, 2}, 12: {3}, 16: {4}}}
+
+	tester, blocks := newBorReceiptsTester(t, fetcher)
+	defer tester.terminate()
+
+	hasBorReceipt := func(number uint64) bool {
+		return rawdb.ReadRawBorReceipt(tester.downloader.stateDB, blocks[number-1].Hash(), number) != nil
+	}
+
+	// Without bor peers, the range of the batch is recorded as missing
+	tester.downloader.queueBorReceipts(10)
+
+	status := waitBorReceiptsStatus(t, tester.downloader, 10)
+	if status.StateSyncID != 2 || len(status.Missing) != 1 || *status.Missing[0] != (borReceiptsRange{From: 4, To: 8}) {
+		t.Fatalf("wrong status: have %+v", status)
+	}
+
+	if hasBorReceipt(4) {
+		t.Fatal("bor receipt written without bor peers")
+	}
+
+	// The blocks queued again aren't retrieved twice, the missing range is
+	// retrieved along with the next batch
+	fetcher.serve = true
+
+	tester.downloader.queueBorReceipts(10)
+	tester.downloader.queueBorReceipts(20)
+
+	status = waitBorReceiptsStatus(t, tester.downloader, 20)
+	if status.StateSyncID != 4 || len(status.Missing) != 0 {
+		t.Fatalf("wrong status: have %+v", status)
+	}
+
+	for _, number := range []uint64{4, 12, 16} {
+		if !hasBorReceipt(number) {
+			t.Fatalf("missing bor receipt of block %d", number)
+		}
+	}
+
+	if hasBorReceipt(8) {
+		t.Fatal("bor receipt written for a block without state syncs")
+	}
+
+	if want := []uint64{0, 0, 2}; fmt.Sprint(fetcher.lastIDs) != fmt.Sprint(want) {
+		t.Fatalf("wrong last state syncs: have %v, want %v", fetcher.lastIDs, want)
+	}
+}
+
+// Tests that the blocks whose state syncs can't be retrieved from heimdall are
+// recorded as missing along with the following ones, since the state syncs they
+// follow are unknown, until the state syncs are retrieved again.
+func TestFetchBorReceiptsMissingStateSyncs(t *testing.T) {
+	fetcher := &testBorReceiptsFetcher{stateSyncs: map[uint64][]uint64{4: {1, 2}, 12: {3}, 16: {4}}, serve: true, failStateSyncs: true}
+
+	tester, blocks := newBorReceiptsTester(t, fetcher)
+	defer tester.terminate()
+
+	d := tester.downloader
+
+	d.borReceiptsTarget.Store(10)
+
+	if err := d.retrieveBorReceipts(); err != nil {
+		t.Fatalf("failed to retrieve bor receipts: %v", err)
+	}
+
+	status := d.readBorReceiptsStatus()
+	if status.Number != 10 || !status.StateSyncsMissing || len(status.Missing) != 1 || *status.Missing[0] != (borReceiptsRange{From: 4, To: 8}) {
+		t.Fatalf("wrong status: have %+v", status)
+	}
+
+	d.borReceiptsTarget.Store(20)
+
+	if err := d.retrieveBorReceipts(); err != nil {
+		t.Fatalf("failed to retrieve bor receipts: %v", err)
+	}
+
+	status = d.readBorReceiptsStatus()
+	if status.Number != 20 || !status.StateSyncsMissing || len(status.Missing) != 1 || *status.Missing[0] != (borReceiptsRange{From: 4, To: 20}) {
+		t.Fatalf("wrong status: have %+v", status)
+	}
+
+	// Once heimdall is back, the whole range is retrieved and the following blocks
+	// follow on from its state syncs
+	fetcher.failStateSyncs = false
+	d.borReceiptsRetried = time.Time{}
+
+	if err := d.retrieveBorReceipts(); err != nil {
+		t.Fatalf("failed to retrieve bor receipts: %v", err)
+	}
+
+	status = d.readBorReceiptsStatus()
+	if status.Number != 20 || status.StateSyncsMissing || status.StateSyncID != 4 || len(status.Missing) != 0 {
+		t.Fatalf("wrong status: have %+v", status)
+	}
+
+	for _, number := range []uint64{4, 12, 16} {
+		if rawdb.ReadRawBorReceipt(d.stateDB, blocks[number-1].Hash(), number) == nil {
+			t.Fatalf("missing bor receipt of block %d", number)
+		}
+	}
+
+	if want := []uint64{0, 0, 0}; fmt.Sprint(fetcher.lastIDs) != fmt.Sprint(want) {
+		t.Fatalf("wrong last state syncs: have %v, want %v", fetcher.lastIDs, want)
+	}
+}
+
 // Tests that if a large batch of blocks are being downloaded, it is throttled
 // until the cached blocks are retrieved.
 func TestThrottling68Full(t *testing.T) { testThrottling(t, eth.ETH68, FullSync) }
@@ -17,13 +17,69 @@
 package downloader
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+var (
+	// ErrNoBorReceiptsPeers is returned by a BorReceiptsFetcher if no connected peer
+	// supports the `bor` protocol.
+	ErrNoBorReceiptsPeers = errors.New("no peers serving bor receipts")
+
+	// ErrBorReceiptsUnverifiable is returned by a BorReceiptsFetcher if the state
+	// syncs committed by the blocks can't be retrieved, without heimdall.
+	ErrBorReceiptsUnverifiable = errors.New("bor receipts can't be verified")
+)
+
+// borReceiptsRetryInterval is the minimum time between two retrievals of the bor
+// receipts which couldn't be retrieved during snap sync.
+const borReceiptsRetryInterval = time.Minute
+
+// borReceiptsBatch is the maximum number of sprint start blocks whose bor receipts
+// are retrieved at once.
+const borReceiptsBatch = 128
+
+// BorReceiptsFetcher retrieves the bor receipts of the blocks imported during
+// snap sync from the peers supporting the `bor` protocol.
+type BorReceiptsFetcher interface {
+	// StateSyncs retrieves the ids of the state syncs committed by the sprint
+	// start blocks with the given headers, in ascending order, given the id of
+	// the last state sync committed before them.
+	StateSyncs(ctx context.Context, headers []*types.Header, lastStateID uint64) ([][]uint64, error)
+
+	// FetchBorReceipts retrieves the bor receipts of the blocks with the given
+	// headers, in ascending order, nil for the blocks without any, verified
+	// against the ids of the state syncs committed by the blocks.
+	FetchBorReceipts(ctx context.Context, headers []*types.Header, stateSyncs [][]uint64) ([]*types.Receipt, error)
+}
+
+// borReceiptsStatus is the progress of the retrieval of the bor receipts of the
+// snap synced blocks, persisted across restarts.
+type borReceiptsStatus struct {
+	Target            uint64              // Last snap synced block whose bor receipt is to be retrieved
+	Number            uint64              // Last block whose bor receipt was retrieved or recorded as missing
+	StateSyncID       uint64              // Id of the last state sync committed up to the block
+	StateSyncsMissing bool                // Whether the state syncs of the last missing range are unknown
+	Missing           []*borReceiptsRange // Ranges of blocks whose bor receipts couldn't be retrieved
+}
+
+// borReceiptsRange is a range of sprint start blocks whose bor receipts couldn't
+// be retrieved, to be retrieved again later.
+type borReceiptsRange struct {
+	From        uint64 // First sprint start block of the range
+	To          uint64 // Last sprint start block of the range
+	StateSyncID uint64 // Id of the last state sync committed before the range
+}
+
 // receiptQueue implements typedQueue and is a type adapter between the generic
 // concurrent fetcher and the downloader.
 type receiptQueue Downloader
@@ -108,3 +164,313 @@ func (q *receiptQueue) deliver(peer *peerConnection, packet *eth.Response) (int,
 
 	return accepted, err
 }
+
+// SetBorReceiptsFetcher sets the fetcher retrieving the bor receipts of the blocks
+// imported during snap sync and starts retrieving them in the background. It must
+// be called before the sync starts.
+func (d *Downloader) SetBorReceiptsFetcher(fetcher BorReceiptsFetcher) {
+	d.borReceipts = fetcher
+
+	go d.borReceiptsLoop()
+}
+
+// RetryBorReceipts wakes the retrieval of the bor receipts of the snap synced
+// blocks, e.g. once a `bor` peer may have connected.
+func (d *Downloader) RetryBorReceipts() {
+	select {
+	case d.borReceiptsWakeCh <- struct{}{}:
+	default:
+	}
+}
+
+// queueBorReceipts schedules the retrieval of the bor receipts of the snap synced
+// blocks up to the given one. The bor receipts are not needed to advance the sync,
+// so they are retrieved in the background, out of the way of the block import.
+func (d *Downloader) queueBorReceipts(number uint64) {
+	for {
+		target := d.borReceiptsTarget.Load()
+		if number <= target || d.borReceiptsTarget.CompareAndSwap(target, number) {
+			break
+		}
+	}
+
+	d.RetryBorReceipts()
+}
+
+// borReceiptsLoop retrieves the bor receipts of the snap synced blocks whenever
+// more blocks are queued, and retries the missing ones periodically.
+func (d *Downloader) borReceiptsLoop() {
+	retry := time.NewTicker(borReceiptsRetryInterval)
+	defer retry.Stop()
+
+	for {
+		select {
+		case <-d.borReceiptsWakeCh:
+		case <-retry.C:
+		case <-d.quitCh:
+			return
+		}
+
+		if err := d.retrieveBorReceipts(); err != nil {
+			log.Warn("Failed to retrieve bor receipts", "err", err)
+		}
+	}
+}
+
+// retrieveBorReceipts retrieves the bor receipts of the sprint start blocks snap
+// synced since the last retrieval, in batches, and writes them along with the bor
+// transaction and state sync lookups. The bor receipts are verified against the
+// state syncs committed by the blocks according to heimdall, following the last
+// state sync committed locally. The blocks whose bor receipts can't be retrieved
+// are recorded to retrieve them again later.
+func (d *Downloader) retrieveBorReceipts() error {
+	config := d.blockchain.GetChainConfig()
+	if d.borReceipts == nil || config.Bor == nil || config.Bor.Sprint == nil {
+		return nil
+	}
+
+	d.borReceiptsLock.Lock()
+	defer d.borReceiptsLock.Unlock()
+
+	ctx, cancel := d.borReceiptsContext()
+	defer cancel()
+
+	status := d.readBorReceiptsStatus()
+	status.Target = max(status.Target, d.borReceiptsTarget.Load())
+
+	d.retryBorReceipts(ctx, status)
+
+	for status.Number < status.Target && ctx.Err() == nil {
+		var (
+			headers []*types.Header
+			number  = status.Number + 1
+		)
+
+		for ; number <= status.Target && len(headers) < borReceiptsBatch; number++ {
+			if !config.Bor.IsSprintStart(number) {
+				continue
+			}
+
+			header := d.blockchain.GetHeaderByNumber(number)
+			if header == nil {
+				// The chain was rewound below the snap synced blocks
+				status.Target = number - 1
+				break
+			}
+
+			headers = append(headers, header)
+		}
+
+		if len(headers) == 0 {
+			status.Number = min(number-1, status.Target)
+			continue
+		}
+
+		if !d.fetchBorReceipts(ctx, status, headers, number-1) {
+			break
+		}
+
+		if err := d.writeBorReceiptsStatus(d.stateDB, status); err != nil {
+			return err
+		}
+	}
+
+	return d.writeBorReceiptsStatus(d.stateDB, status)
+}
+
+// fetchBorReceipts retrieves the bor receipts of a batch of sprint start blocks
+// and advances the status up to the given block. It reports whether the retrieval
+// can go on with the next batch.
+func (d *Downloader) fetchBorReceipts(ctx context.Context, status *borReceiptsStatus, headers []*types.Header, number uint64) bool {
+	first, last := headers[0].Number.Uint64(), headers[len(headers)-1].Number.Uint64()
+
+	// The ids of the state syncs following a range whose state syncs couldn't be
+	// retrieved are unknown, the batch is retrieved along with the range later
+	if status.StateSyncsMissing {
+		status.Missing[len(status.Missing)-1].To = last
+		status.Number = number
+
+		return true
+	}
+
+	stateSyncs, err := d.borReceipts.StateSyncs(ctx, headers, status.StateSyncID)
+	if errors.Is(err, ErrBorReceiptsUnverifiable) {
+		log.Debug("Skipping bor receipts, no heimdall", "from", first, "to", last)
+		return false
+	}
+
+	if err == nil {
+		err = d.writeBorReceipts(ctx, headers, stateSyncs)
+	}
+
+	if err != nil {
+		log.Warn("Failed to retrieve bor receipts, retrying later", "from", first, "to", last, "err", err)
+
+		status.Missing = append(status.Missing, &borReceiptsRange{
+			From:        first,
+			To:          last,
+			StateSyncID: status.StateSyncID,
+		})
+	}
+
+	if stateSyncs == nil {
+		status.StateSyncsMissing = true
+	}
+
+	status.Number = number
+	status.StateSyncID = lastStateSyncID(status.StateSyncID, stateSyncs)
+
+	return true
+}
+
+// retryBorReceipts retrieves the missing bor receipts of the ranges recorded in
+// the status, at most once per retry interval, and reports whether any range was
+// retrieved and removed from the status.
+func (d *Downloader) retryBorReceipts(ctx context.Context, status *borReceiptsStatus) bool {
+	if len(status.Missing) == 0 || time.Since(d.borReceiptsRetried) < borReceiptsRetryInterval {
+		return false
+	}
+
+	d.borReceiptsRetried = time.Now()
+
+	missing := status.Missing[:0]
+
+	for i, r := range status.Missing {
+		stateSyncID, err := d.retryBorReceiptsRange(ctx, r)
+		if err != nil {
+			log.Debug("Failed to retrieve missing bor receipts", "from", r.From, "to", r.To, "err", err)
+
+			missing = append(missing, r)
+
+			continue
+		}
+
+		// The state syncs of the last range are known again, the following blocks
+		// can be retrieved on their own
+		if i == len(status.Missing)-1 && status.StateSyncsMissing {
+			status.StateSyncsMissing = false
+			status.StateSyncID = stateSyncID
+		}
+
+		log.Info("Retrieved missing bor receipts", "from", r.From, "to", r.To)
+	}
+
+	retried := len(missing) < len(status.Missing)
+	status.Missing = missing
+
+	return retried
+}
+
+// retryBorReceiptsRange retrieves the missing bor receipts of a range in batches,
+// and returns the id of the last state sync committed up to the range.
+func (d *Downloader) retryBorReceiptsRange(ctx context.Context, r *borReceiptsRange) (uint64, error) {
+	var (
+		config      = d.blockchain.GetChainConfig()
+		stateSyncID = r.StateSyncID
+		headers     []*types.Header
+	)
+
+	for number := r.From; number <= r.To; number++ {
+		if config.Bor.IsSprintStart(number) {
+			if header := d.blockchain.GetHeaderByNumber(number); header != nil {
+				headers = append(headers, header)
+			}
+		}
+
+		if len(headers) == 0 || (len(headers) < borReceiptsBatch && number < r.To) {
+			continue
+		}
+
+		stateSyncs, err := d.borReceipts.StateSyncs(ctx, headers, stateSyncID)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := d.writeBorReceipts(ctx, headers, stateSyncs); err != nil {
+			return 0, err
+		}
+
+		stateSyncID = lastStateSyncID(stateSyncID, stateSyncs)
+		headers = headers[:0]
+	}
+
+	return stateSyncID, nil
+}
+
+// lastStateSyncID returns the id of the last of the given state syncs, or the id
+// of the state sync committed before them if there are none.
+func lastStateSyncID(stateSyncID uint64, stateSyncs [][]uint64) uint64 {
+	for _, ids := range stateSyncs {
+		if len(ids) > 0 {
+			stateSyncID = ids[len(ids)-1]
+		}
+	}
+
+	return stateSyncID
+}
+
+// writeBorReceipts retrieves the bor receipts of the sprint start blocks and writes
+// them along with the bor transaction and state sync lookups.
+func (d *Downloader) writeBorReceipts(ctx context.Context, headers []*types.Header, stateSyncs [][]uint64) error {
+	receipts, err := d.borReceipts.FetchBorReceipts(ctx, headers, stateSyncs)
+	if err != nil {
+		return err
+	}
+
+	batch := d.stateDB.NewBatch()
+
+	for i, receipt := range receipts {
+		hash, number := headers[i].Hash(), headers[i].Number.Uint64()
+
+		if receipt != nil {
+			rawdb.WriteBorReceipt(batch, hash, number, (*types.ReceiptForStorage)(receipt))
+			rawdb.WriteBorTxLookupEntry(batch, hash, number)
+		}
+
+		rawdb.WriteStateSyncTxLookupEntries(batch, hash, number, stateSyncs[i])
+	}
+
+	return batch.Write()
+}
+
+// borReceiptsContext returns a context cancelled when the downloader terminates.
+func (d *Downloader) borReceiptsContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		select {
+		case <-d.quitCh:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, cancel
+}
+
+// readBorReceiptsStatus retrieves the progress of the retrieval of the bor receipts,
+// starting at genesis if there is none.
+func (d *Downloader) readBorReceiptsStatus() *borReceiptsStatus {
+	status := new(borReceiptsStatus)
+
+	if blob := rawdb.ReadBorReceiptsSyncStatus(d.stateDB); len(blob) > 0 {
+		if err := json.Unmarshal(blob, status); err != nil {
+			log.Error("Failed to decode bor receipts sync status", "err", err)
+			return new(borReceiptsStatus)
+		}
+	}
+
+	return status
+}
+
+// writeBorReceiptsStatus stores the progress of the retrieval of the bor receipts.
+func (d *Downloader) writeBorReceiptsStatus(db ethdb.KeyValueWriter, status *borReceiptsStatus) error {
+	blob, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+
+	rawdb.WriteBorReceiptsSyncStatus(db, blob)
+
+	return nil
+}
@@ -23,12 +23,13 @@ import "fmt"
 type SyncMode uint32
 
 const (
-	FullSync SyncMode = iota // Synchronise the entire blockchain history from full blocks
-	SnapSync                 // Download the chain and the state via compact snapshots
+	FullSync       SyncMode = iota // Synchronise the entire blockchain history from full blocks
+	SnapSync                       // Download the chain and the state via compact snapshots
+	CheckpointSync                 // Snap sync anchored on the latest Heimdall checkpoint
 )
 
 func (mode SyncMode) IsValid() bool {
-	return mode == FullSync || mode == SnapSync
+	return mode == FullSync || mode == SnapSync || mode == CheckpointSync
 }
 
 // String implements the stringer interface.
@@ -38,6 +39,8 @@ func (mode SyncMode) String() string {
 		return "full"
 	case SnapSync:
 		return "snap"
+	case CheckpointSync:
+		return "checkpoint"
 	default:
 		return "unknown"
 	}
@@ -49,6 +52,8 @@ func (mode SyncMode) MarshalText() ([]byte, error) {
 		return []byte("full"), nil
 	case SnapSync:
 		return []byte("snap"), nil
+	case CheckpointSync:
+		return []byte("checkpoint"), nil
 	default:
 		return nil, fmt.Errorf("unknown sync mode %d", mode)
 	}
@@ -60,8 +65,10 @@ func (mode *SyncMode) UnmarshalText(text []byte) error {
 		*mode = FullSync
 	case "snap":
 		*mode = SnapSync
+	case "checkpoint":
+		*mode = CheckpointSync
 	default:
-		return fmt.Errorf(`unknown sync mode %q, want "full" or "snap"`, text)
+		return fmt.Errorf(`unknown sync mode %q, want "full", "snap" or "checkpoint"`, text)
 	}
 
 	return nil
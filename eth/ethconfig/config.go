@@ -38,10 +38,12 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -76,6 +78,8 @@ var Defaults = Config{
 	RPCEVMTimeout:      5 * time.Second,
 	GPO:                FullNodeGPO,
 	RPCTxFeeCap:        1, // 1 ether
+
+	RPCTraceFilterRange: 1000,
 }
 
 //go:generate go run github.com/fjl/gencodec -type Config -formats toml -out gen_config.go
@@ -114,6 +118,25 @@ type Config struct {
 	// presence of these blocks for every new peer connection.
 	RequiredBlocks map[uint64]common.Hash `toml:"-"`
 
+	// ValidatorNodes is a set of validator signer address -> node mappings. The
+	// node keeps direct connections to the upcoming block producers among them,
+	// and pushes new blocks to them first.
+	ValidatorNodes map[common.Address]*enode.Node `toml:"-"`
+
+	// NodeRole is the role of the node advertised in its bor ENR entry: full,
+	// archive, validator, sentry or rpc. Defaults to archive if pruning is
+	// disabled, to full otherwise.
+	NodeRole string `toml:",omitempty"`
+
+	// DialRoles restricts the nodes found by discovery to the ones advertising
+	// one of the roles in their bor ENR entry. Nodes advertising another bor
+	// chain are never dialed.
+	DialRoles []string `toml:",omitempty"`
+
+	// Serve limits the rate of the headers, bodies and receipts served to the
+	// peers, per peer and globally.
+	Serve eth.ServeConfig `toml:",omitempty"`
+
 	// Light client options
 	LightServ        int  `toml:",omitempty"` // Maximum percentage of time allowed for serving LES requests
 	LightIngress     int  `toml:",omitempty"` // Incoming bandwidth limit for light servers
@@ -164,6 +187,11 @@ type Config struct {
 	VMTrace           string
 	VMTraceJsonConfig string
 
+	// Trace result cache size in megabytes (0 disables it) and the tracers
+	// run on imported blocks to fill it
+	TraceCache        int
+	TraceCachePrefill []string
+
 	// Miscellaneous options
 	DocRoot string `toml:"-"`
 
@@ -180,6 +208,10 @@ type Config struct {
 	// send-transaction variants. The unit is ether.
 	RPCTxFeeCap float64
 
+	// RPCTraceFilterRange is the maximum number of blocks a trace_filter request
+	// can span, 0 for no limit.
+	RPCTraceFilterRange uint64
+
 	// OverrideCancun (TODO: remove after the fork)
 	OverrideCancun *big.Int `toml:",omitempty"`
 
@@ -201,9 +233,19 @@ type Config struct {
 	// Use child heimdall process to fetch data, Only works when RunHeimdall is true
 	UseHeimdallApp bool
 
-	// Bor logs flag
+	// HeimdallClient is used instead of connecting to a heimdall node if set (devnet)
+	HeimdallClient bor.IHeimdallClient `toml:"-"`
+
+	// BorLogs merges the bor block logs, the state sync events, into the logs
+	// returned by eth_getLogs and eth_getFilterLogs
 	BorLogs bool
 
+	// AddressIndex enables the index of the transactions each address is involved in
+	AddressIndex bool
+
+	// LogIndex enables the log index, used instead of the bloom bits to filter logs
+	LogIndex bool
+
 	// Parallel EVM (Block-STM) related config
 	ParallelEVM core.ParallelEVMConfig `toml:",omitempty"`
 
@@ -237,7 +279,9 @@ func CreateConsensusEngine(chainConfig *params.ChainConfig, ethConfig *Config, d
 			}
 
 			var heimdallClient bor.IHeimdallClient
-			if ethConfig.RunHeimdall && ethConfig.UseHeimdallApp {
+			if ethConfig.HeimdallClient != nil {
+				heimdallClient = ethConfig.HeimdallClient
+			} else if ethConfig.RunHeimdall && ethConfig.UseHeimdallApp {
 				heimdallClient = heimdallapp.NewHeimdallAppClient()
 			} else if ethConfig.HeimdallgRPCAddress != "" {
 				heimdallClient = heimdallgrpc.NewHeimdallGRPCClient(ethConfig.HeimdallgRPCAddress)
@@ -12,7 +12,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
 // MarshalTOML marshals as TOML.
@@ -27,6 +29,10 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		NoPrefetch                           bool
 		TxLookupLimit                        uint64                 `toml:",omitempty"`
 		RequiredBlocks                       map[uint64]common.Hash `toml:"-"`
+		ValidatorNodes                       map[common.Address]*enode.Node `toml:"-"`
+		NodeRole                             string                         `toml:",omitempty"`
+		DialRoles                            []string                       `toml:",omitempty"`
+		Serve                                eth.ServeConfig                `toml:",omitempty"`
 		LightServ                            int                    `toml:",omitempty"`
 		LightIngress                         int                    `toml:",omitempty"`
 		LightEgress                          int                    `toml:",omitempty"`
@@ -56,11 +62,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		EnableWitnessCollection bool `toml:"-"`
 		VMTrace                 string
 		VMTraceJsonConfig       string
+		TraceCache              int
+		TraceCachePrefill       []string
 		DocRoot                              string `toml:"-"`
 		RPCGasCap                            uint64
 		RPCReturnDataLimit                   uint64
 		RPCEVMTimeout                        time.Duration
 		RPCTxFeeCap                          float64
+		RPCTraceFilterRange                  uint64
 		OverrideCancun                       *big.Int `toml:",omitempty"`
 		HeimdallURL                          string
 		WithoutHeimdall                      bool
@@ -69,6 +78,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 		RunHeimdallArgs                      string
 		UseHeimdallApp                       bool
 		BorLogs                              bool
+		AddressIndex                         bool
+		LogIndex                             bool
 		ParallelEVM                          core.ParallelEVMConfig `toml:",omitempty"`
 		DevFakeAuthor                        bool                   `hcl:"devfakeauthor,optional" toml:"devfakeauthor,optional"`
 		OverrideVerkle                       *big.Int               `toml:",omitempty"`
@@ -83,6 +94,10 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.NoPrefetch = c.NoPrefetch
 	enc.TxLookupLimit = c.TxLookupLimit
 	enc.RequiredBlocks = c.RequiredBlocks
+	enc.ValidatorNodes = c.ValidatorNodes
+	enc.NodeRole = c.NodeRole
+	enc.DialRoles = c.DialRoles
+	enc.Serve = c.Serve
 	enc.LightServ = c.LightServ
 	enc.LightIngress = c.LightIngress
 	enc.LightEgress = c.LightEgress
@@ -112,11 +127,14 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.EnableWitnessCollection = c.EnableWitnessCollection
 	enc.VMTrace = c.VMTrace
 	enc.VMTraceJsonConfig = c.VMTraceJsonConfig
+	enc.TraceCache = c.TraceCache
+	enc.TraceCachePrefill = c.TraceCachePrefill
 	enc.DocRoot = c.DocRoot
 	enc.RPCGasCap = c.RPCGasCap
 	enc.RPCReturnDataLimit = c.RPCReturnDataLimit
 	enc.RPCEVMTimeout = c.RPCEVMTimeout
 	enc.RPCTxFeeCap = c.RPCTxFeeCap
+	enc.RPCTraceFilterRange = c.RPCTraceFilterRange
 	enc.OverrideCancun = c.OverrideCancun
 	enc.HeimdallURL = c.HeimdallURL
 	enc.WithoutHeimdall = c.WithoutHeimdall
@@ -125,6 +143,8 @@ func (c Config) MarshalTOML() (interface{}, error) {
 	enc.RunHeimdallArgs = c.RunHeimdallArgs
 	enc.UseHeimdallApp = c.UseHeimdallApp
 	enc.BorLogs = c.BorLogs
+	enc.AddressIndex = c.AddressIndex
+	enc.LogIndex = c.LogIndex
 	enc.ParallelEVM = c.ParallelEVM
 	enc.DevFakeAuthor = c.DevFakeAuthor
 	enc.OverrideVerkle = c.OverrideVerkle
@@ -143,6 +163,10 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		NoPrefetch                           *bool
 		TxLookupLimit                        *uint64                `toml:",omitempty"`
 		RequiredBlocks                       map[uint64]common.Hash `toml:"-"`
+		ValidatorNodes                       map[common.Address]*enode.Node `toml:"-"`
+		NodeRole                             *string                        `toml:",omitempty"`
+		DialRoles                            []string                       `toml:",omitempty"`
+		Serve                                *eth.ServeConfig               `toml:",omitempty"`
 		LightServ                            *int                   `toml:",omitempty"`
 		LightIngress                         *int                   `toml:",omitempty"`
 		LightEgress                          *int                   `toml:",omitempty"`
@@ -172,11 +196,14 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		EnableWitnessCollection *bool `toml:"-"`
 		VMTrace                 *string
 		VMTraceJsonConfig       *string
+		TraceCache              *int
+		TraceCachePrefill       []string
 		DocRoot                              *string `toml:"-"`
 		RPCGasCap                            *uint64
 		RPCReturnDataLimit                   *uint64
 		RPCEVMTimeout                        *time.Duration
 		RPCTxFeeCap                          *float64
+		RPCTraceFilterRange                  *uint64
 		OverrideCancun                       *big.Int `toml:",omitempty"`
 		HeimdallURL                          *string
 		WithoutHeimdall                      *bool
@@ -185,6 +212,8 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 		RunHeimdallArgs                      *string
 		UseHeimdallApp                       *bool
 		BorLogs                              *bool
+		AddressIndex                         *bool
+		LogIndex                             *bool
 		ParallelEVM                          *core.ParallelEVMConfig `toml:",omitempty"`
 		DevFakeAuthor                        *bool                   `hcl:"devfakeauthor,optional" toml:"devfakeauthor,optional"`
 		OverrideVerkle                       *big.Int                `toml:",omitempty"`
@@ -220,6 +249,18 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.RequiredBlocks != nil {
 		c.RequiredBlocks = dec.RequiredBlocks
 	}
+	if dec.ValidatorNodes != nil {
+		c.ValidatorNodes = dec.ValidatorNodes
+	}
+	if dec.NodeRole != nil {
+		c.NodeRole = *dec.NodeRole
+	}
+	if dec.DialRoles != nil {
+		c.DialRoles = dec.DialRoles
+	}
+	if dec.Serve != nil {
+		c.Serve = *dec.Serve
+	}
 	if dec.LightServ != nil {
 		c.LightServ = *dec.LightServ
 	}
@@ -307,6 +348,12 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.VMTraceJsonConfig != nil {
 		c.VMTraceJsonConfig = *dec.VMTraceJsonConfig
 	}
+	if dec.TraceCache != nil {
+		c.TraceCache = *dec.TraceCache
+	}
+	if dec.TraceCachePrefill != nil {
+		c.TraceCachePrefill = dec.TraceCachePrefill
+	}
 	if dec.DocRoot != nil {
 		c.DocRoot = *dec.DocRoot
 	}
@@ -322,6 +369,9 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.RPCTxFeeCap != nil {
 		c.RPCTxFeeCap = *dec.RPCTxFeeCap
 	}
+	if dec.RPCTraceFilterRange != nil {
+		c.RPCTraceFilterRange = *dec.RPCTraceFilterRange
+	}
 	if dec.OverrideCancun != nil {
 		c.OverrideCancun = dec.OverrideCancun
 	}
@@ -346,6 +396,12 @@ func (c *Config) UnmarshalTOML(unmarshal func(interface{}) error) error {
 	if dec.BorLogs != nil {
 		c.BorLogs = *dec.BorLogs
 	}
+	if dec.AddressIndex != nil {
+		c.AddressIndex = *dec.AddressIndex
+	}
+	if dec.LogIndex != nil {
+		c.LogIndex = *dec.LogIndex
+	}
 	if dec.ParallelEVM != nil {
 		c.ParallelEVM = *dec.ParallelEVM
 	}
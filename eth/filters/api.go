@@ -30,6 +30,8 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -40,8 +42,17 @@ var (
 	errInvalidBlockRange      = errors.New("invalid block range params")
 	errPendingLogsUnsupported = errors.New("pending logs are not supported")
 	errExceedMaxTopics        = errors.New("exceed max topics")
+	errInvalidMethodSelector  = errors.New("invalid method selector, want 4 bytes")
 )
 
+// pendingTxsQueueSize is the number of matching pending transactions queued for
+// a subscriber, beyond which they're dropped until it catches up.
+const pendingTxsQueueSize = 4096
+
+// pendingTxsDroppedCounter counts the pending transactions dropped because their
+// subscriber was too slow to receive them.
+var pendingTxsDroppedCounter = metrics.NewRegisteredCounter("eth/filters/pendingtxs/dropped", nil)
+
 // The maximum number of topic criteria allowed, vm.LOG4 - vm.LOG0
 const maxTopics = 4
 
@@ -69,7 +80,11 @@ type FilterAPI struct {
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
 	timeout   time.Duration
-	borLogs   bool
+
+	// borLogs merges the state sync logs into eth_getLogs and eth_getFilterLogs.
+	// The logs subscriptions and eth_getFilterChanges deliver the logs fed by the
+	// chain, which include them either way.
+	borLogs bool
 
 	chainConfig *params.ChainConfig
 }
@@ -159,39 +174,194 @@ func (api *FilterAPI) NewPendingTransactionFilter(fullTx *bool) rpc.ID {
 	return pendingTxSub.ID
 }
 
+// PendingTxFilterCriteria restricts the transactions sent by a pending
+// transactions subscription. A transaction is sent if it matches all the set
+// criteria, and any of the values of a set list.
+type PendingTxFilterCriteria struct {
+	From             []common.Address `json:"from"`             // Senders of the transactions
+	To               []common.Address `json:"to"`               // Recipients of the transactions
+	MethodSelectors  []hexutil.Bytes  `json:"methodSelectors"`  // First 4 bytes of the transactions data
+	MinTip           *hexutil.Big     `json:"minTip"`           // Minimum effective tip at the current base fee
+	ContractCreation bool             `json:"contractCreation"` // Contract creations only
+}
+
+// pendingTxFilter matches pending transactions against filter criteria.
+type pendingTxFilter struct {
+	from             map[common.Address]struct{}
+	to               map[common.Address]struct{}
+	selectors        map[[4]byte]struct{}
+	minTip           *big.Int
+	contractCreation bool
+	signer           types.Signer
+}
+
+// newPendingTxFilter creates a pending transactions filter of the criteria, nil
+// if all transactions match.
+func newPendingTxFilter(crit *PendingTxFilterCriteria, config *params.ChainConfig) (*pendingTxFilter, error) {
+	if crit == nil {
+		return nil, nil
+	}
+
+	f := &pendingTxFilter{
+		minTip:           (*big.Int)(crit.MinTip),
+		contractCreation: crit.ContractCreation,
+		signer:           types.LatestSigner(config),
+	}
+
+	if len(crit.From) > 0 {
+		f.from = make(map[common.Address]struct{}, len(crit.From))
+		for _, addr := range crit.From {
+			f.from[addr] = struct{}{}
+		}
+	}
+
+	if len(crit.To) > 0 {
+		f.to = make(map[common.Address]struct{}, len(crit.To))
+		for _, addr := range crit.To {
+			f.to[addr] = struct{}{}
+		}
+	}
+
+	if len(crit.MethodSelectors) > 0 {
+		f.selectors = make(map[[4]byte]struct{}, len(crit.MethodSelectors))
+		for _, selector := range crit.MethodSelectors {
+			if len(selector) != 4 {
+				return nil, errInvalidMethodSelector
+			}
+
+			f.selectors[[4]byte(selector)] = struct{}{}
+		}
+	}
+
+	return f, nil
+}
+
+// match returns whether the transaction matches the filter at the base fee.
+func (f *pendingTxFilter) match(tx *types.Transaction, baseFee *big.Int) bool {
+	if f == nil {
+		return true
+	}
+
+	if f.contractCreation && tx.To() != nil {
+		return false
+	}
+
+	if f.to != nil {
+		if tx.To() == nil {
+			return false
+		}
+
+		if _, ok := f.to[*tx.To()]; !ok {
+			return false
+		}
+	}
+
+	if f.selectors != nil {
+		data := tx.Data()
+		if len(data) < 4 {
+			return false
+		}
+
+		if _, ok := f.selectors[[4]byte(data[:4])]; !ok {
+			return false
+		}
+	}
+
+	if f.minTip != nil {
+		tip, err := tx.EffectiveGasTip(baseFee)
+		if err != nil || tip.Cmp(f.minTip) < 0 {
+			return false
+		}
+	}
+
+	// Recovering the sender is the most expensive, so it's checked last
+	if f.from != nil {
+		from, err := types.Sender(f.signer, tx)
+		if err != nil {
+			return false
+		}
+
+		if _, ok := f.from[from]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
 // NewPendingTransactions creates a subscription that is triggered each time a
 // transaction enters the transaction pool. If fullTx is true the full tx is
-// sent to the client, otherwise the hash is sent.
-func (api *FilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
+// sent to the client, otherwise the hash is sent. If crit is set, only the
+// transactions matching it are sent.
+//
+// The transactions are queued for the subscriber, if it can't keep up with the
+// transaction pool they're dropped instead of blocking the other subscribers.
+func (api *FilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool, crit *PendingTxFilterCriteria) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
+	chainConfig := api.sys.backend.ChainConfig()
+
+	filter, err := newPendingTxFilter(crit, chainConfig)
+	if err != nil {
+		return nil, err
+	}
+
 	rpcSub := notifier.CreateSubscription()
+	queue := make(chan *types.Transaction, pendingTxsQueueSize)
 
 	go func() {
 		txs := make(chan []*types.Transaction, 128)
 		pendingTxSub := api.events.SubscribePendingTxs(txs)
 		defer pendingTxSub.Unsubscribe()
 
-		chainConfig := api.sys.backend.ChainConfig()
+		var dropped uint64
 
 		for {
 			select {
 			case txs := <-txs:
-				// To keep the original behaviour, send a single tx hash in one notification.
-				// TODO(rjl493456442) Send a batch of tx hashes in one notification
-				latest := api.sys.backend.CurrentHeader()
+				var baseFee *big.Int
+				if latest := api.sys.backend.CurrentHeader(); latest != nil {
+					baseFee = latest.BaseFee
+				}
 
 				for _, tx := range txs {
-					if fullTx != nil && *fullTx {
-						rpcTx := ethapi.NewRPCPendingTransaction(tx, latest, chainConfig)
-						_ = notifier.Notify(rpcSub.ID, rpcTx)
-					} else {
-						_ = notifier.Notify(rpcSub.ID, tx.Hash())
+					if !filter.match(tx, baseFee) {
+						continue
+					}
+
+					select {
+					case queue <- tx:
+					default:
+						dropped++
+
+						pendingTxsDroppedCounter.Inc(1)
 					}
 				}
+			case <-rpcSub.Err():
+				if dropped > 0 {
+					log.Debug("Dropped pending transactions of slow subscriber", "id", rpcSub.ID, "dropped", dropped)
+				}
+
+				return
+			}
+		}
+	}()
+
+	go func() {
+		for {
+			select {
+			case tx := <-queue:
+				// To keep the original behaviour, send a single tx hash in one notification.
+				// TODO(rjl493456442) Send a batch of tx hashes in one notification
+				if fullTx != nil && *fullTx {
+					rpcTx := ethapi.NewRPCPendingTransaction(tx, api.sys.backend.CurrentHeader(), chainConfig)
+					_ = notifier.Notify(rpcSub.ID, rpcTx)
+				} else {
+					_ = notifier.Notify(rpcSub.ID, tx.Hash())
+				}
 			case <-rpcSub.Err():
 				return
 			}
@@ -352,19 +522,11 @@ func (api *FilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) ([]*type
 		return nil, errExceedMaxTopics
 	}
 
-	borConfig := api.sys.backend.ChainConfig().Bor
-
 	var filter *Filter
 
-	var borLogsFilter *BorBlockLogsFilter
-
 	if crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
 		filter = api.sys.NewBlockFilter(*crit.BlockHash, crit.Addresses, crit.Topics)
-		// Block bor filter
-		if api.borLogs {
-			borLogsFilter = NewBorBlockLogsFilter(api.sys.backend, borConfig, *crit.BlockHash, crit.Addresses, crit.Topics)
-		}
 	} else {
 		// Convert the RPC block numbers into internal representations
 		begin := rpc.LatestBlockNumber.Int64()
@@ -381,29 +543,14 @@ func (api *FilterAPI) GetLogs(ctx context.Context, crit FilterCriteria) ([]*type
 		}
 		// Construct the range filter
 		filter = api.sys.NewRangeFilter(begin, end, crit.Addresses, crit.Topics)
-		// Block bor filter
-		if api.borLogs {
-			borLogsFilter = NewBorBlockLogsRangeFilter(api.sys.backend, borConfig, begin, end, crit.Addresses, crit.Topics)
-		}
 	}
 
-	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx)
+	// Run the filter and return all the logs, along with the bor block logs
+	logs, err := api.withBorLogs(filter).Logs(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if borLogsFilter != nil {
-		// Run the filter and return all the logs
-		borBlockLogs, err := borLogsFilter.Logs(ctx)
-		if err != nil {
-			return nil, err
-		}
-
-		return returnLogs(types.MergeBorLogs(logs, borBlockLogs)), err
-	}
-
-	// merge bor block logs and receipt logs and return it
 	return returnLogs(logs), err
 }
 
@@ -435,20 +582,11 @@ func (api *FilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*types.Lo
 		return nil, errFilterNotFound
 	}
 
-	borConfig := api.sys.backend.ChainConfig().Bor
-
 	var filter *Filter
 
-	var borLogsFilter *BorBlockLogsFilter
-
 	if f.crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
 		filter = api.sys.NewBlockFilter(*f.crit.BlockHash, f.crit.Addresses, f.crit.Topics)
-
-		// Block bor filter
-		if api.borLogs {
-			borLogsFilter = NewBorBlockLogsFilter(api.sys.backend, borConfig, *f.crit.BlockHash, f.crit.Addresses, f.crit.Topics)
-		}
 	} else {
 		// Convert the RPC block numbers into internal representations
 		begin := rpc.LatestBlockNumber.Int64()
@@ -462,28 +600,24 @@ func (api *FilterAPI) GetFilterLogs(ctx context.Context, id rpc.ID) ([]*types.Lo
 		}
 		// Construct the range filter
 		filter = api.sys.NewRangeFilter(begin, end, f.crit.Addresses, f.crit.Topics)
-
-		if api.borLogs {
-			borLogsFilter = NewBorBlockLogsRangeFilter(api.sys.backend, borConfig, begin, end, f.crit.Addresses, f.crit.Topics)
-		}
 	}
-	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx)
+	// Run the filter and return all the logs, along with the bor block logs
+	logs, err := api.withBorLogs(filter).Logs(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if borLogsFilter != nil {
-		// Run the filter and return all the logs
-		borBlockLogs, err := borLogsFilter.Logs(ctx)
-		if err != nil {
-			return nil, err
-		}
+	return returnLogs(logs), nil
+}
 
-		return returnLogs(types.MergeBorLogs(logs, borBlockLogs)), nil
+// withBorLogs makes the filter merge the bor block logs in, if they're enabled
+// and the chain is a bor chain.
+func (api *FilterAPI) withBorLogs(filter *Filter) *Filter {
+	if borConfig := api.sys.backend.ChainConfig().Bor; api.borLogs && borConfig != nil {
+		filter.withBorLogs(borConfig)
 	}
 
-	return returnLogs(logs), nil
+	return filter
 }
 
 // GetFilterChanges returns the logs for the filter with the given id since
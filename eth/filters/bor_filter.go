@@ -20,6 +20,8 @@ import (
 	"context"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
@@ -106,7 +108,58 @@ func (f *BorBlockLogsFilter) Logs(ctx context.Context) ([]*types.Log, error) {
 	}
 
 	// Gather all indexed logs, and finish with non indexed ones
-	return f.unindexedLogs(ctx, uint64(end))
+	var logs []*types.Log
+
+	size, sections := f.backend.LogIndexStatus()
+	if indexed := int64(sections * size); indexed > f.begin {
+		if indexed > end {
+			indexed = end + 1
+		}
+
+		found, err := f.indexedLogs(ctx, uint64(indexed-1))
+		if err != nil {
+			return found, err
+		}
+
+		logs = append(logs, found...)
+	}
+
+	found, err := f.unindexedLogs(ctx, uint64(end))
+
+	return append(logs, found...), err
+}
+
+// indexedLogs returns the logs matching the filter criteria based on the log
+// index, realigning the start of the filter on the next sprint afterwards.
+func (f *BorBlockLogsFilter) indexedLogs(ctx context.Context, end uint64) ([]*types.Log, error) {
+	matches, ok := core.ReadLogIndexMatches(f.db, uint64(f.begin), end, f.addresses, f.topics)
+	if !ok {
+		return nil, nil
+	}
+
+	var logs []*types.Log
+
+	for _, match := range matches {
+		if match.Sources&rawdb.LogIndexBorReceipt == 0 {
+			continue
+		}
+
+		receipt, err := f.backend.GetBorBlockReceipt(ctx, match.Hash)
+		if receipt == nil || err != nil {
+			continue
+		}
+
+		found, err := f.borBlockLogs(ctx, receipt)
+		if err != nil {
+			return logs, err
+		}
+
+		logs = append(logs, found...)
+	}
+
+	f.begin = currentSprintEnd(f.borConfig.CalculateSprint(end+1), int64(end)+1)
+
+	return logs, nil
 }
 
 // unindexedLogs returns the logs matching the filter criteria based on raw block
@@ -70,6 +70,7 @@ func TestBorFilters(t *testing.T) {
 	// should return the following at all times
 	backend.EXPECT().ChainDb().Return(db).AnyTimes()
 	backend.EXPECT().HeaderByNumber(gomock.Any(), gomock.Any()).Return(newTestHeader(1), nil).AnyTimes()
+	backend.EXPECT().LogIndexStatus().Return(params.LogIndexBlocks, uint64(0)).AnyTimes()
 
 	// Block 1
 	backend.expectBorReceiptsFromMock([]*common.Hash{nil, &hash1, &hash2, &hash3, &hash4})
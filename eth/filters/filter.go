@@ -19,12 +19,16 @@ package filters
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/big"
 	"slices"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
@@ -38,6 +42,8 @@ type Filter struct {
 	block      *common.Hash // Block hash if filtering a single block
 	begin, end int64        // Range interval if filtering multiple blocks
 
+	borConfig *params.BorConfig // Bor config if the bor block logs are merged in
+
 	matcher *bloombits.Matcher
 }
 
@@ -99,6 +105,14 @@ func newFilter(sys *FilterSystem, addresses []common.Address, topics [][]common.
 	}
 }
 
+// withBorLogs makes the filter merge the logs of the bor block receipts, the
+// state sync events, with the logs of the transactions in block order.
+func (f *Filter) withBorLogs(config *params.BorConfig) *Filter {
+	f.borConfig = config
+
+	return f
+}
+
 // Logs searches the blockchain for matching log entries, returning all from the
 // first block that contains matches, updating the start of the filter accordingly.
 func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
@@ -113,7 +127,12 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 			return nil, errors.New("unknown block")
 		}
 
-		return f.blockLogs(ctx, header)
+		logs, err := f.blockLogs(ctx, header)
+		if err != nil || f.borConfig == nil {
+			return logs, err
+		}
+
+		return f.mergeBorLogs(ctx, logs, NewBorBlockLogsFilter(f.sys.backend, f.borConfig, *f.block, f.addresses, f.topics))
 	}
 
 	// Disallow pending logs.
@@ -159,6 +178,9 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 		return nil, err
 	}
 
+	// The range is consumed by the filtering, the bor logs are filtered on it after
+	begin, end := f.begin, f.end
+
 	logChan, errChan := f.rangeLogsAsync(ctx)
 	var logs []*types.Log
 	for {
@@ -166,11 +188,27 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 		case log := <-logChan:
 			logs = append(logs, log)
 		case err := <-errChan:
-			return logs, err
+			if err != nil || f.borConfig == nil {
+				return logs, err
+			}
+
+			return f.mergeBorLogs(ctx, logs, NewBorBlockLogsRangeFilter(f.sys.backend, f.borConfig, begin, end, f.addresses, f.topics))
 		}
 	}
 }
 
+// mergeBorLogs merges the logs matched by the bor filter with the logs of the
+// transactions. The bor logs are the last of their blocks, after the logs of
+// all the transactions, so their indexes keep the merged logs in order.
+func (f *Filter) mergeBorLogs(ctx context.Context, logs []*types.Log, filter *BorBlockLogsFilter) ([]*types.Log, error) {
+	borLogs, err := filter.Logs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.MergeBorLogs(logs, borLogs), nil
+}
+
 // rangeLogsAsync retrieves block-range logs that match the filter criteria asynchronously,
 // it creates and returns two channels: one for delivering log data, and one for reporting errors.
 func (f *Filter) rangeLogsAsync(ctx context.Context) (chan *types.Log, chan error) {
@@ -191,6 +229,18 @@ func (f *Filter) rangeLogsAsync(ctx context.Context) (chan *types.Log, chan erro
 			size, sections = f.sys.backend.BloomStatus()
 			err            error
 		)
+		// The log index covers the most recent sections of the bloom bits it
+		// replaces, so it's used first
+		if logSize, logSections := f.sys.backend.LogIndexStatus(); logSections*logSize > uint64(f.begin) {
+			indexed := logSections * logSize
+			if indexed > end {
+				indexed = end + 1
+			}
+			if err = f.logIndexedLogs(ctx, indexed-1, logChan); err != nil {
+				errChan <- err
+				return
+			}
+		}
 		if indexed := sections * size; indexed > uint64(f.begin) {
 			if indexed > end {
 				indexed = end + 1
@@ -260,6 +310,50 @@ func (f *Filter) indexedLogs(ctx context.Context, end uint64, logChan chan *type
 	}
 }
 
+// logIndexedLogs returns the logs matching the filter criteria based on the log
+// index. If the criteria match any log, the blocks are left to the bloom bits
+// and the raw block iteration.
+func (f *Filter) logIndexedLogs(ctx context.Context, end uint64, logChan chan *types.Log) error {
+	matches, ok := core.ReadLogIndexMatches(f.sys.backend.ChainDb(), uint64(f.begin), end, f.addresses, f.topics)
+	if !ok {
+		return nil
+	}
+
+	for _, match := range matches {
+		// The matches of the bor receipt logs are for the bor filters
+		if match.Sources&rawdb.LogIndexReceipts == 0 {
+			continue
+		}
+
+		header, err := f.sys.backend.HeaderByNumber(ctx, rpc.BlockNumber(match.Number))
+		if err != nil {
+			return err
+		}
+		if header == nil {
+			return fmt.Errorf("header %d of log index match not found", match.Number)
+		}
+
+		found, err := f.checkMatches(ctx, header)
+		if err != nil {
+			return err
+		}
+		for _, log := range found {
+			select {
+			case logChan <- log:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		// The logs up to the match are delivered, they're not delivered again if
+		// the filtering goes on after a failure
+		f.begin = int64(match.Number) + 1
+	}
+
+	f.begin = int64(end) + 1
+
+	return nil
+}
+
 // unindexedLogs returns the logs matching the filter criteria based on raw block
 // iteration and bloom matching.
 func (f *Filter) unindexedLogs(ctx context.Context, end uint64, logChan chan *types.Log) error {
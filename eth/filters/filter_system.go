@@ -77,6 +77,7 @@ type Backend interface {
 	SubscribeStateSyncEvent(ch chan<- core.StateSyncEvent) event.Subscription
 
 	BloomStatus() (uint64, uint64)
+	LogIndexStatus() (uint64, uint64)
 	ServiceFilter(ctx context.Context, session *bloombits.MatcherSession)
 }
 
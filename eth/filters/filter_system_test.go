@@ -27,11 +27,13 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
@@ -42,6 +44,7 @@ import (
 type testBackend struct {
 	db              ethdb.Database
 	sections        uint64
+	logSections     uint64
 	txFeed          event.Feed
 	logsFeed        event.Feed
 	rmLogsFeed      event.Feed
@@ -165,6 +168,10 @@ func (b *testBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, b.sections
 }
 
+func (b *testBackend) LogIndexStatus() (uint64, uint64) {
+	return params.LogIndexBlocks, b.logSections
+}
+
 func (b *testBackend) GetBorBlockReceipt(ctx context.Context, blockHash common.Hash) (*types.Receipt, error) {
 	number := rawdb.ReadHeaderNumber(b.db, blockHash)
 	if number == nil {
@@ -412,6 +419,94 @@ func TestPendingTxFilterFullTx(t *testing.T) {
 	}
 }
 
+// TestPendingTxSubscriptionFilter tests that the pending transactions
+// subscription only sends the transactions matching its criteria.
+func TestPendingTxSubscriptionFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(t, db, Config{})
+		api          = NewFilterAPI(sys, true)
+
+		key, _   = crypto.GenerateKey()
+		sender   = crypto.PubkeyToAddress(key.PublicKey)
+		signer   = types.LatestSigner(params.TestChainConfig)
+		contract = common.HexToAddress("0xb794f5ea0ba39494ce83a213fffba74279579268")
+		selector = hexutil.Bytes{0xa9, 0x05, 0x9c, 0xbb}
+	)
+
+	sign := func(tx types.TxData) *types.Transaction {
+		return types.MustSignNewTx(key, signer, tx)
+	}
+
+	var (
+		transfer = sign(&types.LegacyTx{Nonce: 0, To: &contract, GasPrice: big.NewInt(10), Data: append(common.CopyBytes(selector), 1)})
+		cheap    = sign(&types.LegacyTx{Nonce: 1, To: &contract, GasPrice: big.NewInt(1), Data: selector})
+		other    = sign(&types.LegacyTx{Nonce: 2, To: &contract, GasPrice: big.NewInt(10), Data: []byte{1, 2, 3, 4}})
+		creation = sign(&types.LegacyTx{Nonce: 3, GasPrice: big.NewInt(10)})
+		unsigned = types.NewTransaction(4, contract, new(big.Int), 0, big.NewInt(10), selector)
+		txs      = []*types.Transaction{transfer, cheap, other, creation, unsigned}
+	)
+
+	server := rpc.NewServer("", 0, 0)
+	defer server.Stop()
+
+	if err := server.RegisterName("eth", api); err != nil {
+		t.Fatal(err)
+	}
+
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	for i, tc := range []struct {
+		crit *PendingTxFilterCriteria
+		want []*types.Transaction
+	}{
+		{crit: nil, want: txs},
+		{crit: &PendingTxFilterCriteria{To: []common.Address{contract}, MethodSelectors: []hexutil.Bytes{selector}}, want: []*types.Transaction{transfer, cheap, unsigned}},
+		{crit: &PendingTxFilterCriteria{From: []common.Address{sender}, MethodSelectors: []hexutil.Bytes{selector}}, want: []*types.Transaction{transfer, cheap}},
+		{crit: &PendingTxFilterCriteria{From: []common.Address{sender}, MinTip: (*hexutil.Big)(big.NewInt(5))}, want: []*types.Transaction{transfer, other, creation}},
+		{crit: &PendingTxFilterCriteria{ContractCreation: true}, want: []*types.Transaction{creation}},
+	} {
+		hashes := make(chan common.Hash, len(txs))
+
+		sub, err := client.EthSubscribe(context.Background(), hashes, "newPendingTransactions", false, tc.crit)
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+
+		// Wait for the subscription to be installed in the event system
+		time.Sleep(100 * time.Millisecond)
+		backend.txFeed.Send(core.NewTxsEvent{Txs: txs})
+
+		for j, want := range tc.want {
+			select {
+			case hash := <-hashes:
+				if hash != want.Hash() {
+					t.Fatalf("test %d, transaction %d: have %x, want %x", i, j, hash, want.Hash())
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("test %d, transaction %d: timeout", i, j)
+			}
+		}
+
+		select {
+		case hash := <-hashes:
+			t.Fatalf("test %d: unexpected transaction %x", i, hash)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		sub.Unsubscribe()
+	}
+
+	// Method selectors must have 4 bytes
+	crit := &PendingTxFilterCriteria{MethodSelectors: []hexutil.Bytes{{1, 2}}}
+	if _, err := client.EthSubscribe(context.Background(), make(chan common.Hash), "newPendingTransactions", false, crit); err == nil {
+		t.Fatal("expected error for invalid method selector")
+	}
+}
+
 // TestLogFilterCreation test whether a given filter criteria makes sense.
 // If not it must return an error.
 func TestLogFilterCreation(t *testing.T) {
@@ -641,6 +736,87 @@ func TestLogFilter(t *testing.T) {
 	}
 }
 
+// TestBorLogsSubscription tests that the bor block logs fed by the chain are
+// delivered to the log subscriptions and filters whether bor.logs is set or not,
+// as it only merges them into the logs retrieved from the database.
+func TestBorLogsSubscription(t *testing.T) {
+	t.Parallel()
+
+	var (
+		blockHash = common.Hash{0x01}
+		contract  = common.Address{0xfe}
+		txLog     = &types.Log{Address: contract, Topics: []common.Hash{}, Data: []byte{}, BlockNumber: 16, BlockHash: blockHash, TxHash: common.Hash{0x02}}
+		borLog    = &types.Log{Address: contract, Topics: []common.Hash{}, Data: []byte{}, BlockNumber: 16, BlockHash: blockHash, Index: 1}
+	)
+
+	types.DeriveFieldsForBorLogs([]*types.Log{borLog}, blockHash, 16, 1, 1)
+
+	for _, borLogs := range []bool{false, true} {
+		var (
+			db           = rawdb.NewMemoryDatabase()
+			backend, sys = newTestFilterSystem(t, db, Config{})
+			api          = NewFilterAPI(sys, borLogs)
+			want         = []*types.Log{txLog, borLog}
+		)
+
+		server := rpc.NewServer("", 0, 0)
+		if err := server.RegisterName("eth", api); err != nil {
+			t.Fatal(err)
+		}
+
+		client := rpc.DialInProc(server)
+
+		logs := make(chan types.Log, 2)
+
+		sub, err := client.EthSubscribe(context.Background(), logs, "logs", map[string]interface{}{"address": contract})
+		if err != nil {
+			t.Fatalf("borLogs %v: %v", borLogs, err)
+		}
+
+		id, err := api.NewFilter(FilterCriteria{Addresses: []common.Address{contract}})
+		if err != nil {
+			t.Fatalf("borLogs %v: %v", borLogs, err)
+		}
+
+		// Wait for the subscription to be installed in the event system
+		time.Sleep(100 * time.Millisecond)
+
+		if nsend := backend.logsFeed.Send([]*types.Log{txLog, borLog}); nsend == 0 {
+			t.Fatalf("borLogs %v: logs event not delivered", borLogs)
+		}
+
+		for i, want := range want {
+			select {
+			case log := <-logs:
+				if log.TxHash != want.TxHash {
+					t.Fatalf("borLogs %v, log %d: have tx %x, want %x", borLogs, i, log.TxHash, want.TxHash)
+				}
+			case <-time.After(time.Second):
+				t.Fatalf("borLogs %v, log %d: timeout", borLogs, i)
+			}
+		}
+
+		select {
+		case log := <-logs:
+			t.Fatalf("borLogs %v: unexpected log of tx %x", borLogs, log.TxHash)
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		changes, err := api.GetFilterChanges(id)
+		if err != nil {
+			t.Fatalf("borLogs %v: %v", borLogs, err)
+		}
+
+		if fetched := changes.([]*types.Log); !reflect.DeepEqual(fetched, want) {
+			t.Fatalf("borLogs %v: have %d filter changes, want %d", borLogs, len(fetched), len(want))
+		}
+
+		sub.Unsubscribe()
+		client.Close()
+		server.Stop()
+	}
+}
+
 // TestPendingTxFilterDeadlock tests if the event loop hangs when pending
 // txes arrive at the same time that one of multiple filters is timing out.
 // Please refer to #22131 for more details.
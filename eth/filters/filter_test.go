@@ -391,3 +391,222 @@ func TestFilters(t *testing.T) {
 		}
 	})
 }
+
+func TestLogIndexFilters(t *testing.T) {
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(t, db, Config{})
+		key1, _      = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr         = crypto.PubkeyToAddress(key1.PublicKey)
+		signer       = types.NewLondonSigner(big.NewInt(1))
+		// Logging contracts, emitting a log with the topic 0x01 and 0x02
+		contract  = common.Address{0xfe}
+		contract2 = common.Address{0xff}
+		hash1     = common.HexToHash("0x01")
+		hash2     = common.HexToHash("0x02")
+		gspec     = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr:      {Balance: big.NewInt(0).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+				contract:  {Balance: big.NewInt(0), Code: common.FromHex("0x600160006000a100")},
+				contract2: {Balance: big.NewInt(0), Code: common.FromHex("0x600260006000a100")},
+			},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+	)
+
+	_, err := gspec.Commit(db, triedb.NewDatabase(db, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Blocks 2, 3 and 1030 have logs, the latter isn't covered by the log index
+	nonce := uint64(0)
+	chain, _ := core.GenerateChain(gspec.Config, gspec.ToBlock(), ethash.NewFaker(), db, 1030, func(i int, gen *core.BlockGen) {
+		if i != 1 && i != 2 && i != 1029 {
+			return
+		}
+		for _, to := range []common.Address{contract, contract2} {
+			tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{
+				Nonce:    nonce,
+				GasPrice: gen.BaseFee(),
+				Gas:      30000,
+				To:       &to,
+			}), signer, key1)
+			gen.AddTx(tx)
+			nonce++
+		}
+	})
+
+	bc, err := core.NewBlockChain(db, nil, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bc.Stop()
+
+	if _, err = bc.InsertChain(chain); err != nil {
+		t.Fatal(err)
+	}
+
+	indexer := core.NewLogIndexer(db, params.LogIndexBlocks, 0)
+	indexer.Start(bc)
+	defer indexer.Close()
+
+	for deadline := time.Now().Add(5 * time.Second); ; time.Sleep(10 * time.Millisecond) {
+		if sections, _, _ := indexer.Sections(); sections == 1 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("log index section not indexed")
+		}
+	}
+
+	// A stale entry of a reorged block is ignored
+	rawdb.WriteLogIndexEntry(db, rawdb.LogIndexAddressKey(contract), &rawdb.LogIndexEntry{Number: 5, Hash: common.Hash{1}, Sources: rawdb.LogIndexReceipts})
+
+	for i, tc := range []struct {
+		addresses []common.Address
+		topics    [][]common.Hash
+		want      int
+	}{
+		{addresses: []common.Address{contract}, want: 3},
+		{addresses: []common.Address{contract, contract2}, want: 6},
+		{topics: [][]common.Hash{{hash2}}, want: 3},
+		{addresses: []common.Address{contract}, topics: [][]common.Hash{{hash2}}},
+		{addresses: []common.Address{contract2}, topics: [][]common.Hash{{hash1, hash2}}, want: 3},
+		{want: 6},
+	} {
+		var results [2][]*types.Log
+		for j, sections := range []uint64{0, 1} {
+			backend.logSections = sections
+
+			logs, err := sys.NewRangeFilter(0, int64(rpc.LatestBlockNumber), tc.addresses, tc.topics).Logs(context.Background())
+			if err != nil {
+				t.Fatalf("test %d, sections %d: %v", i, sections, err)
+			}
+			results[j] = logs
+		}
+		if len(results[1]) != tc.want {
+			t.Fatalf("test %d, have %d logs, want %d", i, len(results[1]), tc.want)
+		}
+		have, _ := json.Marshal(results[1])
+		want, _ := json.Marshal(results[0])
+		if string(have) != string(want) {
+			t.Fatalf("test %d, indexed logs differ, have:\n%s\nwant:\n%s", i, have, want)
+		}
+	}
+}
+
+func TestLogIndexFiltersMissingHeader(t *testing.T) {
+	var (
+		db           = rawdb.NewMemoryDatabase()
+		backend, sys = newTestFilterSystem(t, db, Config{})
+		contract     = common.Address{0xfe}
+		hash         = common.Hash{1}
+	)
+
+	// The log index has a canonical match whose header is missing
+	backend.logSections = 1
+
+	rawdb.WriteCanonicalHash(db, hash, 5)
+	rawdb.WriteLogIndexEntry(db, rawdb.LogIndexAddressKey(contract), &rawdb.LogIndexEntry{Number: 5, Hash: hash, Sources: rawdb.LogIndexReceipts})
+
+	filter := sys.NewRangeFilter(0, 10, []common.Address{contract}, nil)
+	if _, err := filter.Logs(context.Background()); err == nil {
+		t.Fatal("missing header of a log index match not reported")
+	}
+}
+
+func TestBorLogsMerged(t *testing.T) {
+	var (
+		db            = rawdb.NewMemoryDatabase()
+		_, sys        = newTestFilterSystem(t, db, Config{})
+		key1, _       = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+		addr          = crypto.PubkeyToAddress(key1.PublicKey)
+		signer        = types.NewLondonSigner(big.NewInt(1))
+		contract      = common.Address{0xfe}
+		stateReceiver = common.HexToAddress("0x0000000000000000000000000000000000001001")
+		gspec         = &core.Genesis{
+			Config: params.TestChainConfig,
+			Alloc: types.GenesisAlloc{
+				addr:     {Balance: big.NewInt(0).Mul(big.NewInt(100), big.NewInt(params.Ether))},
+				contract: {Balance: big.NewInt(0), Code: common.FromHex("0x600160006000a100")},
+			},
+			BaseFee: big.NewInt(params.InitialBaseFee),
+		}
+	)
+
+	_, err := gspec.Commit(db, triedb.NewDatabase(db, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Block 4 ends a sprint, it has a transaction log and a state sync log
+	chain, _ := core.GenerateChain(gspec.Config, gspec.ToBlock(), ethash.NewFaker(), db, 6, func(i int, gen *core.BlockGen) {
+		if i != 3 {
+			return
+		}
+		tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{
+			Nonce:    0,
+			GasPrice: gen.BaseFee(),
+			Gas:      30000,
+			To:       &contract,
+		}), signer, key1)
+		gen.AddTx(tx)
+	})
+
+	bc, err := core.NewBlockChain(db, nil, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bc.Stop()
+
+	if _, err = bc.InsertChain(chain); err != nil {
+		t.Fatal(err)
+	}
+
+	block := chain[3]
+	rawdb.WriteBorReceipt(db, block.Hash(), block.NumberU64(), &types.ReceiptForStorage{
+		Status: types.ReceiptStatusSuccessful,
+		Logs: []*types.Log{
+			{Address: stateReceiver, Topics: []common.Hash{common.HexToHash("0x02")}},
+			{Address: contract, Topics: []common.Hash{common.HexToHash("0x03")}},
+		},
+	})
+
+	latest := rpc.LatestBlockNumber
+	for i, tc := range []struct {
+		borLogs bool
+		crit    FilterCriteria
+		want    int
+	}{
+		{borLogs: false, crit: FilterCriteria{FromBlock: big.NewInt(0), Addresses: []common.Address{contract}}, want: 1},
+		{borLogs: true, crit: FilterCriteria{FromBlock: big.NewInt(0), Addresses: []common.Address{contract}}, want: 2},
+		{borLogs: true, crit: FilterCriteria{BlockHash: &[]common.Hash{block.Hash()}[0]}, want: 3},
+		{borLogs: true, crit: FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(latest.Int64())}, want: 3},
+		{borLogs: true, crit: FilterCriteria{FromBlock: big.NewInt(5), ToBlock: big.NewInt(latest.Int64())}},
+	} {
+		logs, err := NewFilterAPI(sys, tc.borLogs).GetLogs(context.Background(), tc.crit)
+		if err != nil {
+			t.Fatalf("test %d: %v", i, err)
+		}
+		if len(logs) != tc.want {
+			t.Fatalf("test %d, have %d logs, want %d", i, len(logs), tc.want)
+		}
+		// The state sync logs follow the transaction logs of the block, as the
+		// logs of a pseudo transaction after the last one
+		for j, log := range logs {
+			if log.BlockHash != block.Hash() || log.BlockNumber != block.NumberU64() {
+				t.Fatalf("test %d, log %d: wrong block %x", i, j, log.BlockHash)
+			}
+			if j > 0 && log.Index <= logs[j-1].Index {
+				t.Fatalf("test %d, log %d: index %d out of order", i, j, log.Index)
+			}
+			if log.Address == contract && log.Topics[0] == common.HexToHash("0x01") {
+				if log.TxIndex != 0 || log.TxHash != block.Transactions()[0].Hash() {
+					t.Fatalf("test %d, log %d: wrong transaction %d %x", i, j, log.TxIndex, log.TxHash)
+				}
+			} else if log.TxIndex != 1 || log.TxHash != types.GetDerivedBorTxHash(types.BorReceiptKey(block.NumberU64(), block.Hash())) {
+				t.Fatalf("test %d, log %d: wrong state sync transaction %d %x", i, j, log.TxIndex, log.TxHash)
+			}
+		}
+	}
+}
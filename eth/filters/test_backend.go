@@ -33,6 +33,10 @@ func (b *TestBackend) BloomStatus() (uint64, uint64) {
 	return params.BloomBitsBlocks, b.sections
 }
 
+func (b *TestBackend) LogIndexStatus() (uint64, uint64) {
+	return params.LogIndexBlocks, 0
+}
+
 func (b *TestBackend) GetBorBlockReceipt(ctx context.Context, hash common.Hash) (*types.Receipt, error) {
 	number := rawdb.ReadHeaderNumber(b.DB, hash)
 	if number == nil {
@@ -77,9 +77,17 @@ type Oracle struct {
 	historyCache *lru.Cache[cacheKey, processedFees]
 }
 
-// NewOracle returns a new gasprice oracle which can recommend suitable
-// gasprice for newly created transaction.
-func NewOracle(backend OracleBackend, params Config) *Oracle {
+// sanitizedConfig holds the oracle parameters after they were checked and
+// corrected by sanitizeConfig.
+type sanitizedConfig struct {
+	checkBlocks, percentile           int
+	maxPrice, ignorePrice             *big.Int
+	maxHeaderHistory, maxBlockHistory uint64
+}
+
+// sanitizeConfig checks the provided oracle parameters and changes anything
+// that's unreasonable or unworkable.
+func sanitizeConfig(params Config) sanitizedConfig {
 	blocks := params.Blocks
 	if blocks < 1 {
 		blocks = 1
@@ -122,6 +130,21 @@ func NewOracle(backend OracleBackend, params Config) *Oracle {
 		log.Warn("Sanitizing invalid gasprice oracle max block history", "provided", params.MaxBlockHistory, "updated", maxBlockHistory)
 	}
 
+	return sanitizedConfig{
+		checkBlocks:      blocks,
+		percentile:       percent,
+		maxPrice:         maxPrice,
+		ignorePrice:      ignorePrice,
+		maxHeaderHistory: maxHeaderHistory,
+		maxBlockHistory:  maxBlockHistory,
+	}
+}
+
+// NewOracle returns a new gasprice oracle which can recommend suitable
+// gasprice for newly created transaction.
+func NewOracle(backend OracleBackend, params Config) *Oracle {
+	conf := sanitizeConfig(params)
+
 	cache := lru.NewCache[cacheKey, processedFees](2048)
 	headEvent := make(chan core.ChainHeadEvent, 1)
 	backend.SubscribeChainHeadEvent(headEvent)
@@ -140,16 +163,36 @@ func NewOracle(backend OracleBackend, params Config) *Oracle {
 	return &Oracle{
 		backend:          backend,
 		lastPrice:        params.Default,
-		maxPrice:         maxPrice,
-		ignorePrice:      ignorePrice,
-		checkBlocks:      blocks,
-		percentile:       percent,
-		maxHeaderHistory: maxHeaderHistory,
-		maxBlockHistory:  maxBlockHistory,
+		maxPrice:         conf.maxPrice,
+		ignorePrice:      conf.ignorePrice,
+		checkBlocks:      conf.checkBlocks,
+		percentile:       conf.percentile,
+		maxHeaderHistory: conf.maxHeaderHistory,
+		maxBlockHistory:  conf.maxBlockHistory,
 		historyCache:     cache,
 	}
 }
 
+// SetConfig replaces the sampling parameters of a running oracle. The cached
+// suggestion is discarded so the next request is computed with the new values.
+func (oracle *Oracle) SetConfig(params Config) {
+	conf := sanitizeConfig(params)
+
+	oracle.fetchLock.Lock()
+	defer oracle.fetchLock.Unlock()
+
+	oracle.cacheLock.Lock()
+	defer oracle.cacheLock.Unlock()
+
+	oracle.maxPrice = conf.maxPrice
+	oracle.ignorePrice = conf.ignorePrice
+	oracle.checkBlocks = conf.checkBlocks
+	oracle.percentile = conf.percentile
+	oracle.maxHeaderHistory = conf.maxHeaderHistory
+	oracle.maxBlockHistory = conf.maxBlockHistory
+	oracle.lastHead = common.Hash{}
+}
+
 func (oracle *Oracle) ProcessCache() {
 	headEvent := make(chan core.ChainHeadEvent, 1)
 	oracle.backend.SubscribeChainHeadEvent(headEvent)
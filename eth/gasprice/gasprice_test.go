@@ -248,3 +248,38 @@ func TestSuggestTipCap(t *testing.T) {
 		}
 	}
 }
+
+func TestSetConfig(t *testing.T) {
+	config := Config{
+		Blocks:     3,
+		Percentile: 60,
+		Default:    big.NewInt(params.GWei),
+	}
+
+	backend := newTestBackend(t, big.NewInt(0), nil, false)
+	defer backend.teardown()
+
+	oracle := NewOracle(backend, config)
+
+	got, err := oracle.SuggestTipCap(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to retrieve recommended gas price: %v", err)
+	}
+
+	if want := big.NewInt(params.GWei * int64(30)); got.Cmp(want) != 0 {
+		t.Fatalf("Gas price mismatch, want %d, got %d", want, got)
+	}
+
+	// Lower the price cap, the cached suggestion must not be served anymore
+	config.MaxPrice = big.NewInt(params.GWei * int64(10))
+	oracle.SetConfig(config)
+
+	got, err = oracle.SuggestTipCap(context.Background())
+	if err != nil {
+		t.Fatalf("Failed to retrieve recommended gas price: %v", err)
+	}
+
+	if got.Cmp(config.MaxPrice) != 0 {
+		t.Fatalf("Gas price mismatch, want %d, got %d", config.MaxPrice, got)
+	}
+}
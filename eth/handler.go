@@ -27,6 +27,8 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/clique"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -35,8 +37,10 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/fetcher"
+	borproto "github.com/ethereum/go-ethereum/eth/protocols/bor"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
+	"github.com/ethereum/go-ethereum/eth/reputation"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/internal/ethapi"
@@ -44,6 +48,7 @@ import (
 	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/netutil"
 	"github.com/ethereum/go-ethereum/triedb/pathdb"
 )
 
@@ -98,6 +103,9 @@ type handlerConfig struct {
 	checker             ethereum.ChainValidator
 	RequiredBlocks      map[uint64]common.Hash // Hard coded map of required block hashes for sync challenges
 	EthAPI              *ethapi.BlockChainAPI  // EthAPI to interact
+	Reputation          *reputation.Store      // Reputation store of the misbehaving peers, disabled if nil
+	ValidatorMesh       *validatorMesh         // Connections to the upcoming producers, disabled if nil
+	PrivatePeers        []*enode.Node          // Validator nodes behind the sentry node, whose blocks and transactions are relayed to all peers
 	enableBlockTracking bool                   // Whether to log information collected while tracking block lifecycle
 }
 
@@ -106,8 +114,9 @@ type handler struct {
 	networkID  uint64
 	forkFilter forkid.Filter // Fork ID filter, constant across the lifetime of the node
 
-	snapSync atomic.Bool // Flag whether snap sync is enabled (gets disabled if we already have blocks)
-	synced   atomic.Bool // Flag whether we're considered synchronised (enables transaction processing)
+	snapSync       atomic.Bool // Flag whether snap sync is enabled (gets disabled if we already have blocks)
+	checkpointSync atomic.Bool // Flag whether the snap sync is anchored on the latest checkpoint
+	synced         atomic.Bool // Flag whether we're considered synchronised (enables transaction processing)
 
 	database ethdb.Database
 	txpool   txPool
@@ -119,7 +128,13 @@ type handler struct {
 	txFetcher    *fetcher.TxFetcher
 	peers        *peerSet
 
-	ethAPI *ethapi.BlockChainAPI // EthAPI to interact
+	borPeers     map[string]*borproto.Peer // Peers supporting the `bor` protocol
+	borPeersLock sync.RWMutex              // Lock protecting the `bor` peers
+
+	ethAPI     *ethapi.BlockChainAPI // EthAPI to interact
+	reputation *reputation.Store     // Reputation store of the misbehaving peers
+	mesh       *validatorMesh        // Connections to the upcoming producers, receiving the blocks first
+	relay      *sentryRelay          // Blocks and transactions of the private peers, relayed to all peers
 
 	eventMux      *event.TypeMux
 	txsCh         chan core.NewTxsEvent
@@ -156,7 +171,11 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		txpool:              config.TxPool,
 		chain:               config.Chain,
 		peers:               newPeerSet(),
+		borPeers:            make(map[string]*borproto.Peer),
 		ethAPI:              config.EthAPI,
+		reputation:          config.Reputation,
+		mesh:                config.ValidatorMesh,
+		relay:               newSentryRelay(config.PrivatePeers),
 		requiredBlocks:      config.RequiredBlocks,
 		enableBlockTracking: config.enableBlockTracking,
 		quitSync:            make(chan struct{}),
@@ -193,7 +212,8 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		} else {
 			// If snap sync was requested and our database is empty, grant it
 			h.snapSync.Store(true)
-			log.Info("Enabled snap sync", "head", head.Number, "hash", head.Hash())
+			h.checkpointSync.Store(config.Sync == downloader.CheckpointSync)
+			log.Info("Enabled snap sync", "head", head.Number, "hash", head.Hash(), "checkpoint", h.checkpointSync.Load())
 		}
 	}
 	// If snap sync is requested but snapshots are disabled, fail loudly
@@ -202,6 +222,7 @@ func newHandler(config *handlerConfig) (*handler, error) {
 	}
 	// Construct the downloader (long sync)
 	h.downloader = downloader.New(config.Database, h.eventMux, h.chain, nil, h.removePeer, h.enableSyncedFeatures, config.checker)
+	h.downloader.SetBorReceiptsFetcher((*borReceiptsHandler)(h))
 	if ttd := h.chain.Config().TerminalTotalDifficulty; ttd != nil {
 		if h.chain.Config().TerminalTotalDifficultyPassed {
 			log.Info("Chain post-merge, sync via beacon client")
@@ -251,7 +272,7 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		return nil, errors.New("snap sync not supported with snapshots disabled")
 	}
 
-	h.blockFetcher = fetcher.NewBlockFetcher(false, nil, h.chain.GetBlockByHash, validator, h.BroadcastBlock, heighter, nil, inserter, h.removePeer, h.enableBlockTracking)
+	h.blockFetcher = fetcher.NewBlockFetcher(false, nil, h.chain.GetBlockByHash, validator, h.BroadcastBlock, heighter, nil, inserter, h.removePeer, h.badBlock, h.enableBlockTracking)
 
 	fetchTx := func(peer string, hashes []common.Hash) error {
 		p := h.peers.peer(peer)
@@ -465,6 +486,37 @@ func (h *handler) removePeer(id string) {
 	}
 }
 
+// reportPeer records a violation of a peer in the reputation store, and
+// disconnects the peer if it gets banned.
+func (h *handler) reportPeer(id string, violation reputation.Violation) {
+	if h.reputation == nil {
+		return
+	}
+
+	peer := h.peers.peer(id)
+	if peer == nil {
+		return
+	}
+
+	ip := netutil.AddrAddr(peer.RemoteAddr())
+	if h.reputation.Report(peer.Node().ID(), ip, violation) {
+		peer.Log().Debug("Disconnecting banned peer", "violation", violation)
+		peer.Peer.Disconnect(p2p.DiscUselessPeer)
+	}
+}
+
+// badBlock reports a peer propagating a block failing the header verification
+// because of its seal. The other failures, like a missing ancestor, a block too
+// soon or a snapshot not available locally, aren't necessarily the fault of the
+// peer.
+func (h *handler) badBlock(id string, header *types.Header, err error) {
+	if !bor.IsSealError(err) && !clique.IsSealError(err) {
+		return
+	}
+
+	h.reportPeer(id, reputation.InvalidSeal)
+}
+
 // unregisterPeer removes a peer from the downloader, fetchers and main peer set.
 func (h *handler) unregisterPeer(id string) {
 	// Create a custom logger to avoid printing the entire id
@@ -518,12 +570,21 @@ func (h *handler) Start(maxPeers int) {
 	// start peer handler tracker
 	h.wg.Add(1)
 	go h.protoTracker()
+
+	// connect to the upcoming producers
+	if h.mesh != nil {
+		h.mesh.start()
+	}
 }
 
 func (h *handler) Stop() {
 	h.txsSub.Unsubscribe() // quits txBroadcastLoop
 	h.minedBlockSub.Unsubscribe()
 
+	if h.mesh != nil {
+		h.mesh.stop()
+	}
+
 	// Quit chainSync and txsync64.
 	// After this is done, no new peers will be accepted.
 	close(h.quitSync)
@@ -562,6 +623,26 @@ func (h *handler) BroadcastBlock(block *types.Block, propagate bool) {
 			return
 		}
 
+		// Push the block to the upcoming producers first, so that the next block
+		// isn't delayed by the propagation
+		priorityPeers := []*ethPeer{}
+		otherPeers := make([]*ethPeer, 0, len(peers))
+
+		for _, peer := range peers {
+			if h.mesh.isPriority(peer.Node().ID()) {
+				priorityPeers = append(priorityPeers, peer)
+			} else {
+				otherPeers = append(otherPeers, peer)
+			}
+		}
+
+		for _, peer := range priorityPeers {
+			log.Trace("Propagating block to upcoming producer", "hash", hash, "peerID", peer.ID())
+			peer.AsyncSendNewBlock(block, td)
+		}
+
+		peers = otherPeers
+
 		// These are the static and trusted peers which are not
 		// in `transfer := peers[:int(math.Sqrt(float64(len(peers))))]`
 		staticAndTrustedPeers := []*ethPeer{}
@@ -572,8 +653,13 @@ func (h *handler) BroadcastBlock(block *types.Block, propagate bool) {
 			}
 		}
 
-		// Send the block to a subset of our peers
+		// Send the block to a subset of our peers, or to all of them if it was
+		// received from a private peer of the sentry node
 		transfer := peers[:int(math.Sqrt(float64(len(peers))))]
+		if h.relay.relayBlock(hash) {
+			transfer, staticAndTrustedPeers = peers, nil
+		}
+
 		for _, peer := range transfer {
 			peer.AsyncSendNewBlock(block, td)
 		}
@@ -584,7 +670,7 @@ func (h *handler) BroadcastBlock(block *types.Block, propagate bool) {
 			peer.AsyncSendNewBlock(block, td)
 		}
 
-		log.Debug("Propagated block", "hash", hash, "recipients", len(transfer), "static and trusted recipients", len(staticAndTrustedPeers), "duration", common.PrettyDuration(time.Since(block.ReceivedAt)))
+		log.Debug("Propagated block", "hash", hash, "recipients", len(transfer), "producer recipients", len(priorityPeers), "static and trusted recipients", len(staticAndTrustedPeers), "duration", common.PrettyDuration(time.Since(block.ReceivedAt)))
 
 		return
 	}
@@ -635,6 +721,10 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 		default:
 			maybeDirect = true
 		}
+		// The transactions received from the private peers of a sentry node are
+		// sent directly to all the peers
+		relayed := maybeDirect && h.relay.relayTx(tx.Hash())
+
 		// Send the transaction (if it's small enough) directly to a subset of
 		// the peers that have not received it yet, ensuring that the flow of
 		// transactions is grouped by account to (try and) avoid nonce gaps.
@@ -644,7 +734,9 @@ func (h *handler) BroadcastTransactions(txs types.Transactions) {
 		// `sha(self, peer, sender) mod peers < sqrt(peers)`.
 		for _, peer := range h.peers.peersWithoutTransaction(tx.Hash()) {
 			var broadcast bool
-			if maybeDirect {
+			if relayed {
+				broadcast = true
+			} else if maybeDirect {
 				hasher.Reset()
 				hasher.Write(h.nodeID.Bytes())
 				hasher.Write(peer.Node().ID().Bytes())
@@ -720,6 +812,7 @@ func (h *handler) enableSyncedFeatures() {
 	if h.snapSync.Load() {
 		log.Info("Snap sync complete, auto disabling")
 		h.snapSync.Store(false)
+		h.checkpointSync.Store(false)
 	}
 	if h.chain.TrieDB().Scheme() == rawdb.PathScheme {
 		h.chain.TrieDB().SetBufferSize(pathdb.DefaultBufferSize)
@@ -0,0 +1,211 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	borproto "github.com/ethereum/go-ethereum/eth/protocols/bor"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+var (
+	// errNoBorReceiptsServed is returned if none of the `bor` peers served the
+	// requested bor receipts.
+	errNoBorReceiptsServed = errors.New("no peer served the bor receipts")
+
+	// errInvalidBorReceipts is returned if a `bor` peer served bor receipts not
+	// matching the state syncs committed by the blocks.
+	errInvalidBorReceipts = errors.New("invalid bor receipts")
+)
+
+// borPeerInfo represents a short summary of the `bor` sub-protocol metadata known
+// about a connected peer.
+type borPeerInfo struct {
+	Version uint `json:"version"` // Bor protocol version negotiated
+}
+
+// borReceiptsHandler implements the bor.Backend interface to serve the bor
+// receipts, and retrieves them from the `bor` peers for the downloader.
+type borReceiptsHandler handler
+
+func (h *borReceiptsHandler) Chain() *core.BlockChain { return h.chain }
+
+// RunPeer is invoked when a peer joins on the `bor` protocol.
+func (h *borReceiptsHandler) RunPeer(peer *borproto.Peer, hand borproto.Handler) error {
+	if !(*handler)(h).incHandlers() {
+		return p2p.DiscQuitting
+	}
+	defer (*handler)(h).decHandlers()
+
+	h.borPeersLock.Lock()
+	if _, ok := h.borPeers[peer.ID()]; ok {
+		h.borPeersLock.Unlock()
+		return errPeerAlreadyRegistered
+	}
+	h.borPeers[peer.ID()] = peer
+	h.borPeersLock.Unlock()
+
+	defer func() {
+		h.borPeersLock.Lock()
+		delete(h.borPeers, peer.ID())
+		h.borPeersLock.Unlock()
+	}()
+
+	return hand(peer)
+}
+
+// PeerInfo retrieves all known `bor` information about a peer.
+func (h *borReceiptsHandler) PeerInfo(id enode.ID) interface{} {
+	h.borPeersLock.RLock()
+	defer h.borPeersLock.RUnlock()
+
+	if p, ok := h.borPeers[id.String()]; ok {
+		return &borPeerInfo{Version: p.Version()}
+	}
+
+	return nil
+}
+
+// StateSyncs implements downloader.BorReceiptsFetcher, retrieving the state syncs
+// committed by the blocks from heimdall.
+func (h *borReceiptsHandler) StateSyncs(ctx context.Context, headers []*types.Header, lastStateID uint64) ([][]uint64, error) {
+	engine, ok := h.chain.Engine().(*bor.Bor)
+	if !ok || engine.HeimdallClient == nil {
+		return nil, downloader.ErrBorReceiptsUnverifiable
+	}
+
+	return engine.CommittedStateSyncs(ctx, h.chain, headers, lastStateID)
+}
+
+// FetchBorReceipts implements downloader.BorReceiptsFetcher, retrieving the bor
+// receipts from the `bor` peers one after the other until all of them are served.
+// Peers serving invalid bor receipts are dropped.
+//
+// A block committing state syncs has no bor receipt if all of them were sent to
+// accounts without code, which can't be told apart from a peer withholding it,
+// so the other peers are asked for the bor receipts missing from such blocks.
+func (h *borReceiptsHandler) FetchBorReceipts(ctx context.Context, headers []*types.Header, stateSyncs [][]uint64) ([]*types.Receipt, error) {
+	h.borPeersLock.RLock()
+	peers := make([]*borproto.Peer, 0, len(h.borPeers))
+	for _, peer := range h.borPeers {
+		peers = append(peers, peer)
+	}
+	h.borPeersLock.RUnlock()
+
+	if len(peers) == 0 {
+		return nil, downloader.ErrNoBorReceiptsPeers
+	}
+
+	receipts := make([]*types.Receipt, 0, len(headers))
+
+	for i := 0; i < len(peers) && len(receipts) < len(headers); i++ {
+		for len(receipts) < len(headers) {
+			served, err := h.requestBorReceipts(ctx, peers[i], headers[len(receipts):], stateSyncs[len(receipts):])
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			if errors.Is(err, errInvalidBorReceipts) {
+				peers = append(peers[:i], peers[i+1:]...)
+				i--
+			}
+
+			if err != nil {
+				break
+			}
+
+			receipts = append(receipts, served...)
+		}
+	}
+
+	if len(receipts) < len(headers) {
+		return nil, errNoBorReceiptsServed
+	}
+
+	for i, receipt := range receipts {
+		if receipt != nil || len(stateSyncs[i]) == 0 {
+			continue
+		}
+
+		for j := 0; j < len(peers) && receipts[i] == nil; j++ {
+			served, err := h.requestBorReceipts(ctx, peers[j], headers[i:i+1], stateSyncs[i:i+1])
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+
+			if errors.Is(err, errInvalidBorReceipts) {
+				peers = append(peers[:j], peers[j+1:]...)
+				j--
+			}
+
+			if err == nil {
+				receipts[i] = served[0]
+			}
+		}
+	}
+
+	return receipts, nil
+}
+
+// requestBorReceipts requests the bor receipts of the blocks from a `bor` peer,
+// returning the verified bor receipts served for the first of them. Peers serving
+// invalid bor receipts are dropped.
+func (h *borReceiptsHandler) requestBorReceipts(ctx context.Context, peer *borproto.Peer, headers []*types.Header, stateSyncs [][]uint64) ([]*types.Receipt, error) {
+	hashes := make([]common.Hash, 0, len(headers))
+	for _, header := range headers {
+		hashes = append(hashes, header.Hash())
+	}
+
+	res, err := peer.RequestBorReceipts(ctx, hashes)
+	if err == nil && len(res) == 0 {
+		err = errNoBorReceiptsServed
+	}
+
+	if err != nil {
+		peer.Log().Debug("Bor receipts not served", "err", err)
+		return nil, err
+	}
+
+	served, err := borReceiptsFromPacket(res)
+	if err == nil && len(served) > len(headers) {
+		err = fmt.Errorf("%d bor receipts for %d blocks", len(served), len(headers))
+	}
+
+	if err == nil {
+		err = borproto.VerifyBorReceipts(h.chain.Config().Bor, headers[:len(served)], served, stateSyncs[:len(served)])
+	}
+
+	if err != nil {
+		peer.Log().Debug("Invalid bor receipts served", "err", err)
+		(*handler)(h).removePeer(peer.ID())
+
+		return nil, fmt.Errorf("%w: %v", errInvalidBorReceipts, err)
+	}
+
+	return served, nil
+}
+
+// borReceiptsFromPacket converts the bor receipts of a response, holding at most
+// one bor receipt per block.
+func borReceiptsFromPacket(res [][]*types.ReceiptForStorage) ([]*types.Receipt, error) {
+	receipts := make([]*types.Receipt, len(res))
+
+	for i, entry := range res {
+		switch len(entry) {
+		case 0:
+		case 1:
+			receipts[i] = (*types.Receipt)(entry[0])
+		default:
+			return nil, fmt.Errorf("%d bor receipts for a single block", len(entry))
+		}
+	}
+
+	return receipts, nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/eth/reputation"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 )
 
@@ -80,9 +81,11 @@ func (h *ethHandler) Handle(peer *eth.Peer, packet eth.Packet) error {
 				return errors.New("disallowed broadcast blob transaction")
 			}
 		}
+		h.relay.markTxs(peer.Node().ID(), *packet)
 		return h.txFetcher.Enqueue(peer.ID(), *packet, false)
 
 	case *eth.PooledTransactionsResponse:
+		h.relay.markTxs(peer.Node().ID(), *packet)
 		return h.txFetcher.Enqueue(peer.ID(), *packet, true)
 
 	default:
@@ -107,6 +110,7 @@ func (h *ethHandler) handleBlockAnnounces(peer *eth.Peer, hashes []common.Hash,
 	}
 
 	for i := 0; i < len(unknownHashes); i++ {
+		h.relay.markBlock(peer.Node().ID(), unknownHashes[i])
 		h.blockFetcher.Notify(peer.ID(), unknownHashes[i], unknownNumbers[i], time.Now(), peer.RequestOneHeader, peer.RequestBodies)
 	}
 
@@ -116,7 +120,14 @@ func (h *ethHandler) handleBlockAnnounces(peer *eth.Peer, hashes []common.Hash,
 // handleBlockBroadcast is invoked from a peer's message handler when it transmits a
 // block broadcast for the local node to process.
 func (h *ethHandler) handleBlockBroadcast(peer *eth.Peer, block *types.Block, td *big.Int) error {
+	// Blocks with a malformed transaction dependency are still importable, as the
+	// metadata is then ignored, but the peer shouldn't have propagated them
+	if core.InvalidTxDependency(block) {
+		peer.Log().Debug("Propagated block with invalid tx dependency", "number", block.Number(), "hash", block.Hash())
+		(*handler)(h).reportPeer(peer.ID(), reputation.InvalidTxDependency)
+	}
 	// Schedule the block for import
+	h.relay.markBlock(peer.Node().ID(), block.Hash())
 	h.blockFetcher.Enqueue(peer.ID(), block)
 
 	// Assuming the block is importable by the peer, but possibly not yet done so,
@@ -0,0 +1,131 @@
+package bor
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Role is the role of a node in the bor network, advertised in its ENR entry.
+type Role uint8
+
+const (
+	RoleFull      Role = iota // Full node, pruning the old state
+	RoleArchive               // Archive node, keeping all the state
+	RoleValidator             // Validator node, producing blocks
+	RoleSentry                // Sentry node, relaying for validator nodes
+	RoleRPC                   // Node serving RPC requests
+)
+
+var roleNames = []string{
+	RoleFull:      "full",
+	RoleArchive:   "archive",
+	RoleValidator: "validator",
+	RoleSentry:    "sentry",
+	RoleRPC:       "rpc",
+}
+
+func (r Role) String() string {
+	if int(r) < len(roleNames) {
+		return roleNames[r]
+	}
+
+	return fmt.Sprintf("unknown(%d)", uint8(r))
+}
+
+// ParseRole parses the name of a node role.
+func ParseRole(name string) (Role, error) {
+	for i, roleName := range roleNames {
+		if strings.EqualFold(name, roleName) {
+			return Role(i), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown node role %q, want one of %s", name, strings.Join(roleNames, ", "))
+}
+
+var (
+	errNoAttestation      = errors.New("no validator attestation")
+	errInvalidAttestation = errors.New("invalid validator attestation")
+)
+
+// ENREntry is the ENR entry which advertises the bor chain and the role of the
+// node on the discovery. Validator nodes, or the sentries relaying for them, may
+// attest the validator signer address with a signature of the node ID by the
+// validator key.
+type ENREntry struct {
+	ChainID     uint64
+	Role        Role
+	Attestation []byte `rlp:"optional"`
+
+	// Ignore additional fields (for forward compatibility).
+	Rest []rlp.RawValue `rlp:"tail"`
+}
+
+// ENRKey implements enr.Entry.
+func (e ENREntry) ENRKey() string {
+	return ProtocolName
+}
+
+// NewENREntry creates the ENR entry of a node of the chain.
+func NewENREntry(chainID uint64, role Role) *ENREntry {
+	return &ENREntry{ChainID: chainID, Role: role}
+}
+
+// AttestationData returns the data signed by the validator key to attest the
+// validator signer address of the node.
+func AttestationData(id enode.ID, chainID uint64) []byte {
+	data := make([]byte, 0, len(ProtocolName)+len(id)+8)
+	data = append(data, ProtocolName...)
+	data = append(data, id[:]...)
+
+	return binary.BigEndian.AppendUint64(data, chainID)
+}
+
+// Validator returns the validator signer address attested for the node.
+func (e *ENREntry) Validator(id enode.ID) (common.Address, error) {
+	if len(e.Attestation) == 0 {
+		return common.Address{}, errNoAttestation
+	}
+
+	pubkey, err := crypto.SigToPub(crypto.Keccak256(AttestationData(id, e.ChainID)), e.Attestation)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("%w: %v", errInvalidAttestation, err)
+	}
+
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// LoadENREntry returns the bor ENR entry of the node, nil if it has none.
+func LoadENREntry(n *enode.Node) *ENREntry {
+	var entry ENREntry
+	if n.Load(&entry) != nil {
+		return nil
+	}
+
+	return &entry
+}
+
+// NewNodeFilter returns a filter accepting the nodes not advertising another bor
+// chain. If roles are given, only the nodes advertising one of them are accepted.
+func NewNodeFilter(chainID uint64, roles []Role) func(*enode.Node) bool {
+	return func(n *enode.Node) bool {
+		entry := LoadENREntry(n)
+		if entry == nil {
+			return len(roles) == 0
+		}
+
+		if entry.ChainID != chainID {
+			return false
+		}
+
+		return len(roles) == 0 || slices.Contains(roles, entry.Role)
+	}
+}
@@ -0,0 +1,104 @@
+package bor
+
+import (
+	"net"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTestNode creates a signed node record holding the ENR entries.
+func newTestNode(t *testing.T, entries ...enr.Entry) *enode.Node {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	var r enr.Record
+
+	r.Set(enr.IP(net.IP{127, 0, 0, 1}))
+
+	for _, entry := range entries {
+		r.Set(entry)
+	}
+
+	require.NoError(t, enode.SignV4(&r, key))
+
+	node, err := enode.New(enode.ValidSchemes, &r)
+	require.NoError(t, err)
+
+	return node
+}
+
+func TestENREntryAttestation(t *testing.T) {
+	t.Parallel()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	validator := crypto.PubkeyToAddress(key.PublicKey)
+
+	// The attestation binds the validator to the node ID and the chain
+	id := enode.ID{1}
+	entry := NewENREntry(137, RoleValidator)
+	entry.Attestation, err = crypto.Sign(crypto.Keccak256(AttestationData(id, entry.ChainID)), key)
+	require.NoError(t, err)
+
+	node := newTestNode(t, entry)
+	loaded := LoadENREntry(node)
+	require.NotNil(t, loaded)
+	require.Equal(t, uint64(137), loaded.ChainID)
+	require.Equal(t, RoleValidator, loaded.Role)
+
+	attested, err := loaded.Validator(id)
+	require.NoError(t, err)
+	require.Equal(t, validator, attested)
+
+	// The attestation doesn't hold for another node
+	attested, _ = loaded.Validator(node.ID())
+	require.NotEqual(t, validator, attested)
+
+	// Entries without attestation attest no validator
+	_, err = NewENREntry(137, RoleSentry).Validator(id)
+	require.ErrorIs(t, err, errNoAttestation)
+}
+
+func TestNodeFilter(t *testing.T) {
+	t.Parallel()
+
+	var (
+		plain   = newTestNode(t)
+		archive = newTestNode(t, NewENREntry(137, RoleArchive))
+		full    = newTestNode(t, NewENREntry(137, RoleFull))
+		other   = newTestNode(t, NewENREntry(80002, RoleArchive))
+	)
+
+	filter := NewNodeFilter(137, nil)
+	require.True(t, filter(plain))
+	require.True(t, filter(archive))
+	require.True(t, filter(full))
+	require.False(t, filter(other))
+
+	filter = NewNodeFilter(137, []Role{RoleArchive})
+	require.False(t, filter(plain))
+	require.True(t, filter(archive))
+	require.False(t, filter(full))
+	require.False(t, filter(other))
+}
+
+func TestParseRole(t *testing.T) {
+	t.Parallel()
+
+	for _, role := range []Role{RoleFull, RoleArchive, RoleValidator, RoleSentry, RoleRPC} {
+		parsed, err := ParseRole(role.String())
+		require.NoError(t, err)
+		require.Equal(t, role, parsed)
+	}
+
+	_, err := ParseRole("light")
+	require.Error(t, err)
+}
@@ -0,0 +1,187 @@
+package bor
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// softResponseLimit is the target maximum size of replies to data retrievals.
+	softResponseLimit = 2 * 1024 * 1024
+
+	// maxBorReceiptsServe is the maximum number of bor receipts to serve. This
+	// number is there to limit the number of disk lookups.
+	maxBorReceiptsServe = 1024
+)
+
+// emptyBorReceipts is the encoding of a block without bor receipt.
+var emptyBorReceipts, _ = rlp.EncodeToBytes([]rlp.RawValue{})
+
+// Handler is a callback to invoke from an outside runner after the boilerplate
+// exchanges have passed.
+type Handler func(peer *Peer) error
+
+// Backend defines the data retrieval methods to serve remote requests. The
+// responses to our own requests are consumed by the protocol handler.
+type Backend interface {
+	// Chain retrieves the blockchain object to serve data.
+	Chain() *core.BlockChain
+
+	// RunPeer is invoked when a peer joins on the `bor` protocol. The handler
+	// should do any peer maintenance work, handshakes and validations. If all
+	// is passed, control should be given back to the `handler` to process the
+	// inbound messages going forward.
+	RunPeer(peer *Peer, handler Handler) error
+
+	// PeerInfo retrieves all known `bor` information about a peer.
+	PeerInfo(id enode.ID) interface{}
+}
+
+// MakeProtocols constructs the P2P protocol definitions for `bor`, advertising
+// the ENR entry if it isn't nil.
+func MakeProtocols(backend Backend, entry *ENREntry) []p2p.Protocol {
+	var attributes []enr.Entry
+	if entry != nil {
+		attributes = []enr.Entry{entry}
+	}
+
+	protocols := make([]p2p.Protocol, len(ProtocolVersions))
+
+	for i, version := range ProtocolVersions {
+		version := version // Closure
+
+		protocols[i] = p2p.Protocol{
+			Name:    ProtocolName,
+			Version: version,
+			Length:  protocolLengths[version],
+			Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+				return backend.RunPeer(NewPeer(version, p, rw), func(peer *Peer) error {
+					return Handle(backend, peer)
+				})
+			},
+			NodeInfo: func() interface{} {
+				return nodeInfo(backend.Chain())
+			},
+			PeerInfo: func(id enode.ID) interface{} {
+				return backend.PeerInfo(id)
+			},
+			Attributes: attributes,
+		}
+	}
+
+	return protocols
+}
+
+// Handle is the callback invoked to manage the life cycle of a `bor` peer.
+// When this function terminates, the peer is disconnected.
+func Handle(backend Backend, peer *Peer) error {
+	for {
+		if err := HandleMessage(backend, peer); err != nil {
+			peer.Log().Debug("Message handling failed in `bor`", "err", err)
+			return err
+		}
+	}
+}
+
+// HandleMessage is invoked whenever an inbound message is received from a
+// remote peer on the `bor` protocol. The remote connection is torn down upon
+// returning any error.
+func HandleMessage(backend Backend, peer *Peer) error {
+	// Read the next message from the remote peer, and ensure it's fully consumed
+	msg, err := peer.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+
+	if msg.Size > maxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	}
+
+	defer msg.Discard()
+
+	// Handle the message depending on its contents
+	switch msg.Code {
+	case GetBorReceiptsMsg:
+		// Decode the bor receipt retrieval request
+		var req GetBorReceiptsPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		// Service the request, potentially returning nothing in case of errors
+		receipts := ServiceGetBorReceiptsQuery(backend.Chain(), req.Hashes)
+
+		return p2p.Send(peer.rw, BorReceiptsMsg, &BorReceiptsRLPPacket{
+			ID:       req.ID,
+			Receipts: receipts,
+		})
+
+	case BorReceiptsMsg:
+		// A batch of bor receipts arrived to one of our previous requests
+		res := new(BorReceiptsPacket)
+		if err := msg.Decode(res); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+
+		peer.deliver(res)
+
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+	}
+}
+
+// ServiceGetBorReceiptsQuery assembles the response to a bor receipt query.
+// It is exposed to allow external packages to test protocol behavior. The
+// response stops at the first block which is unknown locally, so that the
+// requester can tell a block without bor receipt from a missing one.
+func ServiceGetBorReceiptsQuery(chain *core.BlockChain, hashes []common.Hash) []rlp.RawValue {
+	var (
+		bytes    int
+		receipts []rlp.RawValue
+		db       = chain.DB()
+	)
+
+	for lookups, hash := range hashes {
+		if bytes >= softResponseLimit || lookups >= maxBorReceiptsServe {
+			break
+		}
+
+		number := rawdb.ReadHeaderNumber(db, hash)
+		if number == nil || !chain.HasBlock(hash, *number) && !chain.HasFastBlock(hash, *number) {
+			break
+		}
+
+		// Bor receipts are stored on their own, wrap them into a list
+		results := emptyBorReceipts
+		if receipt := rawdb.ReadBorReceiptRLP(db, hash, *number); len(receipt) > 0 {
+			encoded, err := rlp.EncodeToBytes([]rlp.RawValue{receipt})
+			if err != nil {
+				break
+			}
+
+			results = encoded
+		}
+
+		receipts = append(receipts, results)
+		bytes += len(results)
+	}
+
+	return receipts
+}
+
+// NodeInfo represents a short summary of the `bor` sub-protocol metadata
+// known about the host peer.
+type NodeInfo struct{}
+
+// nodeInfo retrieves some `bor` protocol metadata about the running host node.
+func nodeInfo(chain *core.BlockChain) *NodeInfo {
+	return &NodeInfo{}
+}
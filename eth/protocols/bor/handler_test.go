@@ -0,0 +1,123 @@
+package bor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	testBorConfig = &params.BorConfig{
+		Sprint:                map[string]uint64{"0": 4},
+		StateReceiverContract: "0x0000000000000000000000000000000000001001",
+	}
+
+	stateReceiver = common.HexToAddress(testBorConfig.StateReceiverContract)
+)
+
+// testBackend is a bor protocol backend serving a local chain.
+type testBackend struct {
+	chain *core.BlockChain
+}
+
+func (b *testBackend) Chain() *core.BlockChain                   { return b.chain }
+func (b *testBackend) RunPeer(peer *Peer, handler Handler) error { return handler(peer) }
+func (b *testBackend) PeerInfo(id enode.ID) interface{}          { return nil }
+
+// stateSyncReceipt creates a bor receipt committing the state syncs with the ids.
+func stateSyncReceipt(ids ...uint64) *types.Receipt {
+	receipt := &types.Receipt{Status: types.ReceiptStatusSuccessful}
+
+	for _, id := range ids {
+		receipt.Logs = append(receipt.Logs,
+			&types.Log{Address: common.HexToAddress("0x1234"), Topics: []common.Hash{{1}}},
+			&types.Log{Address: stateReceiver, Topics: []common.Hash{stateCommittedTopic, common.BigToHash(new(big.Int).SetUint64(id))}},
+		)
+	}
+
+	return receipt
+}
+
+func TestGetBorReceipts(t *testing.T) {
+	t.Parallel()
+
+	gspec := &core.Genesis{Config: params.TestChainConfig}
+	_, blocks, _ := core.GenerateChainWithGenesis(gspec, ethash.NewFaker(), 8, nil)
+
+	db := rawdb.NewMemoryDatabase()
+
+	chain, err := core.NewBlockChain(db, nil, gspec, nil, ethash.NewFaker(), vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+
+	defer chain.Stop()
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	rawdb.WriteBorReceipt(db, blocks[3].Hash(), 4, (*types.ReceiptForStorage)(stateSyncReceipt(1, 2)))
+
+	var (
+		backend  = &testBackend{chain: chain}
+		app, net = p2p.MsgPipe()
+		client   = NewFakePeer(BOR1, "client0000000000", app)
+		server   = NewFakePeer(BOR1, "server0000000000", net)
+	)
+
+	defer app.Close()
+
+	go Handle(backend, client)
+	go Handle(backend, server)
+
+	// The response stops at the first unknown block
+	res, err := client.RequestBorReceipts(context.Background(), []common.Hash{blocks[3].Hash(), blocks[4].Hash(), {0xff}, blocks[5].Hash()})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	require.Len(t, res[0], 1)
+	require.Empty(t, res[1])
+	require.Equal(t, []uint64{1, 2}, StateSyncIDs(testBorConfig, (*types.Receipt)(res[0][0])))
+}
+
+func TestVerifyBorReceipts(t *testing.T) {
+	t.Parallel()
+
+	headers := []*types.Header{{Number: big.NewInt(4)}, {Number: big.NewInt(6)}, {Number: big.NewInt(8)}}
+
+	failed := stateSyncReceipt(3)
+	failed.Status = types.ReceiptStatusFailed
+
+	stateSyncs := [][]uint64{{1, 2}, nil, {3, 4, 5}}
+
+	tests := []struct {
+		receipts []*types.Receipt
+		err      error
+	}{
+		{[]*types.Receipt{stateSyncReceipt(1, 2), nil, stateSyncReceipt(3, 4, 5)}, nil},
+		{[]*types.Receipt{nil, nil, nil}, nil},
+		{[]*types.Receipt{stateSyncReceipt(2), nil, stateSyncReceipt(3, 5)}, nil},
+		{[]*types.Receipt{nil, stateSyncReceipt(1), nil}, errNotSprintStart},
+		{[]*types.Receipt{stateSyncReceipt(1), nil, failed}, errFailedBorReceipt},
+		{[]*types.Receipt{stateSyncReceipt(), nil, nil}, errNoStateSync},
+		{[]*types.Receipt{stateSyncReceipt(1, 3), nil, nil}, errUnknownStateSync},
+		{[]*types.Receipt{stateSyncReceipt(2, 1), nil, nil}, errUnknownStateSync},
+		{[]*types.Receipt{stateSyncReceipt(1, 2), nil, stateSyncReceipt(6)}, errUnknownStateSync},
+	}
+
+	for i, test := range tests {
+		require.ErrorIs(t, VerifyBorReceipts(testBorConfig, headers, test.receipts, stateSyncs), test.err, "test %d", i)
+	}
+
+	// Bor receipts of blocks which don't commit any state sync are rejected
+	require.ErrorIs(t, VerifyBorReceipts(testBorConfig, headers, tests[0].receipts, [][]uint64{{1, 2}, nil, nil}), errUnexpectedBorReceipt)
+}
@@ -0,0 +1,136 @@
+package bor
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+// requestTimeout is the maximum time to wait for the response to a request.
+const requestTimeout = 10 * time.Second
+
+var errRequestTimeout = errors.New("request timed out")
+
+// Peer is a collection of relevant information we have about a `bor` peer.
+type Peer struct {
+	id string // Unique ID for the peer, cached
+
+	*p2p.Peer                   // The embedded P2P package peer
+	rw        p2p.MsgReadWriter // Input/output streams for bor
+	version   uint              // Protocol version negotiated
+
+	pending map[uint64]chan *BorReceiptsPacket // Requests waiting for their response
+	lock    sync.Mutex                         // Lock protecting the pending requests
+
+	logger log.Logger // Contextual logger with the peer id injected
+}
+
+// NewPeer creates a wrapper for a network connection and negotiated  protocol
+// version.
+func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+	id := p.ID().String()
+
+	return &Peer{
+		id:      id,
+		Peer:    p,
+		rw:      rw,
+		version: version,
+		pending: make(map[uint64]chan *BorReceiptsPacket),
+		logger:  log.New("peer", id[:8]),
+	}
+}
+
+// NewFakePeer creates a fake bor peer without a backing p2p peer, for testing purposes.
+func NewFakePeer(version uint, id string, rw p2p.MsgReadWriter) *Peer {
+	return &Peer{
+		id:      id,
+		rw:      rw,
+		version: version,
+		pending: make(map[uint64]chan *BorReceiptsPacket),
+		logger:  log.New("peer", id[:8]),
+	}
+}
+
+// ID retrieves the peer's unique identifier.
+func (p *Peer) ID() string {
+	return p.id
+}
+
+// Version retrieves the peer's negotiated `bor` protocol version.
+func (p *Peer) Version() uint {
+	return p.version
+}
+
+// Log overrides the P2P logger with the higher level one containing only the id.
+func (p *Peer) Log() log.Logger {
+	return p.logger
+}
+
+// RequestBorReceipts fetches the bor receipts of a batch of blocks and waits for
+// the response. The peer may answer with the receipts of the first blocks only,
+// each entry holding the bor receipt of the block, if any.
+func (p *Peer) RequestBorReceipts(ctx context.Context, hashes []common.Hash) ([][]*types.ReceiptForStorage, error) {
+	id := rand.Uint64()
+
+	p.logger.Trace("Fetching batch of bor receipts", "reqid", id, "count", len(hashes))
+
+	resCh := make(chan *BorReceiptsPacket, 1)
+
+	p.lock.Lock()
+	p.pending[id] = resCh
+	p.lock.Unlock()
+
+	defer func() {
+		p.lock.Lock()
+		delete(p.pending, id)
+		p.lock.Unlock()
+	}()
+
+	if err := p2p.Send(p.rw, GetBorReceiptsMsg, &GetBorReceiptsPacket{
+		ID:     id,
+		Hashes: hashes,
+	}); err != nil {
+		return nil, err
+	}
+
+	timeout := time.NewTimer(requestTimeout)
+	defer timeout.Stop()
+
+	select {
+	case res := <-resCh:
+		if len(res.Receipts) > len(hashes) {
+			return nil, errTooManyReceipts
+		}
+
+		return res.Receipts, nil
+
+	case <-timeout.C:
+		return nil, errRequestTimeout
+
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// deliver hands a bor receipt response over to the request waiting for it.
+// Responses arriving after their request timed out are dropped.
+func (p *Peer) deliver(res *BorReceiptsPacket) {
+	p.lock.Lock()
+	resCh, ok := p.pending[res.ID]
+	delete(p.pending, res.ID)
+	p.lock.Unlock()
+
+	if !ok {
+		p.logger.Debug("Dropping unexpected bor receipts", "reqid", res.ID)
+		return
+	}
+
+	resCh <- res
+}
@@ -0,0 +1,74 @@
+package bor
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Constants to match up protocol versions and messages
+const (
+	BOR1 = 1
+)
+
+// ProtocolName is the official short name of the `bor` protocol used during
+// devp2p capability negotiation.
+const ProtocolName = "bor"
+
+// ProtocolVersions are the supported versions of the `bor` protocol (first
+// is primary).
+var ProtocolVersions = []uint{BOR1}
+
+// protocolLengths are the number of implemented message corresponding to
+// different protocol versions.
+var protocolLengths = map[uint]uint64{BOR1: 2}
+
+// maxMessageSize is the maximum cap on the size of a protocol message.
+const maxMessageSize = 10 * 1024 * 1024
+
+const (
+	GetBorReceiptsMsg = 0x00
+	BorReceiptsMsg    = 0x01
+)
+
+var (
+	errMsgTooLarge     = errors.New("message too long")
+	errDecode          = errors.New("invalid message")
+	errInvalidMsgCode  = errors.New("invalid message code")
+	errTooManyReceipts = errors.New("more bor receipts than requested")
+)
+
+// Packet represents a p2p message in the `bor` protocol.
+type Packet interface {
+	Name() string // Name returns a string corresponding to the message type.
+	Kind() byte   // Kind returns the message type.
+}
+
+// GetBorReceiptsPacket represents a bor receipt query.
+type GetBorReceiptsPacket struct {
+	ID     uint64        // Request ID to match up responses with
+	Hashes []common.Hash // Block hashes of the bor receipts to retrieve
+}
+
+// BorReceiptsPacket is the response to GetBorReceiptsPacket. Each entry holds
+// the bor receipt of the requested block at the same position, or is empty if
+// the block has none.
+type BorReceiptsPacket struct {
+	ID       uint64                       // ID of the request this is a response for
+	Receipts [][]*types.ReceiptForStorage // Bor receipts of the requested blocks
+}
+
+// BorReceiptsRLPPacket is used for bor receipts, when we already have them
+// encoded in the database.
+type BorReceiptsRLPPacket struct {
+	ID       uint64         // ID of the request this is a response for
+	Receipts []rlp.RawValue // Encoded bor receipt lists of the requested blocks
+}
+
+func (*GetBorReceiptsPacket) Name() string { return "GetBorReceipts" }
+func (*GetBorReceiptsPacket) Kind() byte   { return GetBorReceiptsMsg }
+
+func (*BorReceiptsPacket) Name() string { return "BorReceipts" }
+func (*BorReceiptsPacket) Kind() byte   { return BorReceiptsMsg }
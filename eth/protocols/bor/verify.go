@@ -0,0 +1,101 @@
+package bor
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// stateCommittedTopic is the topic of the StateCommitted(uint256,bool) event
+// emitted by the state receiver contract for every committed state sync.
+var stateCommittedTopic = crypto.Keccak256Hash([]byte("StateCommitted(uint256,bool)"))
+
+var (
+	errNotSprintStart       = errors.New("bor receipt for a block which is not a sprint start")
+	errFailedBorReceipt     = errors.New("bor receipt with failed status")
+	errNoStateSync          = errors.New("bor receipt without committed state sync")
+	errUnexpectedBorReceipt = errors.New("bor receipt for a block committing no state sync")
+	errUnknownStateSync     = errors.New("state sync not committed by the block")
+)
+
+// StateSyncIDs returns the ids of the state syncs committed by a bor receipt,
+// in the order of their StateCommitted events.
+func StateSyncIDs(config *params.BorConfig, receipt *types.Receipt) []uint64 {
+	var (
+		contract = common.HexToAddress(config.StateReceiverContract)
+		ids      []uint64
+	)
+
+	for _, log := range receipt.Logs {
+		if log.Address != contract || len(log.Topics) < 2 || log.Topics[0] != stateCommittedTopic {
+			continue
+		}
+
+		id := new(big.Int).SetBytes(log.Topics[1][:])
+		if !id.IsUint64() {
+			continue
+		}
+
+		ids = append(ids, id.Uint64())
+	}
+
+	return ids
+}
+
+// VerifyBorReceipts checks the bor receipts retrieved from a remote peer for
+// a batch of blocks in ascending order, nil for the blocks without any. Bor receipts
+// are not part of the receipts root of the blocks, so they are checked against
+// the ids of the state syncs committed by the blocks according to heimdall instead:
+// only sprint start blocks committing state syncs may have one, which must be
+// successful and emit a StateCommitted event through the state receiver contract
+// for some of them, in order. The events of the state syncs sent to accounts
+// without code are not emitted, and the logs of the receiving contracts can't be
+// checked without executing the blocks.
+func VerifyBorReceipts(config *params.BorConfig, headers []*types.Header, receipts []*types.Receipt, stateSyncs [][]uint64) error {
+	for i, receipt := range receipts {
+		if receipt == nil {
+			continue
+		}
+
+		number := headers[i].Number.Uint64()
+
+		if !config.IsSprintStart(number) {
+			return fmt.Errorf("%w: block %d", errNotSprintStart, number)
+		}
+
+		if len(stateSyncs[i]) == 0 {
+			return fmt.Errorf("%w: block %d", errUnexpectedBorReceipt, number)
+		}
+
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			return fmt.Errorf("%w: block %d", errFailedBorReceipt, number)
+		}
+
+		ids := StateSyncIDs(config, receipt)
+		if len(ids) == 0 {
+			return fmt.Errorf("%w: block %d", errNoStateSync, number)
+		}
+
+		// The committed ids must be a subsequence of the expected ones
+		expected := stateSyncs[i]
+
+		for _, id := range ids {
+			for len(expected) > 0 && expected[0] != id {
+				expected = expected[1:]
+			}
+
+			if len(expected) == 0 {
+				return fmt.Errorf("%w: block %d, state sync %d", errUnknownStateSync, number, id)
+			}
+
+			expected = expected[1:]
+		}
+	}
+
+	return nil
+}
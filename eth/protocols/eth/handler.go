@@ -89,8 +89,9 @@ type TxPool interface {
 	Get(hash common.Hash) *types.Transaction
 }
 
-// MakeProtocols constructs the P2P protocol definitions for `eth`.
-func MakeProtocols(backend Backend, network uint64, dnsdisc enode.Iterator) []p2p.Protocol {
+// MakeProtocols constructs the P2P protocol definitions for `eth`. The headers,
+// bodies and receipts served are rate limited by the limiter, if not nil.
+func MakeProtocols(backend Backend, network uint64, dnsdisc enode.Iterator, limiter *ServeLimiter) []p2p.Protocol {
 	protocols := make([]p2p.Protocol, 0, len(ProtocolVersions))
 	for _, version := range ProtocolVersions {
 		// Blob transactions require eth/68 announcements, disable everything else
@@ -107,6 +108,10 @@ func MakeProtocols(backend Backend, network uint64, dnsdisc enode.Iterator) []p2
 				peer := NewPeer(version, p, rw, backend.TxPool())
 				defer peer.Close()
 
+				if limiter != nil {
+					peer.serve = limiter.newPeer(peer.IsTrusted() || peer.IsStatic(), peer.term)
+				}
+
 				return backend.RunPeer(peer, func(peer *Peer) error {
 					return Handle(backend, peer)
 				})
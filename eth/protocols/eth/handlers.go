@@ -34,8 +34,9 @@ func handleGetBlockHeaders(backend Backend, msg Decoder, peer *Peer) error {
 	if err := msg.Decode(&query); err != nil {
 		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
 	}
-	response := ServiceGetBlockHeadersQuery(backend.Chain(), query.GetBlockHeadersRequest, peer)
-	return peer.ReplyBlockHeadersRLP(query.RequestId, response)
+	return peer.serve.serve(query.RequestId, func() []rlp.RawValue {
+		return ServiceGetBlockHeadersQuery(backend.Chain(), query.GetBlockHeadersRequest, peer)
+	}, peer.ReplyBlockHeadersRLP)
 }
 
 // ServiceGetBlockHeadersQuery assembles the response to a header query. It is
@@ -227,8 +228,9 @@ func handleGetBlockBodies(backend Backend, msg Decoder, peer *Peer) error {
 	if err := msg.Decode(&query); err != nil {
 		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
 	}
-	response := ServiceGetBlockBodiesQuery(backend.Chain(), query.GetBlockBodiesRequest)
-	return peer.ReplyBlockBodiesRLP(query.RequestId, response)
+	return peer.serve.serve(query.RequestId, func() []rlp.RawValue {
+		return ServiceGetBlockBodiesQuery(backend.Chain(), query.GetBlockBodiesRequest)
+	}, peer.ReplyBlockBodiesRLP)
 }
 
 // ServiceGetBlockBodiesQuery assembles the response to a body query. It is
@@ -261,8 +263,9 @@ func handleGetReceipts(backend Backend, msg Decoder, peer *Peer) error {
 	if err := msg.Decode(&query); err != nil {
 		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
 	}
-	response := ServiceGetReceiptsQuery(backend.Chain(), query.GetReceiptsRequest)
-	return peer.ReplyReceiptsRLP(query.RequestId, response)
+	return peer.serve.serve(query.RequestId, func() []rlp.RawValue {
+		return ServiceGetReceiptsQuery(backend.Chain(), query.GetReceiptsRequest)
+	}, peer.ReplyReceiptsRLP)
 }
 
 // ServiceGetReceiptsQuery assembles the response to a receipt query. It is
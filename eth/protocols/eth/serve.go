@@ -0,0 +1,394 @@
+package eth
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// maxServeDelay is the maximum time a request waits for the serving limits.
+	// The requests over the limits for longer are answered with a partial response.
+	maxServeDelay = 500 * time.Millisecond
+
+	// maxQueuedServes is the maximum number of requests of a peer waiting to be
+	// served. The requests over it are answered with an empty response.
+	maxQueuedServes = 32
+)
+
+var (
+	serveThrottledMeter        = metrics.NewRegisteredMeter("eth/protocols/eth/serve/throttled", nil)
+	serveTrustedThrottledMeter = metrics.NewRegisteredMeter("eth/protocols/eth/serve/throttled/trusted", nil)
+	servePartialMeter          = metrics.NewRegisteredMeter("eth/protocols/eth/serve/partial", nil)
+	serveDroppedMeter          = metrics.NewRegisteredMeter("eth/protocols/eth/serve/dropped", nil)
+)
+
+// ServeLimits are rate limits on the headers, bodies and receipts served. A
+// zero value disables the limit.
+type ServeLimits struct {
+	Requests uint64 // Requests served per second
+	Bytes    uint64 // Response bytes served per second
+}
+
+// ServeConfig is the configuration of the serving limits.
+type ServeConfig struct {
+	Peer    ServeLimits // Limits of each regular peer
+	Trusted ServeLimits // Limits of each trusted or static peer
+	Global  ServeLimits // Budget shared by all the regular peers
+}
+
+// ServeLimiter enforces the serving limits of the peers and the global serving
+// budget of the regular peers. The requests of a peer are served in order by a
+// goroutine of the peer, so that the throttled requests don't hold up the other
+// messages of the peer. The requests over the limits wait for at most
+// maxServeDelay, and are answered with a partial response if they don't fit by
+// then. The regular peers waiting for the global budget are queued and admitted
+// round-robin, while the trusted and static peers are exempt from it.
+type ServeLimiter struct {
+	config   ServeConfig
+	requests *rate.Limiter   // Global request budget, nil if unlimited
+	bytes    *rate.Limiter   // Global bandwidth budget, nil if unlimited
+	quit     <-chan struct{} // Quit channel of the protocol handler
+
+	lock  sync.Mutex
+	queue []chan struct{} // Turns of the peers waiting for the global budget
+}
+
+// NewServeLimiter creates the limiter of the served requests, nil if no limit is
+// set. The waiting requests are abandoned when the quit channel is closed.
+func NewServeLimiter(config ServeConfig, quit <-chan struct{}) *ServeLimiter {
+	if config == (ServeConfig{}) {
+		return nil
+	}
+
+	return &ServeLimiter{
+		config:   config,
+		requests: newServeRateLimiter(config.Global.Requests, 1),
+		bytes:    newServeRateLimiter(config.Global.Bytes, softResponseLimit),
+		quit:     quit,
+	}
+}
+
+// newServeRateLimiter creates a rate limiter with a burst of a second of its
+// limit, at least minBurst. It returns nil if the limit is disabled.
+func newServeRateLimiter(limit uint64, minBurst int) *rate.Limiter {
+	if limit == 0 {
+		return nil
+	}
+
+	return rate.NewLimiter(rate.Limit(limit), max(int(limit), minBurst))
+}
+
+// serveDebt returns the time it takes to pay off the bytes charged to a bandwidth
+// limiter.
+func serveDebt(limiter *rate.Limiter, now time.Time) time.Duration {
+	if limiter == nil {
+		return 0
+	}
+
+	tokens := limiter.TokensAt(now)
+	if tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-tokens / float64(limiter.Limit()) * float64(time.Second))
+}
+
+// chargeServe charges the size of a response to a bandwidth limiter. The debt is
+// capped at maxServeDelay, so that the limiter recovers from a burst of large
+// responses served partially.
+func chargeServe(limiter *rate.Limiter, size int, now time.Time) {
+	if limiter == nil {
+		return
+	}
+
+	allowance := limiter.TokensAt(now) + float64(limiter.Limit())*maxServeDelay.Seconds()
+	if n := min(size, limiter.Burst(), int(allowance)); n > 0 {
+		limiter.ReserveN(now, n)
+	}
+}
+
+// newPeer creates the limiter of a peer and starts serving its requests until
+// term is closed. It returns nil if the limiter is disabled.
+func (l *ServeLimiter) newPeer(trusted bool, term <-chan struct{}) *peerServeLimiter {
+	if l == nil {
+		return nil
+	}
+
+	limits := l.config.Peer
+	if trusted {
+		limits = l.config.Trusted
+	}
+
+	peer := &peerServeLimiter{
+		global:   l,
+		trusted:  trusted,
+		requests: newServeRateLimiter(limits.Requests, 1),
+		bytes:    newServeRateLimiter(limits.Bytes, softResponseLimit),
+		queue:    make(chan *serveRequest, maxQueuedServes),
+		term:     term,
+	}
+	go peer.loop()
+
+	return peer
+}
+
+// sleep waits for the delay, and reports false if the peer or the limiter quit
+// in the meantime.
+func (l *ServeLimiter) sleep(delay time.Duration, term <-chan struct{}) bool {
+	if delay <= 0 {
+		return true
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-term:
+		return false
+	case <-l.quit:
+		return false
+	}
+}
+
+// wait queues the request behind the ones of the other peers waiting for the
+// global budget, and waits for its turn and for the budget, for at most
+// maxServeDelay each. As a peer waits with a single request at a time, the
+// waiting peers are admitted round-robin. It reports whether the request was
+// delayed, whether it's over the budget, and false if the peer or the limiter
+// quit in the meantime.
+func (l *ServeLimiter) wait(term <-chan struct{}) (delayed bool, over bool, ok bool) {
+	if l.requests == nil && l.bytes == nil {
+		return false, false, true
+	}
+
+	turn := make(chan struct{})
+
+	l.lock.Lock()
+	l.queue = append(l.queue, turn)
+	delayed = len(l.queue) > 1
+
+	if !delayed {
+		close(turn)
+	}
+	l.lock.Unlock()
+
+	if delayed {
+		timer := time.NewTimer(maxServeDelay)
+		defer timer.Stop()
+
+		select {
+		case <-turn:
+		case <-timer.C:
+			l.leave(turn)
+			return true, true, true
+		case <-term:
+			l.leave(turn)
+			return true, false, false
+		case <-l.quit:
+			l.leave(turn)
+			return true, false, false
+		}
+	}
+	defer l.next()
+
+	// Wait for the budget at the head of the queue, then pass the turn on
+	now := time.Now()
+	delay := serveDebt(l.bytes, now)
+
+	var reservation *rate.Reservation
+	if l.requests != nil {
+		reservation = l.requests.ReserveN(now, 1)
+		if d := reservation.DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+
+	if delay > maxServeDelay {
+		if reservation != nil {
+			reservation.CancelAt(now)
+		}
+
+		delay, over = maxServeDelay, true
+	}
+
+	if !l.sleep(delay, term) {
+		return true, over, false
+	}
+
+	return delayed || delay > 0, over, true
+}
+
+// leave removes a turn from the queue of the peers waiting for the global budget,
+// passing it on if it's at the head of the queue.
+func (l *ServeLimiter) leave(turn chan struct{}) {
+	l.lock.Lock()
+	for i, queued := range l.queue {
+		if queued == turn && i > 0 {
+			l.queue = append(l.queue[:i], l.queue[i+1:]...)
+			l.lock.Unlock()
+
+			return
+		}
+	}
+	l.lock.Unlock()
+
+	l.next()
+}
+
+// next passes the turn at the head of the queue on to the next peer waiting for
+// the global budget.
+func (l *ServeLimiter) next() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.queue = l.queue[1:]
+	if len(l.queue) > 0 {
+		close(l.queue[0])
+	}
+}
+
+// serveRequest is a request of a peer waiting to be served.
+type serveRequest struct {
+	id      uint64                             // Request ID to answer
+	service func() []rlp.RawValue              // Assembles the response
+	reply   func(uint64, []rlp.RawValue) error // Sends the response to the peer
+}
+
+// peerServeLimiter enforces the serving limits of a peer. The requests of the
+// peer are served one at a time by the goroutine of the limiter.
+type peerServeLimiter struct {
+	global  *ServeLimiter
+	trusted bool
+
+	requests *rate.Limiter // Request limit of the peer, nil if unlimited
+	bytes    *rate.Limiter // Bandwidth limit of the peer, nil if unlimited
+
+	queue chan *serveRequest // Requests of the peer waiting to be served
+	term  <-chan struct{}    // Termination channel of the peer
+}
+
+// serve queues a request of the peer to be answered once it fits in the serving
+// limits, without waiting for it. The requests over the queue of the peer are
+// answered with an empty response right away.
+func (l *peerServeLimiter) serve(id uint64, service func() []rlp.RawValue, reply func(uint64, []rlp.RawValue) error) error {
+	if l == nil {
+		return reply(id, service())
+	}
+
+	select {
+	case l.queue <- &serveRequest{id: id, service: service, reply: reply}:
+		return nil
+	default:
+		serveDroppedMeter.Mark(1)
+		return reply(id, nil)
+	}
+}
+
+// loop serves the queued requests of the peer until the peer or the limiter
+// quit. The responses of the requests over the limits are cut to their first
+// item.
+func (l *peerServeLimiter) loop() {
+	for {
+		select {
+		case req := <-l.queue:
+			over, ok := l.admit()
+			if !ok {
+				return
+			}
+
+			response := req.service()
+			if over && len(response) > 1 {
+				servePartialMeter.Mark(1)
+
+				response = response[:1]
+			}
+
+			l.served(response)
+
+			if err := req.reply(req.id, response); err != nil {
+				return
+			}
+		case <-l.term:
+			return
+		case <-l.global.quit:
+			return
+		}
+	}
+}
+
+// admit waits for the request to fit in the serving limits of the peer, then for
+// its turn in the global budget unless the peer is trusted. It reports whether
+// the request is still over the limits after maxServeDelay, and false if the peer
+// or the limiter quit in the meantime.
+func (l *peerServeLimiter) admit() (over bool, ok bool) {
+	now := time.Now()
+	delay := serveDebt(l.bytes, now)
+
+	if l.requests != nil {
+		reservation := l.requests.ReserveN(now, 1)
+		if d := reservation.DelayFrom(now); d > delay {
+			delay = d
+		}
+
+		if delay > maxServeDelay {
+			reservation.CancelAt(now)
+		}
+	}
+
+	if delay > maxServeDelay {
+		delay, over = maxServeDelay, true
+	}
+
+	if !l.global.sleep(delay, l.term) {
+		return over, false
+	}
+
+	delayed := delay > 0
+
+	if !l.trusted {
+		globalDelayed, globalOver, ok := l.global.wait(l.term)
+		if !ok {
+			return over, false
+		}
+
+		delayed, over = delayed || globalDelayed, over || globalOver
+	}
+
+	if delayed {
+		if l.trusted {
+			serveTrustedThrottledMeter.Mark(1)
+		}
+
+		serveThrottledMeter.Mark(1)
+	}
+
+	return over, true
+}
+
+// served charges the size of the response to the bandwidth limits of the peer,
+// and to the global budget unless the peer is trusted.
+func (l *peerServeLimiter) served(response []rlp.RawValue) {
+	var size int
+	for _, value := range response {
+		size += len(value)
+	}
+
+	if size == 0 {
+		return
+	}
+
+	now := time.Now()
+
+	chargeServe(l.bytes, size, now)
+
+	if !l.trusted {
+		chargeServe(l.global.bytes, size, now)
+	}
+}
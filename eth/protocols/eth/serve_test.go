@@ -0,0 +1,356 @@
+package eth
+
+import (
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// newTestServePeer creates the limiter of a peer, stopped when the test ends.
+func newTestServePeer(t *testing.T, limiter *ServeLimiter, trusted bool) *peerServeLimiter {
+	t.Helper()
+
+	term := make(chan struct{})
+	t.Cleanup(func() { close(term) })
+
+	return limiter.newPeer(trusted, term)
+}
+
+// admitTime returns the time the limiter takes to admit a request, and whether
+// the request is over the limits.
+func admitTime(t *testing.T, limiter *peerServeLimiter) (time.Duration, bool) {
+	t.Helper()
+
+	start := time.Now()
+
+	over, ok := limiter.admit()
+	if !ok {
+		t.Fatal("request abandoned")
+	}
+
+	return time.Since(start), over
+}
+
+func TestServeLimiterDisabled(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewServeLimiter(ServeConfig{}, nil)
+	if limiter != nil {
+		t.Fatal("limiter created without limits")
+	}
+
+	peer := limiter.newPeer(false, nil)
+	if peer != nil {
+		t.Fatal("peer limiter created without limits")
+	}
+
+	// Without limits the requests are answered right away
+	var replied bool
+
+	err := peer.serve(1, func() []rlp.RawValue {
+		return []rlp.RawValue{make([]byte, softResponseLimit)}
+	}, func(id uint64, response []rlp.RawValue) error {
+		replied = id == 1 && len(response) == 1
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("failed to serve request: %v", err)
+	}
+
+	if !replied {
+		t.Fatal("request not answered right away")
+	}
+}
+
+func TestServeLimiterBytes(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewServeLimiter(ServeConfig{
+		Peer:    ServeLimits{Bytes: 10 * softResponseLimit},
+		Trusted: ServeLimits{Bytes: 1000 * softResponseLimit},
+	}, nil)
+
+	var (
+		regular  = newTestServePeer(t, limiter, false)
+		trusted  = newTestServePeer(t, limiter, true)
+		response = []rlp.RawValue{make([]byte, softResponseLimit)}
+	)
+
+	// The burst allows ten full responses, the next one is paid off in a tenth
+	// of a second by the regular peer only
+	for i := 0; i < 11; i++ {
+		regular.served(response)
+		trusted.served(response)
+	}
+
+	if elapsed, over := admitTime(t, regular); elapsed < 50*time.Millisecond || over {
+		t.Fatalf("regular peer not delayed: %v, over %v", elapsed, over)
+	}
+
+	if elapsed, _ := admitTime(t, trusted); elapsed > 50*time.Millisecond {
+		t.Fatalf("trusted peer delayed: %v", elapsed)
+	}
+}
+
+func TestServeLimiterMaxDelay(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewServeLimiter(ServeConfig{
+		Peer: ServeLimits{Requests: 1, Bytes: 10 * softResponseLimit},
+	}, nil)
+
+	var (
+		peer     = newTestServePeer(t, limiter, false)
+		response = []rlp.RawValue{make([]byte, softResponseLimit)}
+	)
+
+	// The second request would wait for a second, it's answered partially after
+	// the maximum delay instead
+	if elapsed, over := admitTime(t, peer); elapsed > 50*time.Millisecond || over {
+		t.Fatalf("request within the limits delayed: %v, over %v", elapsed, over)
+	}
+
+	elapsed, over := admitTime(t, peer)
+	if !over {
+		t.Fatal("request over the limits not reported")
+	}
+
+	if elapsed < maxServeDelay-50*time.Millisecond || elapsed > maxServeDelay+200*time.Millisecond {
+		t.Fatalf("request over the limits delayed %v, want %v", elapsed, maxServeDelay)
+	}
+
+	// The bytes served beyond the maximum delay are not charged, so the peer
+	// pays its debt off in at most the maximum delay
+	for i := 0; i < 100; i++ {
+		peer.served(response)
+	}
+
+	if elapsed, _ := admitTime(t, peer); elapsed > maxServeDelay+200*time.Millisecond {
+		t.Fatalf("bandwidth debt not capped: %v", elapsed)
+	}
+}
+
+func TestServeLimiterQuit(t *testing.T) {
+	t.Parallel()
+
+	var (
+		quit    = make(chan struct{})
+		limiter = NewServeLimiter(ServeConfig{Peer: ServeLimits{Requests: 1}}, quit)
+		term    = make(chan struct{})
+		closed  = limiter.newPeer(false, term)
+		stopped = newTestServePeer(t, limiter, false)
+	)
+
+	// The waiting requests are abandoned when the peer disconnects and when the
+	// node shuts down
+	for _, test := range []struct {
+		peer  *peerServeLimiter
+		close chan struct{}
+	}{{closed, term}, {stopped, quit}} {
+		if _, ok := test.peer.admit(); !ok {
+			t.Fatal("request within the limits abandoned")
+		}
+
+		time.AfterFunc(50*time.Millisecond, func() { close(test.close) })
+
+		start := time.Now()
+		if _, ok := test.peer.admit(); ok {
+			t.Fatal("request admitted after the quit")
+		}
+
+		if elapsed := time.Since(start); elapsed > 200*time.Millisecond {
+			t.Fatalf("waiting request abandoned late: %v", elapsed)
+		}
+	}
+}
+
+func TestServeLimiterGlobalBytes(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewServeLimiter(ServeConfig{
+		Global: ServeLimits{Bytes: 10 * softResponseLimit},
+	}, nil)
+
+	var (
+		first    = newTestServePeer(t, limiter, false)
+		second   = newTestServePeer(t, limiter, false)
+		trusted  = newTestServePeer(t, limiter, true)
+		response = []rlp.RawValue{make([]byte, softResponseLimit)}
+	)
+
+	// The bytes served to the trusted peers are not charged to the budget
+	for i := 0; i < 11; i++ {
+		trusted.served(response)
+	}
+
+	if elapsed, _ := admitTime(t, second); elapsed > 50*time.Millisecond {
+		t.Fatalf("request delayed by a trusted peer: %v", elapsed)
+	}
+
+	// The bytes served to a regular peer delay the requests of all the regular
+	// peers, but not the trusted ones
+	for i := 0; i < 11; i++ {
+		first.served(response)
+	}
+
+	if elapsed, _ := admitTime(t, trusted); elapsed > 50*time.Millisecond {
+		t.Fatalf("trusted peer delayed by the global budget: %v", elapsed)
+	}
+
+	if elapsed, _ := admitTime(t, second); elapsed < 50*time.Millisecond {
+		t.Fatalf("request over the global budget not delayed: %v", elapsed)
+	}
+}
+
+func TestServeLimiterGlobalRoundRobin(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewServeLimiter(ServeConfig{
+		Global: ServeLimits{Requests: 20},
+	}, nil)
+
+	var (
+		greedy = newTestServePeer(t, limiter, false)
+		other  = newTestServePeer(t, limiter, false)
+
+		lock  sync.Mutex
+		order []string
+		done  = make(chan struct{})
+	)
+
+	// Exhaust the burst of the budget, the next requests are admitted every 50ms
+	for i := 0; i < 20; i++ {
+		admitTime(t, greedy)
+	}
+
+	admitted := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		for i := 0; i < 6; i++ {
+			greedy.admit()
+
+			lock.Lock()
+			order = append(order, "greedy")
+			lock.Unlock()
+
+			if i == 0 {
+				close(admitted)
+			}
+		}
+	}()
+
+	// The other peer is admitted after at most one more request of the greedy
+	// peer, which queued while the other one was waiting
+	<-admitted
+	admitTime(t, other)
+
+	lock.Lock()
+	order = append(order, "other")
+	position := len(order)
+	lock.Unlock()
+
+	<-done
+
+	if position > 3 {
+		t.Fatalf("peer not admitted round-robin: %v", order)
+	}
+}
+
+// serveTestBackend is a mock backend recording the packets handled.
+type serveTestBackend struct {
+	*testBackend
+	packets chan Packet
+}
+
+func (b *serveTestBackend) Handle(peer *Peer, packet Packet) error {
+	b.packets <- packet
+	return nil
+}
+
+// Tests that the throttled requests of a peer don't hold up its other messages,
+// and are answered partially once over the limits for too long.
+func TestServeLimiterHandle(t *testing.T) {
+	t.Parallel()
+
+	backend := &serveTestBackend{testBackend: newTestBackend(4), packets: make(chan Packet, 1)}
+	defer backend.close()
+
+	app, net := p2p.MsgPipe()
+	defer app.Close()
+
+	peer := NewPeer(ETH68, p2p.NewPeer(enode.ID{1}, "peer", nil), net, backend.TxPool())
+	defer peer.Close()
+
+	peer.serve = NewServeLimiter(ServeConfig{Peer: ServeLimits{Requests: 1}}, nil).newPeer(false, peer.term)
+
+	go Handle(backend, peer)
+
+	request := func(id uint64) {
+		t.Helper()
+
+		err := p2p.Send(app, GetBlockHeadersMsg, &GetBlockHeadersPacket{
+			RequestId:              id,
+			GetBlockHeadersRequest: &GetBlockHeadersRequest{Origin: HashOrNumber{Number: 1}, Amount: 2},
+		})
+		if err != nil {
+			t.Fatalf("failed to send request %d: %v", id, err)
+		}
+	}
+	response := func(id uint64, headers int) {
+		t.Helper()
+
+		msg, err := app.ReadMsg()
+		if err != nil {
+			t.Fatalf("failed to read response %d: %v", id, err)
+		}
+
+		var res BlockHeadersPacket
+		if err := msg.Decode(&res); err != nil {
+			t.Fatalf("failed to decode response %d: %v", id, err)
+		}
+
+		if res.RequestId != id || len(res.BlockHeadersRequest) != headers {
+			t.Fatalf("response mismatch: have request %d with %d headers, want request %d with %d", res.RequestId, len(res.BlockHeadersRequest), id, headers)
+		}
+	}
+
+	// The first request fits in the limits, the second one is throttled
+	request(1)
+	response(1, 2)
+
+	start := time.Now()
+
+	request(2)
+
+	err := p2p.Send(app, NewBlockMsg, &NewBlockPacket{Block: backend.chain.GetBlockByNumber(4), TD: big.NewInt(1)})
+	if err != nil {
+		t.Fatalf("failed to send block: %v", err)
+	}
+
+	select {
+	case packet := <-backend.packets:
+		if _, ok := packet.(*NewBlockPacket); !ok {
+			t.Fatalf("unexpected packet handled: %T", packet)
+		}
+
+		if elapsed := time.Since(start); elapsed > maxServeDelay/2 {
+			t.Fatalf("block held up by a throttled request: %v", elapsed)
+		}
+	case <-time.After(maxServeDelay / 2):
+		t.Fatal("block held up by a throttled request")
+	}
+
+	// The throttled request is answered partially after the maximum delay
+	response(2, 1)
+
+	if elapsed := time.Since(start); elapsed < maxServeDelay-50*time.Millisecond {
+		t.Fatalf("throttled request answered early: %v", elapsed)
+	}
+}
@@ -0,0 +1,339 @@
+// Package reputation keeps the scores of the peers serving chains or blocks
+// violating the local rules, and bans the peers whose score gets too high.
+package reputation
+
+import (
+	"encoding/json"
+	"math"
+	"net/netip"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/netutil"
+)
+
+const (
+	scoreHalfLife  = 30 * time.Minute // Time for the score of a peer to decay by half
+	banThreshold   = 100              // Score from which a peer is banned
+	minBanDuration = 10 * time.Minute // Duration of the first ban of a peer, doubled for each further ban
+	maxBanDuration = 24 * time.Hour   // Maximum duration of a ban
+	maxBanDoubling = 8                // Maximum number of doublings of the ban duration
+	minScore       = 1                // Score under which the record of a peer is dropped, once its bans are forgotten
+	maxRecords     = 4096             // Maximum number of records kept, the lowest scores are dropped first
+	saveInterval   = time.Minute      // Minimum interval between two saves of the records, unless a peer gets banned
+
+	nodeKeyPrefix = "node:"
+	ipKeyPrefix   = "ip:"
+)
+
+// Violation is a misbehaviour of a peer, increasing its score.
+type Violation int
+
+const (
+	// WhitelistMismatch is reported when a peer serves a chain not matching the
+	// whitelisted checkpoints and milestones.
+	WhitelistMismatch Violation = iota
+
+	// InvalidSeal is reported when a peer propagates a block failing the
+	// header verification.
+	InvalidSeal
+
+	// InvalidTxDependency is reported when a peer propagates a block carrying a
+	// transaction dependency metadata not matching its transactions.
+	InvalidTxDependency
+)
+
+// penalties are the scores added to a peer for each violation. A seal error may
+// come from a stale local span rather than from the peer, so it takes repeated
+// invalid seals to ban a peer.
+var penalties = map[Violation]float64{
+	WhitelistMismatch:   50,
+	InvalidSeal:         34,
+	InvalidTxDependency: 25,
+}
+
+func (v Violation) String() string {
+	switch v {
+	case WhitelistMismatch:
+		return "whitelist mismatch"
+	case InvalidSeal:
+		return "invalid seal"
+	case InvalidTxDependency:
+		return "invalid tx dependency"
+	default:
+		return "unknown violation"
+	}
+}
+
+// PeerScore is the reputation of a node or of an IP address. The score decays
+// over time, and the peer is banned until BannedUntil once it reaches the ban
+// threshold.
+type PeerScore struct {
+	ID          string    `json:"id,omitempty"`
+	IP          string    `json:"ip,omitempty"`
+	Score       float64   `json:"score"`
+	Bans        uint64    `json:"bans"`
+	BannedUntil time.Time `json:"bannedUntil"`
+	Updated     time.Time `json:"updated"`
+}
+
+// decay lowers the score by the time elapsed since its last update.
+func (s *PeerScore) decay(now time.Time) {
+	if elapsed := now.Sub(s.Updated); elapsed > 0 {
+		s.Score *= math.Pow(0.5, float64(elapsed)/float64(scoreHalfLife))
+	}
+
+	s.Updated = now
+}
+
+// Store keeps the reputation of the peers by node ID and by IP address,
+// persisting it in the database. It implements p2p.PeerBanList.
+//
+// The records are saved at most once per save interval as the reports come in,
+// and on Close. The new bans are saved right away so they survive a crash.
+type Store struct {
+	db  ethdb.KeyValueStore
+	now func() time.Time
+
+	lock    sync.RWMutex
+	records map[string]*PeerScore
+	dirty   bool      // Whether the records changed since the last save
+	saved   time.Time // Time of the last save, zero to save on the next report
+}
+
+// NewStore creates a reputation store, loading the records persisted in the
+// database.
+func NewStore(db ethdb.KeyValueStore) *Store {
+	s := &Store{
+		db:      db,
+		now:     time.Now,
+		records: make(map[string]*PeerScore),
+	}
+
+	if data := rawdb.ReadPeerReputation(db); len(data) > 0 {
+		var records []*PeerScore
+		if err := json.Unmarshal(data, &records); err != nil {
+			log.Warn("Failed to load peer reputation, starting afresh", "err", err)
+		}
+
+		for _, record := range records {
+			s.records[recordKey(record)] = record
+		}
+	}
+
+	return s
+}
+
+// Report records a violation of the node, and of its IP address unless it is a
+// LAN address. It returns whether the node or its IP address is banned.
+func (s *Store) Report(id enode.ID, ip netip.Addr, v Violation) bool {
+	penalty, ok := penalties[v]
+	if !ok {
+		return false
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	banned := s.penalize(&PeerScore{ID: id.String()}, now, penalty)
+
+	if ip.IsValid() && !netutil.AddrIsLAN(ip) {
+		if s.penalize(&PeerScore{IP: ip.Unmap().String()}, now, penalty) {
+			banned = true
+		}
+	}
+
+	log.Debug("Peer violation reported", "id", id, "ip", ip, "violation", v, "banned", banned)
+
+	s.prune(now)
+	s.dirty = true
+
+	if now.Sub(s.saved) >= saveInterval {
+		s.save(now)
+	}
+
+	return banned
+}
+
+// Close saves the records changed since the last save.
+func (s *Store) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.dirty {
+		s.save(s.now())
+	}
+}
+
+// penalize increases the score of the record, banning it once the score reaches
+// the threshold. It returns whether the record is banned.
+func (s *Store) penalize(record *PeerScore, now time.Time, penalty float64) bool {
+	key := recordKey(record)
+	if old, ok := s.records[key]; ok {
+		record = old
+	} else {
+		s.records[key] = record
+		record.Updated = now
+	}
+
+	record.decay(now)
+	record.Score += penalty
+
+	if now.Before(record.BannedUntil) {
+		return true
+	}
+
+	if record.Score < banThreshold {
+		return false
+	}
+
+	record.Bans++
+	record.Score = 0
+	record.BannedUntil = now.Add(banDuration(record.Bans))
+
+	s.saved = time.Time{}
+
+	log.Info("Banned peer", "id", record.ID, "ip", record.IP, "bans", record.Bans, "until", record.BannedUntil)
+
+	return true
+}
+
+// banDuration returns the duration of the nth ban of a peer.
+func banDuration(bans uint64) time.Duration {
+	duration := minBanDuration << min(bans-1, maxBanDoubling)
+	return min(duration, maxBanDuration)
+}
+
+// IsBannedNode reports whether the node is banned.
+func (s *Store) IsBannedNode(id enode.ID) bool {
+	return s.isBanned(nodeKeyPrefix + id.String())
+}
+
+// IsBannedIP reports whether the IP address is banned.
+func (s *Store) IsBannedIP(ip netip.Addr) bool {
+	return s.isBanned(ipKeyPrefix + ip.Unmap().String())
+}
+
+func (s *Store) isBanned(key string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	record, ok := s.records[key]
+
+	return ok && s.now().Before(record.BannedUntil)
+}
+
+// Score returns the reputation of the node, nil if it has none.
+func (s *Store) Score(id enode.ID) *PeerScore {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	record, ok := s.records[nodeKeyPrefix+id.String()]
+	if !ok {
+		return nil
+	}
+
+	record.decay(s.now())
+	score := *record
+
+	return &score
+}
+
+// Scores returns the reputation of all the nodes and IP addresses, the highest
+// scores first.
+func (s *Store) Scores() []*PeerScore {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	scores := make([]*PeerScore, 0, len(s.records))
+
+	for _, record := range s.records {
+		record.decay(now)
+
+		score := *record
+		scores = append(scores, &score)
+	}
+
+	sort.Slice(scores, func(i, j int) bool {
+		return scores[i].Score > scores[j].Score
+	})
+
+	return scores
+}
+
+// prune drops the records whose score decayed away, once their bans are old
+// enough to be forgotten, and the lowest scores if too many records are kept.
+// The records of the banned peers are always kept. The lock must be held.
+func (s *Store) prune(now time.Time) {
+	var candidates []string
+
+	for key, record := range s.records {
+		if now.Before(record.BannedUntil) {
+			continue
+		}
+
+		if now.Before(record.BannedUntil.Add(maxBanDuration)) {
+			candidates = append(candidates, key)
+			continue
+		}
+
+		record.decay(now)
+
+		if record.Score < minScore {
+			delete(s.records, key)
+			continue
+		}
+
+		candidates = append(candidates, key)
+	}
+
+	if len(s.records) <= maxRecords {
+		return
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		return s.records[candidates[i]].Score < s.records[candidates[j]].Score
+	})
+
+	for _, key := range candidates {
+		if len(s.records) <= maxRecords {
+			break
+		}
+
+		delete(s.records, key)
+	}
+}
+
+// save persists the records in the database. The lock must be held.
+func (s *Store) save(now time.Time) {
+	s.dirty = false
+	s.saved = now
+
+	records := make([]*PeerScore, 0, len(s.records))
+	for _, record := range s.records {
+		records = append(records, record)
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		log.Error("Failed to encode peer reputation", "err", err)
+		return
+	}
+
+	rawdb.WritePeerReputation(s.db, data)
+}
+
+func recordKey(record *PeerScore) string {
+	if record.ID != "" {
+		return nodeKeyPrefix + record.ID
+	}
+
+	return ipKeyPrefix + record.IP
+}
@@ -0,0 +1,168 @@
+package reputation
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+func TestStoreBan(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db    = rawdb.NewMemoryDatabase()
+		store = NewStore(db)
+		now   = time.Unix(1700000000, 0)
+		id    = enode.ID{1}
+		ip    = netip.MustParseAddr("203.0.113.7")
+	)
+
+	store.now = func() time.Time { return now }
+
+	if store.Report(id, ip, WhitelistMismatch) {
+		t.Fatal("peer banned after a single mismatch")
+	}
+
+	// The score decays by half after the half-life, staying under the threshold
+	now = now.Add(scoreHalfLife)
+
+	if store.Report(id, ip, WhitelistMismatch) {
+		t.Fatal("peer banned with a decayed score")
+	}
+
+	if !store.Report(id, ip, WhitelistMismatch) {
+		t.Fatal("peer not banned over the threshold")
+	}
+
+	if !store.IsBannedNode(id) || !store.IsBannedIP(ip) {
+		t.Fatal("node or IP not banned")
+	}
+
+	if store.IsBannedNode(enode.ID{2}) || store.IsBannedIP(netip.MustParseAddr("203.0.113.8")) {
+		t.Fatal("unrelated node or IP banned")
+	}
+
+	// The ban survives a restart
+	reloaded := NewStore(db)
+	reloaded.now = store.now
+
+	if !reloaded.IsBannedNode(id) || !reloaded.IsBannedIP(ip) {
+		t.Fatal("ban not persisted")
+	}
+
+	// The ban expires, and the next one lasts twice as long
+	now = now.Add(minBanDuration)
+
+	if reloaded.IsBannedNode(id) {
+		t.Fatal("ban not expired")
+	}
+
+	for i := 0; i < 2; i++ {
+		if reloaded.Report(id, ip, InvalidSeal) {
+			t.Fatalf("peer banned after %d invalid seals", i+1)
+		}
+	}
+
+	if !reloaded.Report(id, ip, InvalidSeal) {
+		t.Fatal("peer not banned after repeated invalid seals")
+	}
+
+	if score := reloaded.Score(id); score == nil || score.Bans != 2 || !score.BannedUntil.Equal(now.Add(2*minBanDuration)) {
+		t.Fatalf("unexpected score after the second ban: %+v", score)
+	}
+}
+
+func TestStoreSave(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db    = rawdb.NewMemoryDatabase()
+		store = NewStore(db)
+		now   = time.Unix(1700000000, 0)
+	)
+
+	store.now = func() time.Time { return now }
+
+	reloadedScore := func(id enode.ID) *PeerScore {
+		reloaded := NewStore(db)
+		reloaded.now = store.now
+
+		return reloaded.Score(id)
+	}
+
+	// The first report is saved, the next ones are held back for the save interval
+	store.Report(enode.ID{1}, netip.Addr{}, InvalidTxDependency)
+	store.Report(enode.ID{2}, netip.Addr{}, InvalidTxDependency)
+
+	if reloadedScore(enode.ID{1}) == nil || reloadedScore(enode.ID{2}) != nil {
+		t.Fatal("reports not held back for the save interval")
+	}
+
+	// A new ban is saved right away
+	for i := 0; i < 3; i++ {
+		store.Report(enode.ID{3}, netip.Addr{}, InvalidSeal)
+	}
+
+	if score := reloadedScore(enode.ID{3}); score == nil || score.Bans != 1 {
+		t.Fatalf("ban not saved: %+v", score)
+	}
+
+	// The reports are saved once the save interval elapsed, or on close
+	store.Report(enode.ID{4}, netip.Addr{}, InvalidTxDependency)
+
+	if reloadedScore(enode.ID{4}) != nil {
+		t.Fatal("report saved within the save interval")
+	}
+
+	now = now.Add(saveInterval)
+	store.Report(enode.ID{5}, netip.Addr{}, InvalidTxDependency)
+
+	if reloadedScore(enode.ID{4}) == nil || reloadedScore(enode.ID{5}) == nil {
+		t.Fatal("reports not saved after the save interval")
+	}
+
+	store.Report(enode.ID{6}, netip.Addr{}, InvalidTxDependency)
+	store.Close()
+
+	if reloadedScore(enode.ID{6}) == nil {
+		t.Fatal("report not saved on close")
+	}
+}
+
+func TestStoreLAN(t *testing.T) {
+	t.Parallel()
+
+	store := NewStore(rawdb.NewMemoryDatabase())
+	ip := netip.MustParseAddr("192.168.1.10")
+
+	for i := 0; i < 3; i++ {
+		store.Report(enode.ID{1}, ip, InvalidSeal)
+	}
+
+	if !store.IsBannedNode(enode.ID{1}) {
+		t.Fatal("node not banned after repeated invalid seals")
+	}
+
+	if store.IsBannedIP(ip) {
+		t.Fatal("LAN address banned")
+	}
+
+	if len(store.Scores()) != 1 {
+		t.Fatalf("unexpected records: %d", len(store.Scores()))
+	}
+}
+
+func TestBanDuration(t *testing.T) {
+	t.Parallel()
+
+	if d := banDuration(1); d != minBanDuration {
+		t.Fatalf("first ban: have %v, want %v", d, minBanDuration)
+	}
+
+	if d := banDuration(1000); d != maxBanDuration {
+		t.Fatalf("capped ban: have %v, want %v", d, maxBanDuration)
+	}
+}
@@ -0,0 +1,80 @@
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	relayBlocks = 64   // Number of blocks of the private peers remembered for the relay
+	relayTxs    = 8192 // Number of transactions of the private peers remembered for the relay
+)
+
+// sentryRelay tracks the blocks and transactions received from the private
+// peers of a sentry node, usually its validators, so that they are pushed to all
+// the peers rather than to a subset of them.
+type sentryRelay struct {
+	private map[enode.ID]struct{}
+	blocks  *lru.Cache[common.Hash, struct{}]
+	txs     *lru.Cache[common.Hash, struct{}]
+}
+
+// newSentryRelay creates the relay of the private peers, nil if there are none.
+func newSentryRelay(private []*enode.Node) *sentryRelay {
+	if len(private) == 0 {
+		return nil
+	}
+
+	r := &sentryRelay{
+		private: make(map[enode.ID]struct{}, len(private)),
+		blocks:  lru.NewCache[common.Hash, struct{}](relayBlocks),
+		txs:     lru.NewCache[common.Hash, struct{}](relayTxs),
+	}
+
+	for _, n := range private {
+		r.private[n.ID()] = struct{}{}
+	}
+
+	return r
+}
+
+// isPrivate reports whether the peer is a private peer.
+func (r *sentryRelay) isPrivate(id enode.ID) bool {
+	if r == nil {
+		return false
+	}
+
+	_, ok := r.private[id]
+
+	return ok
+}
+
+// markBlock records the block if it was received from a private peer.
+func (r *sentryRelay) markBlock(id enode.ID, hash common.Hash) {
+	if r.isPrivate(id) {
+		r.blocks.Add(hash, struct{}{})
+	}
+}
+
+// markTxs records the transactions if they were received from a private peer.
+func (r *sentryRelay) markTxs(id enode.ID, txs []*types.Transaction) {
+	if !r.isPrivate(id) {
+		return
+	}
+
+	for _, tx := range txs {
+		r.txs.Add(tx.Hash(), struct{}{})
+	}
+}
+
+// relayBlock reports whether the block was received from a private peer.
+func (r *sentryRelay) relayBlock(hash common.Hash) bool {
+	return r != nil && r.blocks.Contains(hash)
+}
+
+// relayTx reports whether the transaction was received from a private peer.
+func (r *sentryRelay) relayTx(hash common.Hash) bool {
+	return r != nil && r.txs.Contains(hash)
+}
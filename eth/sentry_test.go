@@ -0,0 +1,39 @@
+package eth
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+func TestSentryRelay(t *testing.T) {
+	t.Parallel()
+
+	if relay := newSentryRelay(nil); relay != nil || relay.relayBlock(common.Hash{1}) || relay.relayTx(common.Hash{1}) {
+		t.Fatal("relay enabled without private peers")
+	}
+
+	var (
+		private = newMeshTestNode(t)
+		public  = newMeshTestNode(t)
+		relay   = newSentryRelay([]*enode.Node{private})
+
+		privateTx = types.NewTx(&types.LegacyTx{Nonce: 1})
+		publicTx  = types.NewTx(&types.LegacyTx{Nonce: 2})
+	)
+
+	relay.markBlock(private.ID(), common.Hash{1})
+	relay.markBlock(public.ID(), common.Hash{2})
+	relay.markTxs(private.ID(), []*types.Transaction{privateTx})
+	relay.markTxs(public.ID(), []*types.Transaction{publicTx})
+
+	if !relay.relayBlock(common.Hash{1}) || relay.relayBlock(common.Hash{2}) {
+		t.Fatal("wrong relayed blocks")
+	}
+
+	if !relay.relayTx(privateTx.Hash()) || relay.relayTx(publicTx.Hash()) {
+		t.Fatal("wrong relayed transactions")
+	}
+}
@@ -25,7 +25,9 @@ import (
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/downloader/whitelist"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/eth/reputation"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -201,6 +203,14 @@ func peerToSyncOp(mode downloader.SyncMode, p *eth.Peer) *chainSyncOp {
 }
 
 func (cs *chainSyncer) modeAndLocalHead() (downloader.SyncMode, *big.Int) {
+	// If we're in checkpoint sync mode, return that directly
+	if cs.handler.checkpointSync.Load() {
+		block := cs.handler.chain.CurrentSnapBlock()
+		td := cs.handler.chain.GetTd(block.Hash(), block.Number.Uint64())
+
+		return downloader.CheckpointSync, td
+	}
+
 	// TODO - uncomment when we (Polygon-PoS, bor) have snap sync/pbss
 	/*
 		// If we're in snap sync mode, return that directly
@@ -252,7 +262,7 @@ func (cs *chainSyncer) startSync(op *chainSyncOp) {
 
 // doSync synchronizes the local blockchain with a remote peer.
 func (h *handler) doSync(op *chainSyncOp) error {
-	if op.mode == downloader.SnapSync {
+	if op.mode == downloader.SnapSync || op.mode == downloader.CheckpointSync {
 		// Before launch the snap sync, we have to ensure user uses the same
 		// txlookup limit.
 		// The main concern here is: during the snap sync Geth won't index the
@@ -273,10 +283,16 @@ func (h *handler) doSync(op *chainSyncOp) error {
 	// Run the sync cycle, and disable snap sync if we're past the pivot block
 	err := h.downloader.LegacySync(op.peer.ID(), op.head, op.td, h.chain.Config().TerminalTotalDifficulty, op.mode)
 	if err != nil {
+		if errors.Is(err, whitelist.ErrMismatch) {
+			h.reportPeer(op.peer.ID(), reputation.WhitelistMismatch)
+		}
 		return err
 	}
 	h.enableSyncedFeatures()
 
+	// Retry the bor receipts which couldn't be retrieved during snap sync
+	h.downloader.RetryBorReceipts()
+
 	head := h.chain.CurrentBlock()
 	if head.Number.Uint64() > 0 {
 		// We've completed a sync cycle, notify all peers of new state. This path is
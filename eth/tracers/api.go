@@ -30,11 +30,9 @@ import (
 	"sync"
 	"time"
 
-	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
-	"github.com/ethereum/go-ethereum/consensus/bor/statefull"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
@@ -97,6 +95,7 @@ type Backend interface {
 	BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error)
 	GetTransaction(ctx context.Context, txHash common.Hash) (bool, *types.Transaction, common.Hash, uint64, uint64, error)
 	RPCGasCap() uint64
+	RPCTraceFilterRange() uint64
 	ChainConfig() *params.ChainConfig
 	Engine() consensus.Engine
 	ChainDb() ethdb.Database
@@ -171,28 +170,6 @@ func (api *API) blockByNumberAndHash(ctx context.Context, number rpc.BlockNumber
 	return api.blockByHash(ctx, hash)
 }
 
-// getAllBlockTransactions returns all blocks transactions including state-sync transaction if present
-// along with a flag and it's hash (which is calculated differently than regular transactions)
-func (api *API) getAllBlockTransactions(ctx context.Context, block *types.Block) (types.Transactions, bool, common.Hash) {
-	txs := block.Transactions()
-
-	stateSyncPresent := false
-	stateSyncHash := common.Hash{}
-
-	borReceipt := rawdb.ReadBorReceipt(api.backend.ChainDb(), block.Hash(), block.NumberU64(), api.backend.ChainConfig())
-	if borReceipt != nil {
-		txHash := types.GetDerivedBorTxHash(types.BorReceiptKey(block.Number().Uint64(), block.Hash()))
-		if txHash != (common.Hash{}) {
-			borTx, _, _, _, _ := api.backend.GetBorBlockTransactionWithBlockHash(ctx, txHash, block.Hash())
-			txs = append(txs, borTx)
-			stateSyncPresent = true
-			stateSyncHash = txHash
-		}
-	}
-
-	return txs, stateSyncPresent, stateSyncHash
-}
-
 // TraceConfig holds extra parameters to trace functions.
 type TraceConfig struct {
 	*logger.Config
@@ -205,7 +182,6 @@ type TraceConfig struct {
 	// config are historically embedded in main object.
 	TracerConfig    json.RawMessage
 	BorTraceEnabled *bool
-	BorTx           *bool
 }
 
 // TraceCallConfig is the config for traceCall API. It holds one more
@@ -302,7 +278,6 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 	if config == nil {
 		config = &TraceConfig{
 			BorTraceEnabled: defaultBorTraceEnabled,
-			BorTx:           newBoolPtr(false),
 		}
 	}
 
@@ -343,43 +318,36 @@ func (api *API) traceChain(start, end *types.Block, config *TraceConfig, closed
 					blockCtx = core.NewEVMBlockContext(task.block.Header(), api.chainContext(ctx), nil)
 				)
 				// Trace all the transactions contained within
-				txs, stateSyncPresent, stateSyncHash := api.getAllBlockTransactions(ctx, task.block)
-				if !*config.BorTraceEnabled && stateSyncPresent {
-					txs = txs[:len(txs)-1]
-					stateSyncPresent = false
-				}
+				failed := false
 
-				for i, tx := range txs {
+				for i, tx := range task.block.Transactions() {
 					msg, _ := core.TransactionToMessage(tx, signer, task.block.BaseFee())
-					txHash := tx.Hash()
-					if stateSyncPresent && i == len(txs)-1 {
-						txHash = stateSyncHash
-					}
 					txctx := &Context{
 						BlockHash:   task.block.Hash(),
 						BlockNumber: task.block.Number(),
 						TxIndex:     i,
-						TxHash:      txHash,
+						TxHash:      tx.Hash(),
 					}
 
-					var res interface{}
+					res, err := api.traceTx(ctx, tx, msg, txctx, blockCtx, task.statedb, config)
+					if err != nil {
+						task.results[i] = &txTraceResult{TxHash: tx.Hash(), Error: err.Error()}
+						log.Warn("Tracing failed", "hash", tx.Hash(), "block", task.block.NumberU64(), "err", err)
 
-					var err error
+						failed = true
 
-					if stateSyncPresent && i == len(txs)-1 {
-						if *config.BorTraceEnabled {
-							config.BorTx = newBoolPtr(true)
-						}
+						break
 					}
-
-					res, err = api.traceTx(ctx, tx, msg, txctx, blockCtx, task.statedb, config)
+					task.results[i] = &txTraceResult{TxHash: tx.Hash(), Result: res}
+				}
+				// Trace the state syncs committed after the transactions
+				if *config.BorTraceEnabled && !failed {
+					results, err := api.traceStateSyncs(ctx, task.block, task.statedb, config)
 					if err != nil {
-						task.results[i] = &txTraceResult{TxHash: txHash, Error: err.Error()}
-						log.Warn("Tracing failed", "hash", txHash, "block", task.block.NumberU64(), "err", err)
-
-						break
+						log.Warn("Tracing state syncs failed", "block", task.block.NumberU64(), "err", err)
 					}
-					task.results[i] = &txTraceResult{TxHash: txHash, Result: res}
+
+					task.results = append(task.results, results...)
 				}
 				// Tracing state is used up, queue it for de-referencing. Note the
 				// state is the parent state of trace block, use block.number-1 as
@@ -613,28 +581,12 @@ func (api *API) StandardTraceBlockToFile(ctx context.Context, hash common.Hash,
 	return api.standardTraceBlockToFile(ctx, block, config)
 }
 
-func prepareCallMessage(msg core.Message) statefull.Callmsg {
-	return statefull.Callmsg{
-		CallMsg: ethereum.CallMsg{
-			From:       msg.From,
-			To:         msg.To,
-			Gas:        msg.GasLimit,
-			GasPrice:   msg.GasPrice,
-			GasFeeCap:  msg.GasFeeCap,
-			GasTipCap:  msg.GasTipCap,
-			Value:      msg.Value,
-			Data:       msg.Data,
-			AccessList: msg.AccessList,
-		}}
-}
-
 // IntermediateRoots executes a block (bad- or canon- or side-), and returns a list
 // of intermediate roots: the stateroot after each transaction.
 func (api *API) IntermediateRoots(ctx context.Context, hash common.Hash, config *TraceConfig) ([]common.Hash, error) {
 	if config == nil {
 		config = &TraceConfig{
 			BorTraceEnabled: defaultBorTraceEnabled,
-			BorTx:           newBoolPtr(false),
 		}
 	}
 
@@ -685,8 +637,7 @@ func (api *API) IntermediateRoots(ctx context.Context, hash common.Hash, config
 		core.ProcessBeaconBlockRoot(*beaconRoot, vmenv, statedb)
 	}
 
-	txs, stateSyncPresent, stateSyncHash := api.getAllBlockTransactions(ctx, block)
-	for i, tx := range txs {
+	for i, tx := range block.Transactions() {
 		if err := ctx.Err(); err != nil {
 			return nil, err
 		}
@@ -697,37 +648,17 @@ func (api *API) IntermediateRoots(ctx context.Context, hash common.Hash, config
 			vmenv     = vm.NewEVM(vmctx, txContext, statedb, chainConfig, vm.Config{})
 		)
 
-		//nolint: nestif
-		if stateSyncPresent && i == len(txs)-1 {
-			if *config.BorTraceEnabled {
-				callmsg := prepareCallMessage(*msg)
-				statedb.SetTxContext(stateSyncHash, i)
-				if _, err := statefull.ApplyMessage(ctx, callmsg, statedb, block.Header(), api.backend.ChainConfig(), api.chainContext(ctx)); err != nil {
-					log.Warn("Tracing intermediate roots did not complete", "txindex", i, "txhash", stateSyncHash, "err", err)
-					// We intentionally don't return the error here: if we do, then the RPC server will not
-					// return the roots. Most likely, the caller already knows that a certain transaction fails to
-					// be included, but still want the intermediate roots that led to that point.
-					// It may happen the tx_N causes an erroneous state, which in turn causes tx_N+M to not be
-					// executable.
-					// N.B: This should never happen while tracing canon blocks, only when tracing bad blocks.
-					return roots, nil
-				}
-			} else {
-				break
-			}
-		} else {
-			statedb.SetTxContext(tx.Hash(), i)
-			// nolint : contextcheck
-			if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background()); err != nil {
-				log.Warn("Tracing intermediate roots did not complete", "txindex", i, "txhash", tx.Hash(), "err", err)
-				// We intentionally don't return the error here: if we do, then the RPC server will not
-				// return the roots. Most likely, the caller already knows that a certain transaction fails to
-				// be included, but still want the intermediate roots that led to that point.
-				// It may happen the tx_N causes an erroneous state, which in turn causes tx_N+M to not be
-				// executable.
-				// N.B: This should never happen while tracing canon blocks, only when tracing bad blocks.
-				return roots, nil
-			}
+		statedb.SetTxContext(tx.Hash(), i)
+		// nolint : contextcheck
+		if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background()); err != nil {
+			log.Warn("Tracing intermediate roots did not complete", "txindex", i, "txhash", tx.Hash(), "err", err)
+			// We intentionally don't return the error here: if we do, then the RPC server will not
+			// return the roots. Most likely, the caller already knows that a certain transaction fails to
+			// be included, but still want the intermediate roots that led to that point.
+			// It may happen the tx_N causes an erroneous state, which in turn causes tx_N+M to not be
+			// executable.
+			// N.B: This should never happen while tracing canon blocks, only when tracing bad blocks.
+			return roots, nil
 		}
 
 		// calling IntermediateRoot will internally call Finalize on the state
@@ -735,6 +666,17 @@ func (api *API) IntermediateRoots(ctx context.Context, hash common.Hash, config
 		roots = append(roots, statedb.IntermediateRoot(deleteEmptyObjects))
 	}
 
+	// The state syncs committed after the transactions have a root each too
+	if *config.BorTraceEnabled {
+		err := api.replayStateSyncs(ctx, block, statedb, func(hash common.Hash) error {
+			roots = append(roots, statedb.IntermediateRoot(deleteEmptyObjects))
+			return nil
+		})
+		if err != nil {
+			log.Warn("Tracing intermediate roots of state syncs did not complete", "err", err)
+		}
+	}
+
 	return roots, nil
 }
 
@@ -760,7 +702,6 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 	if config == nil {
 		config = &TraceConfig{
 			BorTraceEnabled: defaultBorTraceEnabled,
-			BorTx:           newBoolPtr(false),
 		}
 	}
 
@@ -806,11 +747,11 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 
 	// Execute all the transaction contained within the block concurrently
 	var (
-		txs, stateSyncPresent, stateSyncHash = api.getAllBlockTransactions(ctx, block)
-		blockHash                            = block.Hash()
-		signer                               = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
-		results                              = make([]*txTraceResult, len(txs))
-		pend                                 sync.WaitGroup
+		txs       = block.Transactions()
+		blockHash = block.Hash()
+		signer    = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
+		results   = make([]*txTraceResult, len(txs))
+		pend      sync.WaitGroup
 	)
 
 	threads := runtime.NumCPU()
@@ -829,9 +770,6 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 			for task := range jobs {
 				msg, _ := core.TransactionToMessage(txs[task.index], signer, block.BaseFee())
 				txHash := txs[task.index].Hash()
-				if stateSyncPresent && task.index == len(txs)-1 {
-					txHash = stateSyncHash
-				}
 				txctx := &Context{
 					BlockHash:   blockHash,
 					BlockNumber: block.Number(),
@@ -839,23 +777,12 @@ func (api *API) traceBlock(ctx context.Context, block *types.Block, config *Trac
 					TxHash:      txHash,
 				}
 
-				var res interface{}
-
-				var err error
-
-				if stateSyncPresent && task.index == len(txs)-1 {
-					if *config.BorTraceEnabled {
-						// avoid data race
-						config.BorTx = newBoolPtr(true)
-					}
-				}
-
 				// Reconstruct the block context for each transaction
 				// as the GetHash function of BlockContext is not safe for
 				// concurrent use.
 				// See: https://github.com/ethereum/go-ethereum/issues/29114
 				blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
-				res, err = api.traceTx(ctx, txs[task.index], msg, txctx, blockCtx, task.statedb, config)
+				res, err := api.traceTx(ctx, txs[task.index], msg, txctx, blockCtx, task.statedb, config)
 				if err != nil {
 					results[task.index] = &txTraceResult{TxHash: txHash, Error: err.Error()}
 					continue
@@ -906,28 +833,14 @@ txloop:
 
 		// nolint: nestif
 		if !ioflag {
-			//nolint: nestif
-			if stateSyncPresent && i == len(txs)-1 {
-				if *config.BorTraceEnabled {
-					callmsg := prepareCallMessage(*msg)
-					// nolint : contextcheck
-					if _, err := statefull.ApplyBorMessage(vmenv, callmsg); err != nil {
-						failed = err
-						break txloop
-					}
-				} else {
-					break txloop
-				}
-			} else {
-				// nolint : contextcheck
-				if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background()); err != nil {
-					failed = err
-					break txloop
-				}
-				// Finalize the state so any modifications are written to the trie
-				// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
-				statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
+			// nolint : contextcheck
+			if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background()); err != nil {
+				failed = err
+				break txloop
 			}
+			// Finalize the state so any modifications are written to the trie
+			// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
+			statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
 		} else {
 			coinbaseBalance := big.NewInt(statedb.GetBalance(blockCtx.Coinbase).ToBig().Int64())
 			// nolint : contextcheck
@@ -994,11 +907,17 @@ txloop:
 		return nil, failed
 	}
 
-	if !*config.BorTraceEnabled && stateSyncPresent {
-		return results[:len(results)-1], nil
-	} else {
-		return results, nil
+	// Trace the state syncs committed after the transactions
+	if *config.BorTraceEnabled && !ioflag {
+		stateSyncResults, err := api.traceStateSyncs(ctx, block, statedb, config)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, stateSyncResults...)
 	}
+
+	return results, nil
 }
 
 // standardTraceBlockToFile configures a new tracer which uses standard JSON output,
@@ -1072,12 +991,6 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 		chainConfig, canon = overrideConfig(chainConfig, config.Overrides)
 	}
 
-	txs, stateSyncPresent, stateSyncHash := api.getAllBlockTransactions(ctx, block)
-	if !*config.BorTraceEnabled && stateSyncPresent {
-		txs = txs[:len(txs)-1]
-		stateSyncPresent = false
-	}
-
 	if beaconRoot := block.BeaconRoot(); beaconRoot != nil {
 		vmenv := vm.NewEVM(vmctx, vm.TxContext{}, statedb, chainConfig, vm.Config{})
 		core.ProcessBeaconBlockRoot(*beaconRoot, vmenv, statedb)
@@ -1093,7 +1006,7 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 			err       error
 		)
 		// If the transaction needs tracing, swap out the configs
-		if tx.Hash() == txHash || txHash == (common.Hash{}) || txHash == stateSyncHash {
+		if tx.Hash() == txHash || txHash == (common.Hash{}) {
 			// Generate a unique temporary file to dump it into
 			prefix := fmt.Sprintf("block_%#x-%d-%#x-", block.Hash().Bytes()[:4], i, tx.Hash().Bytes()[:4])
 			if !canon {
@@ -1117,31 +1030,23 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 		// Execute the transaction and flush any traces to disk
 		vmenv := vm.NewEVM(vmctx, txContext, statedb, chainConfig, vmConf)
 
-		//nolint: nestif
-		if stateSyncPresent && i == len(txs)-1 {
-			if *config.BorTraceEnabled {
-				callmsg := prepareCallMessage(*msg)
-				statedb.SetTxContext(stateSyncHash, i)
-				_, err = statefull.ApplyBorMessage(vmenv, callmsg)
+		statedb.SetTxContext(tx.Hash(), i)
+		if vmConf.Tracer != nil && vmConf.Tracer.OnTxStart != nil {
+			vmConf.Tracer.OnTxStart(vmenv.GetVMContext(), tx, msg.From)
+		}
 
-				if writer != nil {
-					writer.Flush()
-				}
-			}
-		} else {
-			statedb.SetTxContext(tx.Hash(), i)
-			if vmConf.Tracer.OnTxStart != nil {
-				vmConf.Tracer.OnTxStart(vmenv.GetVMContext(), tx, msg.From)
+		// nolint : contextcheck
+		vmRet, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background())
+		if vmConf.Tracer != nil && vmConf.Tracer.OnTxEnd != nil {
+			var receipt *types.Receipt
+			if vmRet != nil {
+				receipt = &types.Receipt{GasUsed: vmRet.UsedGas}
 			}
 
-			// nolint : contextcheck
-			vmRet, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background())
-			if vmConf.Tracer.OnTxEnd != nil {
-				vmConf.Tracer.OnTxEnd(&types.Receipt{GasUsed: vmRet.UsedGas}, err)
-			}
-			if writer != nil {
-				writer.Flush()
-			}
+			vmConf.Tracer.OnTxEnd(receipt, err)
+		}
+		if writer != nil {
+			writer.Flush()
 		}
 
 		if dump != nil {
@@ -1158,19 +1063,74 @@ func (api *API) standardTraceBlockToFile(ctx context.Context, block *types.Block
 
 		// If we've traced the transaction we were looking for, abort
 		if tx.Hash() == txHash {
-			break
+			return dumps, nil
+		}
+	}
+
+	if !*config.BorTraceEnabled || chainConfig.Bor == nil {
+		return dumps, nil
+	}
+
+	// Dump the traces of the state syncs committed after the transactions
+	tracer := newStateSyncTracer(block, statedb, chainConfig, &TraceConfig{})
+	tracer.newTracer = func(txctx *Context) (*Tracer, error) {
+		if txHash != (common.Hash{}) && txHash != txctx.TxHash {
+			return nil, nil
+		}
+
+		prefix := fmt.Sprintf("block_%#x-%d-%#x-", block.Hash().Bytes()[:4], txctx.TxIndex, txctx.TxHash.Bytes()[:4])
+		if !canon {
+			prefix = fmt.Sprintf("%valt-", prefix)
+		}
+
+		dump, err := os.CreateTemp(os.TempDir(), prefix)
+		if err != nil {
+			return nil, err
 		}
+
+		dumps = append(dumps, dump.Name())
+		writer := bufio.NewWriter(dump)
+
+		return &Tracer{
+			Hooks: logger.NewJSONLogger(&logConfig, writer),
+			GetResult: func() (json.RawMessage, error) {
+				writer.Flush()
+				dump.Close()
+				log.Info("Wrote standard trace", "file", dump.Name())
+
+				return nil, nil
+			},
+			Stop: func(err error) {},
+		}, nil
 	}
 
-	return dumps, nil
+	statedb.SetLogger(tracer.hooks())
+	defer statedb.SetLogger(nil)
+
+	if err := api.replayStateSyncs(ctx, block, statedb, nil); err != nil {
+		if errors.Is(err, errSystemCallsNotFound) {
+			log.Warn("Skipping standard trace of state syncs", "block", block.NumberU64(), "err", err)
+			return dumps, nil
+		}
+
+		return dumps, err
+	}
+
+	return dumps, tracer.err
 }
 
 // containsTx reports whether the transaction with a certain hash
 // is contained within the specified block.
 func (api *API) containsTx(ctx context.Context, block *types.Block, hash common.Hash) bool {
-	txs, _, stateSyncHash := api.getAllBlockTransactions(ctx, block)
-	for _, tx := range txs {
-		if tx.Hash() == hash || stateSyncHash == hash {
+	for _, tx := range block.Transactions() {
+		if tx.Hash() == hash {
+			return true
+		}
+	}
+
+	// State syncs are traced as transactions
+	for _, stateSyncHash := range api.stateSyncTxHashes(block) {
+		if stateSyncHash == hash {
 			return true
 		}
 	}
@@ -1184,7 +1144,6 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 	if config == nil {
 		config = &TraceConfig{
 			BorTraceEnabled: defaultBorTraceEnabled,
-			BorTx:           newBoolPtr(false),
 		}
 	}
 
@@ -1194,6 +1153,11 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 
 	found, _, blockHash, blockNumber, index, err := api.backend.GetTransaction(ctx, hash)
 	if !found {
+		// State syncs are traced as transactions
+		if number := rawdb.ReadStateSyncTxLookupEntry(api.backend.ChainDb(), hash); number != nil {
+			return api.traceStateSyncTx(ctx, hash, *number, config)
+		}
+
 		// For BorTransaction, there will be no trace available
 		tx, _, _, _ := rawdb.ReadBorTransaction(api.backend.ChainDb(), hash)
 		if tx != nil {
@@ -1219,6 +1183,14 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 		reexec = *config.Reexec
 	}
 
+	// Serve the trace from the cache to avoid re-executing the block
+	cache := api.traceCache()
+	if cache != nil {
+		if result := cache.Get(blockHash, index, config); result != nil {
+			return result, nil
+		}
+	}
+
 	block, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(blockNumber), blockHash)
 	if err != nil {
 		return nil, err
@@ -1240,7 +1212,16 @@ func (api *API) TraceTransaction(ctx context.Context, hash common.Hash, config *
 		TxIndex:     int(index),
 		TxHash:      hash,
 	}
-	return api.traceTx(ctx, tx, msg, txctx, vmctx, statedb, config)
+	result, err := api.traceTx(ctx, tx, msg, txctx, vmctx, statedb, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if raw, ok := result.(json.RawMessage); ok && cache != nil {
+		cache.Put(blockHash, index, config, raw)
+	}
+
+	return result, nil
 }
 
 // TraceCall lets you trace a given eth_call. It collects the structured logs
@@ -1328,7 +1309,6 @@ func (api *API) traceTx(ctx context.Context, tx *types.Transaction, message *cor
 	if config == nil {
 		config = &TraceConfig{
 			BorTraceEnabled: defaultBorTraceEnabled,
-			BorTx:           newBoolPtr(false),
 		}
 	}
 
@@ -1388,23 +1368,9 @@ func (api *API) traceTx(ctx context.Context, tx *types.Transaction, message *cor
 	// Call Prepare to clear out the statedb access list
 	statedb.SetTxContext(txctx.TxHash, txctx.TxIndex)
 
-	if config.BorTx == nil {
-		config.BorTx = newBoolPtr(false)
-	}
-
-	if *config.BorTx {
-		callmsg := prepareCallMessage(*message)
-		// nolint : contextcheck
-		if _, err := statefull.ApplyBorMessage(vmenv, callmsg); err != nil {
-			return nil, fmt.Errorf("tracing failed: %w", err)
-		}
-	} else {
-		// Call Prepare to clear out the statedb access list
-		statedb.SetTxContext(txctx.TxHash, txctx.TxIndex)
-		_, err = core.ApplyTransactionWithEVM(message, api.backend.ChainConfig(), new(core.GasPool).AddGas(message.GasLimit), statedb, vmctx.BlockNumber, txctx.BlockHash, tx, &usedGas, vmenv, context.Background())
-		if err != nil {
-			return nil, fmt.Errorf("tracing failed: %w", err)
-		}
+	_, err = core.ApplyTransactionWithEVM(message, api.backend.ChainConfig(), new(core.GasPool).AddGas(message.GasLimit), statedb, vmctx.BlockNumber, txctx.BlockHash, tx, &usedGas, vmenv, context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("tracing failed: %w", err)
 	}
 
 	return tracer.GetResult()
@@ -1418,6 +1384,10 @@ func APIs(backend Backend) []rpc.API {
 			Namespace: "debug",
 			Service:   NewAPI(backend),
 		},
+		{
+			Namespace: "trace",
+			Service:   NewTraceAPI(backend),
+		},
 	}
 }
 
@@ -2,10 +2,10 @@ package tracers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/consensus/bor/statefull"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -39,6 +39,10 @@ func (api *API) traceBorBlock(ctx context.Context, block *types.Block, config *T
 		return nil, fmt.Errorf("genesis is not traceable")
 	}
 
+	if config == nil {
+		config = &TraceConfig{}
+	}
+
 	res := &BlockTraceResult{
 		Block: block,
 	}
@@ -68,20 +72,16 @@ func (api *API) traceBorBlock(ctx context.Context, block *types.Block, config *T
 
 	// Execute all the transaction contained within the block concurrently
 	var (
-		signer                               = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
-		txs, stateSyncPresent, stateSyncHash = api.getAllBlockTransactions(ctx, block)
-		deleteEmptyObjects                   = api.backend.ChainConfig().IsEIP158(block.Number())
+		signer             = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
+		deleteEmptyObjects = api.backend.ChainConfig().IsEIP158(block.Number())
 	)
 
 	blockCtx := core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
 
-	traceTxn := func(indx int, tx *types.Transaction, borTx bool, stateSyncHash common.Hash) *TxTraceResult {
+	traceTxn := func(indx int, tx *types.Transaction) *TxTraceResult {
 		message, _ := core.TransactionToMessage(tx, signer, block.BaseFee())
 		txContext := core.NewEVMTxContext(message)
 		txHash := tx.Hash()
-		if borTx {
-			txHash = stateSyncHash
-		}
 
 		tracer := logger.NewStructLogger(config.Config)
 
@@ -92,15 +92,7 @@ func (api *API) traceBorBlock(ctx context.Context, block *types.Block, config *T
 		// Not sure if we need to do this
 		statedb.SetTxContext(txHash, indx)
 
-		var execRes *core.ExecutionResult
-
-		if borTx {
-			callmsg := prepareCallMessage(*message)
-			execRes, err = statefull.ApplyBorMessage(vmenv, callmsg)
-		} else {
-			execRes, err = core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.GasLimit), nil)
-		}
-
+		execRes, err := core.ApplyMessage(vmenv, message, new(core.GasPool).AddGas(message.GasLimit), nil)
 		if err != nil {
 			return &TxTraceResult{
 				Error: err.Error(),
@@ -126,12 +118,39 @@ func (api *API) traceBorBlock(ctx context.Context, block *types.Block, config *T
 		return res
 	}
 
-	for indx, tx := range txs {
-		if stateSyncPresent && indx == len(txs)-1 {
-			res.Transactions = append(res.Transactions, traceTxn(indx, tx, true, stateSyncHash))
-		} else {
-			res.Transactions = append(res.Transactions, traceTxn(indx, tx, false, stateSyncHash))
+	for indx, tx := range block.Transactions() {
+		res.Transactions = append(res.Transactions, traceTxn(indx, tx))
+	}
+
+	// Trace the state syncs committed after the transactions, one by one
+	tracer := newStateSyncTracer(block, statedb, api.backend.ChainConfig(), config)
+
+	statedb.SetLogger(tracer.hooks())
+	defer statedb.SetLogger(nil)
+
+	err = api.replayStateSyncs(ctx, block, statedb, func(hash common.Hash) error {
+		if tracer.err != nil {
+			return tracer.err
+		}
+
+		if len(tracer.results) == 0 {
+			return errStateSyncNotFound
 		}
+
+		result := tracer.results[len(tracer.results)-1]
+
+		res.Transactions = append(res.Transactions, &TxTraceResult{
+			Result:           result.Result,
+			Error:            result.Error,
+			IntermediateHash: statedb.IntermediateRoot(deleteEmptyObjects),
+		})
+
+		return nil
+	})
+	if errors.Is(err, errSystemCallsNotFound) {
+		res.Transactions = append(res.Transactions, &TxTraceResult{Error: err.Error()})
+	} else if err != nil {
+		return nil, err
 	}
 
 	return res, nil
@@ -0,0 +1,489 @@
+package tracers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/statefull"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// The state syncs of a block are committed by the consensus engine after the
+// transactions, each of them in a system call to commitState of the state
+// receiver contract. They are traced as pseudo transactions, sent by the system
+// address to the state receiver contract, whose hashes are derived from the
+// block and the id of the state sync. The system calls are replayed from the
+// ones stored along with the block when it was imported, without fetching the
+// state syncs from heimdall again.
+
+var (
+	errStateSyncNotFound = errors.New("state sync not found")
+
+	// errSystemCallsNotFound is returned if the block committed state syncs but
+	// its system calls weren't stored, e.g. if it was imported by snap sync.
+	errSystemCallsNotFound = errors.New("system calls of the block not found")
+
+	// commitStateSelector is the selector of commitState(uint256,bytes)
+	commitStateSelector = crypto.Keccak256([]byte("commitState(uint256,bytes)"))[:4]
+
+	commitStateArgs = func() abi.Arguments {
+		uint256Type, _ := abi.NewType("uint256", "", nil)
+		bytesType, _ := abi.NewType("bytes", "", nil)
+
+		return abi.Arguments{{Type: uint256Type}, {Type: bytesType}}
+	}()
+)
+
+// decodeStateSyncID returns the id of the state sync committed by the input of a
+// commitState call.
+func decodeStateSyncID(input []byte) (uint64, bool) {
+	if len(input) < 4 || !bytes.Equal(input[:4], commitStateSelector) {
+		return 0, false
+	}
+
+	args, err := commitStateArgs.Unpack(input[4:])
+	if err != nil || len(args) != 2 {
+		return 0, false
+	}
+
+	recordBytes, ok := args[1].([]byte)
+	if !ok {
+		return 0, false
+	}
+
+	var record clerk.EventRecord
+	if err := rlp.DecodeBytes(recordBytes, &record); err != nil {
+		return 0, false
+	}
+
+	return record.ID, true
+}
+
+// stateSyncTracer splits the system calls of a block into pseudo transactions,
+// one for each state sync, and traces each of them with a new tracer.
+type stateSyncTracer struct {
+	block       *types.Block
+	statedb     *state.StateDB
+	chainConfig *params.ChainConfig
+	receiver    common.Address
+
+	// newTracer creates the tracer of a state sync
+	newTracer func(txctx *Context) (*Tracer, error)
+
+	systemCall bool
+	current    *Tracer
+	hash       common.Hash
+	gasUsed    uint64
+	reverted   bool
+
+	results []*txTraceResult
+	err     error
+}
+
+func newStateSyncTracer(block *types.Block, statedb *state.StateDB, chainConfig *params.ChainConfig, config *TraceConfig) *stateSyncTracer {
+	t := &stateSyncTracer{
+		block:       block,
+		statedb:     statedb,
+		chainConfig: chainConfig,
+		receiver:    common.HexToAddress(chainConfig.Bor.StateReceiverContract),
+	}
+
+	t.newTracer = func(txctx *Context) (*Tracer, error) {
+		// Default tracer is the struct logger
+		if config.Tracer == nil {
+			logger := logger.NewStructLogger(config.Config)
+
+			return &Tracer{
+				Hooks:     logger.Hooks(),
+				GetResult: logger.GetResult,
+				Stop:      logger.Stop,
+			}, nil
+		}
+
+		return DefaultDirectory.New(*config.Tracer, txctx, config.TracerConfig)
+	}
+
+	return t
+}
+
+// hooks returns the hooks of the tracer, forwarding the hooks of the state
+// sync being executed to its tracer.
+// nolint:gocognit
+func (t *stateSyncTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnSystemCallStart: func() {
+			t.systemCall = true
+		},
+		OnSystemCallEnd: func() {
+			t.systemCall = false
+			t.end()
+		},
+		OnEnter: func(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+			if depth == 0 && t.systemCall && t.current == nil && to == t.receiver {
+				t.start(from, to, input, gas, value)
+			}
+
+			if t.current != nil && t.current.OnEnter != nil {
+				t.current.OnEnter(depth, typ, from, to, input, gas, value)
+			}
+		},
+		OnExit: func(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+			if t.current == nil {
+				return
+			}
+
+			if depth == 0 {
+				t.gasUsed, t.reverted = gasUsed, reverted
+			}
+
+			if t.current.OnExit != nil {
+				t.current.OnExit(depth, output, gasUsed, err, reverted)
+			}
+		},
+		OnOpcode: func(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+			if t.current != nil && t.current.OnOpcode != nil {
+				t.current.OnOpcode(pc, op, gas, cost, scope, rData, depth, err)
+			}
+		},
+		OnFault: func(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, depth int, err error) {
+			if t.current != nil && t.current.OnFault != nil {
+				t.current.OnFault(pc, op, gas, cost, scope, depth, err)
+			}
+		},
+		OnGasChange: func(old, new uint64, reason tracing.GasChangeReason) {
+			if t.current != nil && t.current.OnGasChange != nil {
+				t.current.OnGasChange(old, new, reason)
+			}
+		},
+		OnBalanceChange: func(addr common.Address, prev, new *big.Int, reason tracing.BalanceChangeReason) {
+			if t.current != nil && t.current.OnBalanceChange != nil {
+				t.current.OnBalanceChange(addr, prev, new, reason)
+			}
+		},
+		OnNonceChange: func(addr common.Address, prev, new uint64) {
+			if t.current != nil && t.current.OnNonceChange != nil {
+				t.current.OnNonceChange(addr, prev, new)
+			}
+		},
+		OnCodeChange: func(addr common.Address, prevCodeHash common.Hash, prevCode []byte, codeHash common.Hash, code []byte) {
+			if t.current != nil && t.current.OnCodeChange != nil {
+				t.current.OnCodeChange(addr, prevCodeHash, prevCode, codeHash, code)
+			}
+		},
+		OnStorageChange: func(addr common.Address, slot common.Hash, prev, new common.Hash) {
+			if t.current != nil && t.current.OnStorageChange != nil {
+				t.current.OnStorageChange(addr, slot, prev, new)
+			}
+		},
+		OnLog: func(log *types.Log) {
+			if t.current != nil && t.current.OnLog != nil {
+				t.current.OnLog(log)
+			}
+		},
+	}
+}
+
+// start starts tracing the state sync committed by a call to the state receiver
+func (t *stateSyncTracer) start(from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.err != nil {
+		return
+	}
+
+	stateID, ok := decodeStateSyncID(input)
+	if !ok {
+		return
+	}
+
+	var (
+		number = t.block.NumberU64()
+		txctx  = &Context{
+			BlockHash:   t.block.Hash(),
+			BlockNumber: t.block.Number(),
+			TxIndex:     len(t.block.Transactions()) + len(t.results),
+			TxHash:      types.GetDerivedStateSyncTxHash(types.BorReceiptKey(number, t.block.Hash()), stateID),
+		}
+	)
+
+	tracer, err := t.newTracer(txctx)
+	if err != nil {
+		t.err = err
+		return
+	}
+
+	// The state sync is executed without being traced
+	if tracer == nil {
+		return
+	}
+
+	t.current, t.hash, t.gasUsed, t.reverted = tracer, txctx.TxHash, 0, false
+
+	if tracer.OnTxStart != nil {
+		header := t.block.Header()
+
+		tx := types.NewTx(&types.LegacyTx{
+			To:       &to,
+			Value:    value,
+			Gas:      gas,
+			GasPrice: common.Big0,
+			Data:     input,
+		})
+
+		tracer.OnTxStart(&tracing.VMContext{
+			Coinbase:    header.Coinbase,
+			BlockNumber: header.Number,
+			Time:        header.Time,
+			GasPrice:    common.Big0,
+			ChainConfig: t.chainConfig,
+			StateDB:     t.statedb,
+		}, tx, from)
+	}
+}
+
+// end ends tracing the state sync being executed and collects its result
+func (t *stateSyncTracer) end() {
+	tracer := t.current
+	if tracer == nil {
+		return
+	}
+
+	t.current = nil
+
+	if tracer.OnTxEnd != nil {
+		receipt := &types.Receipt{
+			Type:              types.LegacyTxType,
+			Status:            types.ReceiptStatusSuccessful,
+			CumulativeGasUsed: t.gasUsed,
+			TxHash:            t.hash,
+			GasUsed:           t.gasUsed,
+			BlockHash:         t.block.Hash(),
+			BlockNumber:       t.block.Number(),
+			TransactionIndex:  uint(len(t.block.Transactions()) + len(t.results)),
+		}
+
+		if t.reverted {
+			receipt.Status = types.ReceiptStatusFailed
+		}
+
+		tracer.OnTxEnd(receipt, nil)
+	}
+
+	res, err := tracer.GetResult()
+	if err != nil {
+		t.results = append(t.results, &txTraceResult{TxHash: t.hash, Error: err.Error()})
+		return
+	}
+
+	t.results = append(t.results, &txTraceResult{TxHash: t.hash, Result: res})
+}
+
+// systemCalls returns the system calls applied by the consensus engine at the
+// end of the block, stored when the block was imported.
+func (api *API) systemCalls(block *types.Block) ([]types.SystemCall, error) {
+	if api.backend.ChainConfig().Bor == nil {
+		return nil, nil
+	}
+
+	db := api.backend.ChainDb()
+
+	calls := rawdb.ReadSystemCalls(db, block.Hash(), block.NumberU64())
+	if calls == nil && rawdb.ReadRawBorReceipt(db, block.Hash(), block.NumberU64()) != nil {
+		return nil, errSystemCallsNotFound
+	}
+
+	return calls, nil
+}
+
+// stateSyncsNotFound returns the trace result standing for the state syncs of a
+// block whose system calls weren't stored, under the derived hash of its bor
+// transaction.
+func (api *API) stateSyncsNotFound(block *types.Block) *txTraceResult {
+	return &txTraceResult{
+		TxHash: types.GetDerivedBorTxHash(types.BorReceiptKey(block.NumberU64(), block.Hash())),
+		Error:  errSystemCallsNotFound.Error(),
+	}
+}
+
+// stateSyncTxHash returns the derived tx hash of the state sync committed by a
+// system call of the block, if it's a call to the state receiver contract.
+func (api *API) stateSyncTxHash(block *types.Block, call types.SystemCall) (common.Hash, bool) {
+	if call.To != common.HexToAddress(api.backend.ChainConfig().Bor.StateReceiverContract) {
+		return common.Hash{}, false
+	}
+
+	stateID, ok := decodeStateSyncID(call.Data)
+	if !ok {
+		return common.Hash{}, false
+	}
+
+	return types.GetDerivedStateSyncTxHash(types.BorReceiptKey(block.NumberU64(), block.Hash()), stateID), true
+}
+
+// stateSyncTxHashes returns the derived tx hashes of the state syncs committed by
+// the block, in order.
+func (api *API) stateSyncTxHashes(block *types.Block) []common.Hash {
+	calls, _ := api.systemCalls(block)
+
+	var hashes []common.Hash
+
+	for _, call := range calls {
+		if hash, ok := api.stateSyncTxHash(block, call); ok {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	return hashes
+}
+
+// replayStateSyncs applies the stored system calls of the block on the state after
+// its transactions, committing the span and the state syncs like the consensus
+// engine did. The callback, if any, is invoked after each state sync committed
+// with its derived tx hash.
+func (api *API) replayStateSyncs(ctx context.Context, block *types.Block, statedb *state.StateDB, committed func(hash common.Hash) error) error {
+	calls, err := api.systemCalls(block)
+	if err != nil {
+		return err
+	}
+
+	var (
+		header  = block.Header()
+		txIndex = len(block.Transactions())
+	)
+
+	for _, call := range calls {
+		hash, stateSync := api.stateSyncTxHash(block, call)
+		if stateSync {
+			statedb.SetTxContext(hash, txIndex)
+			txIndex++
+		}
+
+		if _, err := statefull.ApplyMessage(ctx, statefull.GetSystemMessage(call.To, call.Data), statedb, header, api.backend.ChainConfig(), api.chainContext(ctx)); err != nil {
+			return err
+		}
+
+		if stateSync && committed != nil {
+			if err := committed(hash); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// traceStateSyncs traces the state syncs committed by the block, on top of the
+// state after the transactions of the block. It returns no results if the
+// block commits no state syncs.
+func (api *API) traceStateSyncs(ctx context.Context, block *types.Block, statedb *state.StateDB, config *TraceConfig) ([]*txTraceResult, error) {
+	if api.backend.ChainConfig().Bor == nil {
+		return nil, nil
+	}
+
+	if config == nil {
+		config = &TraceConfig{}
+	}
+
+	tracer := newStateSyncTracer(block, statedb, api.backend.ChainConfig(), config)
+
+	statedb.SetLogger(tracer.hooks())
+	defer statedb.SetLogger(nil)
+
+	if err := api.replayStateSyncs(ctx, block, statedb, nil); err != nil {
+		// Blocks imported without their system calls still trace their transactions
+		if errors.Is(err, errSystemCallsNotFound) {
+			return []*txTraceResult{api.stateSyncsNotFound(block)}, nil
+		}
+
+		return nil, fmt.Errorf("tracing state syncs failed: %w", err)
+	}
+
+	if tracer.err != nil {
+		return nil, tracer.err
+	}
+
+	return tracer.results, nil
+}
+
+// traceStateSyncTx traces the state sync with the derived tx hash committed in
+// the block with the number.
+func (api *API) traceStateSyncTx(ctx context.Context, hash common.Hash, number uint64, config *TraceConfig) (interface{}, error) {
+	// It shouldn't happen in practice.
+	if number == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	block, err := api.blockByNumber(ctx, rpc.BlockNumber(number))
+	if err != nil {
+		return nil, err
+	}
+
+	parent, err := api.blockByNumberAndHash(ctx, rpc.BlockNumber(number-1), block.ParentHash())
+	if err != nil {
+		return nil, err
+	}
+
+	reexec := defaultTraceReexec
+	if config != nil && config.Reexec != nil {
+		reexec = *config.Reexec
+	}
+
+	statedb, release, err := api.backend.StateAtBlock(ctx, parent, reexec, nil, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	defer release()
+
+	// Execute the transactions of the block, the state syncs are committed after them
+	var (
+		signer   = types.MakeSigner(api.backend.ChainConfig(), block.Number(), block.Time())
+		blockCtx = core.NewEVMBlockContext(block.Header(), api.chainContext(ctx), nil)
+	)
+
+	for i, tx := range block.Transactions() {
+		msg, _ := core.TransactionToMessage(tx, signer, block.BaseFee())
+		statedb.SetTxContext(tx.Hash(), i)
+
+		vmenv := vm.NewEVM(blockCtx, core.NewEVMTxContext(msg), statedb, api.backend.ChainConfig(), vm.Config{})
+		// nolint : contextcheck
+		if _, err := core.ApplyMessage(vmenv, msg, new(core.GasPool).AddGas(msg.GasLimit), context.Background()); err != nil {
+			return nil, fmt.Errorf("transaction %#x failed: %w", tx.Hash(), err)
+		}
+		// Only delete empty objects if EIP158/161 (a.k.a Spurious Dragon) is in effect
+		statedb.Finalise(vmenv.ChainConfig().IsEIP158(block.Number()))
+	}
+
+	results, err := api.traceStateSyncs(ctx, block, statedb, config)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, result := range results {
+		if result.TxHash != hash {
+			continue
+		}
+
+		if result.Error != "" {
+			return nil, errors.New(result.Error)
+		}
+
+		return result.Result, nil
+	}
+
+	return nil, errStateSyncNotFound
+}
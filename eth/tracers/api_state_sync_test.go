@@ -0,0 +1,456 @@
+package tracers_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/statefull"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	// Force-load native, to trigger registration
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	stateReceiver = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	stateSyncee   = common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+	// stateReceiverCode reads and stores 1 in slot 0, calls the syncee and returns 1
+	stateReceiverCode = common.FromHex("0x60005450600160005560006000600060006000" + "61beef" + "5af150600160005260206000f3")
+)
+
+// stateSyncEngine commits the state syncs of a block in system calls after its
+// transactions, as bor does
+type stateSyncEngine struct {
+	consensus.Engine
+
+	events map[uint64][]*clerk.EventRecordWithTime
+}
+
+// commitStates applies the commits of the state syncs of the block, returning the
+// state syncs and the system calls applied.
+func (e *stateSyncEngine) commitStates(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB) ([]*types.StateSyncData, []types.SystemCall, error) {
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	args := abi.Arguments{{Type: uint256Type}, {Type: bytesType}}
+
+	var (
+		stateSyncs  = make([]*types.StateSyncData, 0, len(e.events[header.Number.Uint64()]))
+		systemCalls []types.SystemCall
+	)
+
+	for _, event := range e.events[header.Number.Uint64()] {
+		record, err := rlp.EncodeToBytes(event.BuildEventRecord())
+		if err != nil {
+			return nil, nil, err
+		}
+
+		packed, err := args.Pack(big.NewInt(event.Time.Unix()), record)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		data := append(crypto.Keccak256([]byte("commitState(uint256,bytes)"))[:4], packed...)
+		msg := statefull.GetSystemMessage(stateReceiver, data)
+
+		if _, err := statefull.ApplyMessage(context.Background(), msg, state, header, chain.Config(), statefull.ChainContext{Chain: chain, Bor: e, SystemCalls: &systemCalls}); err != nil {
+			return nil, nil, err
+		}
+
+		stateSyncs = append(stateSyncs, &types.StateSyncData{ID: event.ID, Contract: event.Contract, TxHash: event.TxHash})
+	}
+
+	return stateSyncs, systemCalls, nil
+}
+
+func (e *stateSyncEngine) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, body *types.Body) {
+	stateSyncs, systemCalls, err := e.commitStates(chain, header, state)
+	if err != nil {
+		panic(err)
+	}
+
+	if syncer, ok := chain.(core.BorStateSyncer); ok {
+		syncer.SetStateSync(stateSyncs)
+		syncer.SetSystemCalls(systemCalls)
+	}
+
+	e.Engine.Finalize(chain, header, state, body)
+}
+
+func (e *stateSyncEngine) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, body *types.Body, receipts []*types.Receipt) (*types.Block, error) {
+	if _, _, err := e.commitStates(chain, header, state); err != nil {
+		return nil, err
+	}
+
+	return e.Engine.FinalizeAndAssemble(chain, header, state, body, receipts)
+}
+
+// stateSyncBackend is a tracers backend over a chain of the stateSyncEngine
+type stateSyncBackend struct {
+	chain   *core.BlockChain
+	engine  *stateSyncEngine
+	genesis *core.Genesis
+
+	traceFilterRange uint64 // Maximum block range of trace_filter, 0 for no limit
+}
+
+func (b *stateSyncBackend) HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	return b.chain.GetHeaderByHash(hash), nil
+}
+
+func (b *stateSyncBackend) HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error) {
+	if number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber {
+		return b.chain.CurrentHeader(), nil
+	}
+
+	return b.chain.GetHeaderByNumber(uint64(number)), nil
+}
+
+func (b *stateSyncBackend) BlockByHash(ctx context.Context, hash common.Hash) (*types.Block, error) {
+	return b.chain.GetBlockByHash(hash), nil
+}
+
+func (b *stateSyncBackend) BlockByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Block, error) {
+	if number == rpc.PendingBlockNumber || number == rpc.LatestBlockNumber {
+		return b.chain.GetBlockByNumber(b.chain.CurrentBlock().Number.Uint64()), nil
+	}
+
+	return b.chain.GetBlockByNumber(uint64(number)), nil
+}
+
+func (b *stateSyncBackend) GetTransaction(ctx context.Context, txHash common.Hash) (bool, *types.Transaction, common.Hash, uint64, uint64, error) {
+	tx, hash, blockNumber, index := rawdb.ReadTransaction(b.chain.DB(), txHash)
+	return tx != nil, tx, hash, blockNumber, index, nil
+}
+
+func (b *stateSyncBackend) RPCGasCap() uint64                { return 25000000 }
+func (b *stateSyncBackend) RPCTraceFilterRange() uint64      { return b.traceFilterRange }
+func (b *stateSyncBackend) ChainConfig() *params.ChainConfig { return b.chain.Config() }
+func (b *stateSyncBackend) Engine() consensus.Engine         { return b.engine }
+func (b *stateSyncBackend) ChainDb() ethdb.Database          { return b.chain.DB() }
+func (b *stateSyncBackend) StateAtBlock(ctx context.Context, block *types.Block, reexec uint64, base *state.StateDB, readOnly bool, preferDisk bool) (*state.StateDB, tracers.StateReleaseFunc, error) {
+	statedb, err := b.chain.StateAt(block.Root())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return statedb, func() {}, nil
+}
+
+// StateAtTransaction only supports the first transaction of a block, which is
+// all the blocks of the test chain have.
+func (b *stateSyncBackend) StateAtTransaction(ctx context.Context, block *types.Block, txIndex int, reexec uint64) (*types.Transaction, vm.BlockContext, *state.StateDB, tracers.StateReleaseFunc, error) {
+	if txIndex != 0 || len(block.Transactions()) == 0 {
+		return nil, vm.BlockContext{}, nil, nil, errors.New("not supported")
+	}
+
+	parent := b.chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, vm.BlockContext{}, nil, nil, errors.New("parent not found")
+	}
+
+	statedb, release, err := b.StateAtBlock(ctx, parent, reexec, nil, true, false)
+	if err != nil {
+		return nil, vm.BlockContext{}, nil, nil, err
+	}
+
+	return block.Transactions()[0], core.NewEVMBlockContext(block.Header(), b.chain, nil), statedb, release, nil
+}
+
+func (b *stateSyncBackend) GetBorBlockTransactionWithBlockHash(ctx context.Context, txHash common.Hash, blockHash common.Hash) (*types.Transaction, common.Hash, uint64, uint64, error) {
+	tx, blockHash, blockNumber, index := rawdb.ReadBorTransactionWithBlockHash(b.chain.DB(), txHash, blockHash)
+	return tx, blockHash, blockNumber, index, nil
+}
+
+func newStateSyncBackend(t *testing.T) *stateSyncBackend {
+	t.Helper()
+
+	var (
+		config = *params.TestChainConfig
+		bor    = *config.Bor
+		key, _ = crypto.GenerateKey()
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+	)
+
+	bor.StateReceiverContract = stateReceiver.Hex()
+	config.Bor = &bor
+
+	engine := &stateSyncEngine{
+		Engine: ethash.NewFaker(),
+		events: map[uint64][]*clerk.EventRecordWithTime{
+			2: {
+				{EventRecord: clerk.EventRecord{ID: 1, Contract: stateSyncee, Data: []byte{1}}, Time: time.Unix(1, 0)},
+				{EventRecord: clerk.EventRecord{ID: 2, Contract: stateSyncee, Data: []byte{2}}, Time: time.Unix(2, 0)},
+			},
+		},
+	}
+
+	gspec := &core.Genesis{
+		Config: &config,
+		Alloc: types.GenesisAlloc{
+			addr:          {Balance: big.NewInt(params.Ether)},
+			stateReceiver: {Balance: common.Big0, Code: stateReceiverCode},
+		},
+	}
+
+	signer := types.LatestSigner(&config)
+
+	_, blocks, _ := core.GenerateChainWithGenesis(gspec, engine, 2, func(i int, b *core.BlockGen) {
+		tx, _ := types.SignNewTx(key, signer, &types.LegacyTx{
+			Nonce:    uint64(i),
+			To:       &stateSyncee,
+			Value:    big.NewInt(1000),
+			Gas:      params.TxGas,
+			GasPrice: b.BaseFee(),
+		})
+		b.AddTx(tx)
+	})
+
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), &core.CacheConfig{TrieDirtyDisabled: true}, gspec, nil, engine, vm.Config{}, nil, nil, nil)
+	require.NoError(t, err)
+
+	t.Cleanup(chain.Stop)
+
+	_, err = chain.InsertChain(blocks)
+	require.NoError(t, err)
+
+	// The state syncs are traced from the system calls stored with the blocks,
+	// they aren't available from the engine anymore
+	engine.events = nil
+
+	return &stateSyncBackend{chain: chain, engine: engine, genesis: gspec}
+}
+
+type stateSyncCallFrame struct {
+	From  common.Address       `json:"from"`
+	To    common.Address       `json:"to"`
+	Calls []stateSyncCallFrame `json:"calls"`
+}
+
+func TestTraceStateSyncs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend = newStateSyncBackend(t)
+		api     = tracers.NewAPI(backend)
+		block   = backend.chain.GetBlockByNumber(2)
+		key     = types.BorReceiptKey(2, block.Hash())
+		hashes  = []common.Hash{types.GetDerivedStateSyncTxHash(key, 1), types.GetDerivedStateSyncTxHash(key, 2)}
+		enabled = true
+	)
+
+	// The state syncs are only traced if enabled
+	callTracer := "callTracer"
+
+	results, err := api.TraceBlockByNumber(context.Background(), 2, &tracers.TraceConfig{Tracer: &callTracer})
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+
+	results, err = api.TraceBlockByNumber(context.Background(), 2, &tracers.TraceConfig{Tracer: &callTracer, BorTraceEnabled: &enabled})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	encoded, err := json.Marshal(results)
+	require.NoError(t, err)
+
+	var traces []struct {
+		TxHash common.Hash        `json:"txHash"`
+		Result stateSyncCallFrame `json:"result"`
+	}
+
+	require.NoError(t, json.Unmarshal(encoded, &traces))
+	require.Equal(t, block.Transactions()[0].Hash(), traces[0].TxHash)
+
+	for i, hash := range hashes {
+		trace := traces[i+1]
+
+		require.Equal(t, hash, trace.TxHash)
+		require.Equal(t, types.SystemAddress, trace.Result.From)
+		require.Equal(t, stateReceiver, trace.Result.To)
+		require.Len(t, trace.Result.Calls, 1)
+		require.Equal(t, stateSyncee, trace.Result.Calls[0].To)
+	}
+
+	// The state syncs are traceable by hash with every tracer
+	for _, name := range []string{"callTracer", "flatCallTracer", "prestateTracer"} {
+		tracer := name
+
+		result, err := api.TraceTransaction(context.Background(), hashes[1], &tracers.TraceConfig{Tracer: &tracer})
+		require.NoError(t, err, tracer)
+		require.NotEmpty(t, result, tracer)
+	}
+
+	// The default struct logger
+	result, err := api.TraceTransaction(context.Background(), hashes[0], nil)
+	require.NoError(t, err)
+	require.Contains(t, string(result.(json.RawMessage)), "SSTORE")
+
+	// The prestate of the second state sync has the slot written by the first one
+	prestate := "prestateTracer"
+
+	result, err = api.TraceTransaction(context.Background(), hashes[1], &tracers.TraceConfig{Tracer: &prestate})
+	require.NoError(t, err)
+
+	var accounts map[common.Address]struct {
+		Storage map[common.Hash]common.Hash `json:"storage"`
+	}
+
+	require.NoError(t, json.Unmarshal(result.(json.RawMessage), &accounts))
+	require.Equal(t, common.BigToHash(common.Big1), accounts[stateReceiver].Storage[common.Hash{}])
+}
+
+func TestTraceStateSyncsWithoutSystemCalls(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend = newStateSyncBackend(t)
+		api     = tracers.NewAPI(backend)
+		block   = backend.chain.GetBlockByNumber(2)
+		key     = types.BorReceiptKey(2, block.Hash())
+		enabled = true
+	)
+
+	// Blocks imported before the system calls were stored, or by snap sync,
+	// only have their bor receipt
+	rawdb.WriteBorReceipt(backend.chain.DB(), block.Hash(), 2, &types.ReceiptForStorage{Status: types.ReceiptStatusSuccessful})
+	rawdb.DeleteSystemCalls(backend.chain.DB(), block.Hash(), 2)
+
+	// The transactions are still traced, the state syncs fail on their own
+	callTracer := "callTracer"
+
+	results, err := api.TraceBlockByNumber(context.Background(), 2, &tracers.TraceConfig{Tracer: &callTracer, BorTraceEnabled: &enabled})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	encoded, err := json.Marshal(results)
+	require.NoError(t, err)
+
+	var traces []struct {
+		TxHash common.Hash     `json:"txHash"`
+		Result json.RawMessage `json:"result"`
+		Error  string          `json:"error"`
+	}
+
+	require.NoError(t, json.Unmarshal(encoded, &traces))
+	require.Equal(t, block.Transactions()[0].Hash(), traces[0].TxHash)
+	require.Empty(t, traces[0].Error)
+	require.NotEmpty(t, traces[0].Result)
+	require.Equal(t, types.GetDerivedBorTxHash(key), traces[1].TxHash)
+	require.NotEmpty(t, traces[1].Error)
+
+	borResult, err := api.TraceBorBlock(&tracers.TraceBlockRequest{Number: 2, Config: &tracers.TraceConfig{BorTraceEnabled: &enabled}})
+	require.NoError(t, err)
+	require.Len(t, borResult.Transactions, 2)
+	require.Empty(t, borResult.Transactions[0].Error)
+	require.NotEmpty(t, borResult.Transactions[1].Error)
+
+	files, err := api.StandardTraceBlockToFile(context.Background(), block.Hash(), &tracers.StdTraceConfig{BorTraceEnabled: &enabled})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	t.Cleanup(func() { os.Remove(files[0]) })
+}
+
+func TestStateSyncIntermediateRoots(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend = newStateSyncBackend(t)
+		api     = tracers.NewAPI(backend)
+		block   = backend.chain.GetBlockByNumber(2)
+		key     = types.BorReceiptKey(2, block.Hash())
+		enabled = true
+	)
+
+	// The state syncs have a root each after the transactions
+	roots, err := api.IntermediateRoots(context.Background(), block.Hash(), nil)
+	require.NoError(t, err)
+	require.Len(t, roots, 1)
+
+	borRoots, err := api.IntermediateRoots(context.Background(), block.Hash(), &tracers.TraceConfig{BorTraceEnabled: &enabled})
+	require.NoError(t, err)
+	require.Len(t, borRoots, 3)
+	require.Equal(t, roots[0], borRoots[0])
+	require.NotEqual(t, borRoots[0], borRoots[1])
+
+	// The state syncs are traced to files like the transactions
+	files, err := api.StandardTraceBlockToFile(context.Background(), block.Hash(), &tracers.StdTraceConfig{
+		TxHash:          types.GetDerivedStateSyncTxHash(key, 2),
+		BorTraceEnabled: &enabled,
+	})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	t.Cleanup(func() { os.Remove(files[0]) })
+
+	files, err = api.StandardTraceBlockToFile(context.Background(), block.Hash(), &tracers.StdTraceConfig{BorTraceEnabled: &enabled})
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+
+	t.Cleanup(func() {
+		for _, file := range files {
+			os.Remove(file)
+		}
+	})
+
+	// The combined bor transaction isn't traced anymore
+	_, err = api.StandardTraceBlockToFile(context.Background(), block.Hash(), &tracers.StdTraceConfig{
+		TxHash:          types.GetDerivedBorTxHash(key),
+		BorTraceEnabled: &enabled,
+	})
+	require.Error(t, err)
+}
+
+func TestStateSyncLookupsReorg(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend = newStateSyncBackend(t)
+		api     = tracers.NewAPI(backend)
+		block   = backend.chain.GetBlockByNumber(2)
+		key     = types.BorReceiptKey(2, block.Hash())
+		hashes  = []common.Hash{types.GetDerivedStateSyncTxHash(key, 1), types.GetDerivedStateSyncTxHash(key, 2)}
+	)
+
+	for _, hash := range hashes {
+		require.NotNil(t, rawdb.ReadStateSyncTxLookupEntry(backend.chain.DB(), hash))
+	}
+
+	// A longer fork without state syncs reorgs the block out
+	_, fork, _ := core.GenerateChainWithGenesis(backend.genesis, backend.engine, 3, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+
+	_, err := backend.chain.InsertChain(fork)
+	require.NoError(t, err)
+	require.Equal(t, fork[2].Hash(), backend.chain.CurrentBlock().Hash())
+
+	for _, hash := range hashes {
+		require.Nil(t, rawdb.ReadStateSyncTxLookupEntry(backend.chain.DB(), hash))
+
+		_, err := api.TraceTransaction(context.Background(), hash, nil)
+		require.Error(t, err)
+	}
+}
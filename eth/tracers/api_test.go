@@ -130,6 +130,10 @@ func (b *testBackend) RPCGasCap() uint64 {
 	return 25000000
 }
 
+func (b *testBackend) RPCTraceFilterRange() uint64 {
+	return 0
+}
+
 func (b *testBackend) RPCRpcReturnDataLimit() uint64 {
 	return 100000
 }
@@ -629,6 +633,9 @@ func TestIOdump(t *testing.T) {
 
 	*ioflag = true
 
+	// the IO dump is written to the given path
+	path := t.TempDir()
+
 	var testSuite = []struct {
 		blockNumber rpc.BlockNumber
 		config      *TraceConfig
@@ -639,6 +646,7 @@ func TestIOdump(t *testing.T) {
 		{
 			config: &TraceConfig{
 				IOFlag: ioflag,
+				Path:   &path,
 			},
 			blockNumber: rpc.BlockNumber(genBlocks),
 			want:        `[{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}},{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}},{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}},{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}},{"result":{"gas":21000,"failed":false,"returnValue":"","structLogs":[]}}]`,
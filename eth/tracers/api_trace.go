@@ -0,0 +1,628 @@
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	// parityTraceType, parityStateDiffType and parityVmTraceType are the
+	// trace types of trace_replayBlockTransactions
+	parityTraceType     = "trace"
+	parityStateDiffType = "stateDiff"
+	parityVmTraceType   = "vmTrace"
+)
+
+var (
+	flatCallTracerName = "flatCallTracer"
+	prestateTracerName = "prestateTracer"
+	parityVmTracerName = "parityVmTracer"
+
+	errInvalidTraceFilterRange  = errors.New("invalid from and to block combination: from > to")
+	errTraceFilterRangeTooLarge = errors.New("block range of trace_filter is too large")
+)
+
+// TraceAPI is the collection of Parity/OpenEthereum style tracing methods, in
+// the trace namespace. The traces are produced by the flatCallTracer and
+// include the state syncs of the blocks.
+type TraceAPI struct {
+	api *API
+}
+
+// NewTraceAPI creates a new API definition for the trace namespace.
+func NewTraceAPI(backend Backend) *TraceAPI {
+	return &TraceAPI{api: NewAPI(backend)}
+}
+
+// flatTraceConfig returns the config of the flat call traces.
+func flatTraceConfig() *TraceConfig {
+	return &TraceConfig{
+		Tracer:          &flatCallTracerName,
+		TracerConfig:    json.RawMessage(`{"convertParityErrors":true}`),
+		BorTraceEnabled: newBoolPtr(true),
+	}
+}
+
+// traceBlock traces the transactions and state syncs of the block. The state
+// syncs of blocks whose system calls weren't stored are skipped, they have no
+// traces but the transactions of the block still do.
+func (api *TraceAPI) traceBlock(ctx context.Context, block *types.Block, config *TraceConfig) ([]*txTraceResult, error) {
+	results, err := api.api.traceBlockCached(ctx, block, config)
+	if err != nil {
+		return nil, err
+	}
+
+	traced := results[:0]
+
+	for _, result := range results {
+		if result.Error == errSystemCallsNotFound.Error() {
+			log.Debug("Skipping traces of state syncs", "block", block.NumberU64(), "hash", result.TxHash, "err", result.Error)
+			continue
+		}
+
+		traced = append(traced, result)
+	}
+
+	return traced, nil
+}
+
+// blockFlatTraces returns the flat call traces of the transactions and state
+// syncs of the block.
+func (api *TraceAPI) blockFlatTraces(ctx context.Context, block *types.Block) ([]json.RawMessage, error) {
+	results, err := api.traceBlock(ctx, block, flatTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	traces := make([]json.RawMessage, 0, len(results))
+
+	for _, result := range results {
+		if result.Error != "" {
+			return nil, fmt.Errorf("tracing failed for %x: %s", result.TxHash, result.Error)
+		}
+
+		frames, err := decodeFlatTraces(result.Result)
+		if err != nil {
+			return nil, err
+		}
+
+		traces = append(traces, frames...)
+	}
+
+	return traces, nil
+}
+
+// decodeFlatTraces splits the result of the flatCallTracer in its frames.
+func decodeFlatTraces(result interface{}) ([]json.RawMessage, error) {
+	raw, ok := result.(json.RawMessage)
+	if !ok {
+		return nil, fmt.Errorf("unexpected trace result %T", result)
+	}
+
+	var frames []json.RawMessage
+	if err := json.Unmarshal(raw, &frames); err != nil {
+		return nil, err
+	}
+
+	return frames, nil
+}
+
+// Block returns the flat call traces of the transactions of a block.
+func (api *TraceAPI) Block(ctx context.Context, number rpc.BlockNumber) ([]json.RawMessage, error) {
+	block, err := api.api.blockByNumber(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.NumberU64() == 0 {
+		return []json.RawMessage{}, nil
+	}
+
+	return api.blockFlatTraces(ctx, block)
+}
+
+// Transaction returns the flat call traces of a transaction.
+func (api *TraceAPI) Transaction(ctx context.Context, hash common.Hash) ([]json.RawMessage, error) {
+	config := flatTraceConfig()
+
+	result, err := api.api.TraceTransaction(ctx, hash, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeFlatTraces(result)
+}
+
+// Get returns the flat call trace of a transaction at the trace address.
+func (api *TraceAPI) Get(ctx context.Context, hash common.Hash, indices []hexutil.Uint64) (json.RawMessage, error) {
+	traces, err := api.Transaction(ctx, hash)
+	if err != nil {
+		return nil, err
+	}
+
+	address := make([]int, 0, len(indices))
+	for _, index := range indices {
+		address = append(address, int(index))
+	}
+
+	for _, trace := range traces {
+		var frame flatTraceFrame
+		if err := json.Unmarshal(trace, &frame); err != nil {
+			return nil, err
+		}
+
+		if slices.Equal(frame.TraceAddress, address) {
+			return trace, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// flatTraceFrame holds the fields of a flat call trace used to filter it.
+type flatTraceFrame struct {
+	Action struct {
+		From          *common.Address `json:"from"`
+		To            *common.Address `json:"to"`
+		Address       *common.Address `json:"address"`
+		RefundAddress *common.Address `json:"refundAddress"`
+	} `json:"action"`
+	Result *struct {
+		Address *common.Address `json:"address"`
+	} `json:"result"`
+	TraceAddress []int `json:"traceAddress"`
+}
+
+// fromTo returns the sender and the recipient of the frame, the created
+// contract for creations and the refunded account for self destructs.
+func (f *flatTraceFrame) fromTo() (from common.Address, to common.Address) {
+	if f.Action.From != nil {
+		from = *f.Action.From
+	} else if f.Action.Address != nil {
+		from = *f.Action.Address
+	}
+
+	switch {
+	case f.Action.To != nil:
+		to = *f.Action.To
+	case f.Action.RefundAddress != nil:
+		to = *f.Action.RefundAddress
+	case f.Result != nil && f.Result.Address != nil:
+		to = *f.Result.Address
+	}
+
+	return from, to
+}
+
+// TraceFilterArgs represents the arguments of trace_filter.
+type TraceFilterArgs struct {
+	FromBlock   *rpc.BlockNumber `json:"fromBlock"`
+	ToBlock     *rpc.BlockNumber `json:"toBlock"`
+	FromAddress []common.Address `json:"fromAddress"`
+	ToAddress   []common.Address `json:"toAddress"`
+	After       *uint64          `json:"after"`
+	Count       *uint64          `json:"count"`
+}
+
+// Filter returns the flat call traces of a block range sent from and to the
+// given addresses. An empty address list matches any address.
+func (api *TraceAPI) Filter(ctx context.Context, args TraceFilterArgs) ([]json.RawMessage, error) {
+	from, err := api.filterBlockNumber(ctx, args.FromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	to, err := api.filterBlockNumber(ctx, args.ToBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if from > to {
+		return nil, errInvalidTraceFilterRange
+	}
+
+	if limit := api.api.backend.RPCTraceFilterRange(); limit != 0 && to-from >= limit {
+		return nil, fmt.Errorf("%w: %d blocks, the limit is %d", errTraceFilterRangeTooLarge, to-from+1, limit)
+	}
+
+	var (
+		after   uint64
+		traces  = []json.RawMessage{}
+		matches = func(addresses []common.Address, address common.Address) bool {
+			return len(addresses) == 0 || slices.Contains(addresses, address)
+		}
+	)
+
+	if args.After != nil {
+		after = *args.After
+	}
+
+	if args.Count != nil && *args.Count == 0 {
+		return traces, nil
+	}
+
+	for number := from; number <= to; number++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if number == 0 {
+			continue
+		}
+
+		block, err := api.api.blockByNumber(ctx, rpc.BlockNumber(number))
+		if err != nil {
+			return nil, err
+		}
+
+		blockTraces, err := api.blockFlatTraces(ctx, block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, trace := range blockTraces {
+			var frame flatTraceFrame
+			if err := json.Unmarshal(trace, &frame); err != nil {
+				return nil, err
+			}
+
+			sender, recipient := frame.fromTo()
+			if !matches(args.FromAddress, sender) || !matches(args.ToAddress, recipient) {
+				continue
+			}
+
+			if after > 0 {
+				after--
+				continue
+			}
+
+			traces = append(traces, trace)
+
+			if args.Count != nil && uint64(len(traces)) == *args.Count {
+				return traces, nil
+			}
+		}
+	}
+
+	return traces, nil
+}
+
+// filterBlockNumber resolves a block number of trace_filter, which defaults to
+// the latest block.
+func (api *TraceAPI) filterBlockNumber(ctx context.Context, number *rpc.BlockNumber) (uint64, error) {
+	if number != nil && *number >= 0 {
+		return uint64(*number), nil
+	}
+
+	latest := rpc.LatestBlockNumber
+	if number != nil {
+		latest = *number
+	}
+
+	header, err := api.api.backend.HeaderByNumber(ctx, latest)
+	if err != nil {
+		return 0, err
+	}
+
+	if header == nil {
+		return 0, fmt.Errorf("block #%d not found", latest)
+	}
+
+	return header.Number.Uint64(), nil
+}
+
+// TraceReplayResult is the result of replaying a transaction with
+// trace_replayBlockTransactions. The trace types which weren't requested are
+// null.
+type TraceReplayResult struct {
+	Output          hexutil.Bytes                        `json:"output"`
+	StateDiff       map[common.Address]*StateDiffAccount `json:"stateDiff"`
+	Trace           []json.RawMessage                    `json:"trace"`
+	VmTrace         json.RawMessage                      `json:"vmTrace"`
+	TransactionHash common.Hash                          `json:"transactionHash"`
+}
+
+// ReplayBlockTransactions replays the transactions and state syncs of a block
+// and returns the requested trace types of each of them: the flat call traces
+// ("trace"), the state changes ("stateDiff") and the executed ops ("vmTrace").
+func (api *TraceAPI) ReplayBlockTransactions(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash, traceTypes []string) ([]*TraceReplayResult, error) {
+	var withTrace, withStateDiff, withVmTrace bool
+
+	for _, traceType := range traceTypes {
+		switch traceType {
+		case parityTraceType:
+			withTrace = true
+		case parityStateDiffType:
+			withStateDiff = true
+		case parityVmTraceType:
+			withVmTrace = true
+		default:
+			return nil, fmt.Errorf("invalid trace type %q", traceType)
+		}
+	}
+
+	block, err := api.blockByNumberOrHash(ctx, blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.NumberU64() == 0 {
+		return []*TraceReplayResult{}, nil
+	}
+
+	// The flat call traces hold the outputs, so they are always needed
+	flatResults, err := api.traceBlock(ctx, block, flatTraceConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	replays := make([]*TraceReplayResult, 0, len(flatResults))
+
+	for _, result := range flatResults {
+		if result.Error != "" {
+			return nil, fmt.Errorf("tracing failed for %x: %s", result.TxHash, result.Error)
+		}
+
+		frames, err := decodeFlatTraces(result.Result)
+		if err != nil {
+			return nil, err
+		}
+
+		replay := &TraceReplayResult{
+			Output:          hexutil.Bytes{},
+			TransactionHash: result.TxHash,
+		}
+
+		if len(frames) > 0 {
+			var top struct {
+				Result *struct {
+					Output hexutil.Bytes `json:"output"`
+				} `json:"result"`
+			}
+
+			if err := json.Unmarshal(frames[0], &top); err != nil {
+				return nil, err
+			}
+
+			if top.Result != nil && top.Result.Output != nil {
+				replay.Output = top.Result.Output
+			}
+		}
+
+		if withTrace {
+			replay.Trace = frames
+		}
+
+		replays = append(replays, replay)
+	}
+
+	if withStateDiff {
+		results, err := api.traceBlock(ctx, block, &TraceConfig{
+			Tracer:          &prestateTracerName,
+			TracerConfig:    json.RawMessage(`{"diffMode":true}`),
+			BorTraceEnabled: newBoolPtr(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(results) != len(replays) {
+			return nil, errors.New("mismatching state diff traces")
+		}
+
+		for i, result := range results {
+			if result.Error != "" {
+				return nil, fmt.Errorf("tracing failed for %x: %s", result.TxHash, result.Error)
+			}
+
+			raw, ok := result.Result.(json.RawMessage)
+			if !ok {
+				return nil, fmt.Errorf("unexpected trace result %T", result.Result)
+			}
+
+			if replays[i].StateDiff, err = newStateDiff(raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if withVmTrace {
+		results, err := api.traceBlock(ctx, block, &TraceConfig{
+			Tracer:          &parityVmTracerName,
+			BorTraceEnabled: newBoolPtr(true),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(results) != len(replays) {
+			return nil, errors.New("mismatching vm traces")
+		}
+
+		for i, result := range results {
+			if result.Error != "" {
+				return nil, fmt.Errorf("tracing failed for %x: %s", result.TxHash, result.Error)
+			}
+
+			raw, ok := result.Result.(json.RawMessage)
+			if !ok {
+				return nil, fmt.Errorf("unexpected trace result %T", result.Result)
+			}
+
+			replays[i].VmTrace = raw
+		}
+	}
+
+	return replays, nil
+}
+
+// blockByNumberOrHash returns the block of the number or hash.
+func (api *TraceAPI) blockByNumberOrHash(ctx context.Context, blockNrOrHash rpc.BlockNumberOrHash) (*types.Block, error) {
+	if hash, ok := blockNrOrHash.Hash(); ok {
+		return api.api.blockByHash(ctx, hash)
+	}
+
+	if number, ok := blockNrOrHash.Number(); ok {
+		return api.api.blockByNumber(ctx, number)
+	}
+
+	return nil, errors.New("invalid arguments; neither block nor hash specified")
+}
+
+// StateDiffAccount is the change of an account in the Parity/OpenEthereum
+// stateDiff format. Each field is "=" if unchanged, {"+": value} if the
+// account was created, {"-": value} if it was deleted or
+// {"*": {"from": value, "to": value}} if it was modified.
+type StateDiffAccount struct {
+	Balance interface{}                 `json:"balance"`
+	Code    interface{}                 `json:"code"`
+	Nonce   interface{}                 `json:"nonce"`
+	Storage map[common.Hash]interface{} `json:"storage"`
+}
+
+// stateDiffChange is a modified value of a stateDiff.
+type stateDiffChange struct {
+	From interface{} `json:"from"`
+	To   interface{} `json:"to"`
+}
+
+// stateDiffValue returns the stateDiff of a value.
+func stateDiffValue(existed, exists bool, from, to interface{}, changed bool) interface{} {
+	switch {
+	case !existed:
+		return map[string]interface{}{"+": to}
+	case !exists:
+		return map[string]interface{}{"-": from}
+	case changed:
+		return map[string]interface{}{"*": stateDiffChange{From: from, To: to}}
+	default:
+		return "="
+	}
+}
+
+// prestateAccount is an account reported by the prestateTracer.
+type prestateAccount struct {
+	Balance *hexutil.Big                `json:"balance"`
+	Code    hexutil.Bytes               `json:"code"`
+	Nonce   uint64                      `json:"nonce"`
+	Storage map[common.Hash]common.Hash `json:"storage"`
+}
+
+func (a *prestateAccount) empty() bool {
+	return a.Nonce == 0 && len(a.Code) == 0 && len(a.Storage) == 0 && (a.Balance == nil || a.Balance.ToInt().Sign() == 0)
+}
+
+// newStateDiff converts the result of the prestateTracer in diff mode to the
+// Parity/OpenEthereum stateDiff format. The accounts missing from the pre
+// state or empty in it are created, the ones missing from the post state are
+// deleted, and the post state only holds the modified fields of the others.
+func newStateDiff(result json.RawMessage) (map[common.Address]*StateDiffAccount, error) {
+	var diff struct {
+		Pre  map[common.Address]*prestateAccount `json:"pre"`
+		Post map[common.Address]*prestateAccount `json:"post"`
+	}
+
+	if err := json.Unmarshal(result, &diff); err != nil {
+		return nil, err
+	}
+
+	stateDiff := make(map[common.Address]*StateDiffAccount)
+
+	addresses := make(map[common.Address]struct{})
+	for addr := range diff.Pre {
+		addresses[addr] = struct{}{}
+	}
+
+	for addr := range diff.Post {
+		addresses[addr] = struct{}{}
+	}
+
+	for addr := range addresses {
+		var (
+			pre, preOk   = diff.Pre[addr]
+			post, postOk = diff.Post[addr]
+			existed      = preOk && !pre.empty()
+			exists       = postOk
+		)
+
+		if !preOk {
+			pre = &prestateAccount{}
+		}
+
+		if !postOk {
+			post = &prestateAccount{}
+		}
+
+		var (
+			preBalance  = (*hexutil.Big)(new(big.Int))
+			postBalance = preBalance
+			preNonce    = hexutil.Uint64(pre.Nonce)
+			postNonce   = preNonce
+			preCode     = pre.Code
+			postCode    = preCode
+		)
+
+		if pre.Balance != nil {
+			preBalance, postBalance = pre.Balance, pre.Balance
+		}
+
+		if post.Balance != nil {
+			postBalance = post.Balance
+		}
+
+		if post.Nonce != 0 {
+			postNonce = hexutil.Uint64(post.Nonce)
+		}
+
+		if len(post.Code) > 0 {
+			postCode = post.Code
+		}
+
+		if preCode == nil {
+			preCode = hexutil.Bytes{}
+		}
+
+		if postCode == nil {
+			postCode = hexutil.Bytes{}
+		}
+
+		account := &StateDiffAccount{
+			Balance: stateDiffValue(existed, exists, preBalance, postBalance, post.Balance != nil),
+			Code:    stateDiffValue(existed, exists, preCode, postCode, len(post.Code) > 0),
+			Nonce:   stateDiffValue(existed, exists, preNonce, postNonce, post.Nonce != 0),
+			Storage: make(map[common.Hash]interface{}),
+		}
+
+		// The slots cleared are missing from the post state of modified accounts
+		for slot, value := range pre.Storage {
+			newValue, ok := post.Storage[slot]
+			if !ok {
+				newValue = common.Hash{}
+			}
+
+			account.Storage[slot] = stateDiffValue(existed, exists, value, newValue, value != newValue)
+		}
+
+		for slot, value := range post.Storage {
+			if _, ok := pre.Storage[slot]; ok {
+				continue
+			}
+
+			account.Storage[slot] = stateDiffValue(existed, exists, common.Hash{}, value, true)
+		}
+
+		stateDiff[addr] = account
+	}
+
+	return stateDiff, nil
+}
@@ -0,0 +1,229 @@
+package tracers_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/stretchr/testify/require"
+)
+
+type parityTrace struct {
+	Action struct {
+		From *common.Address `json:"from"`
+		To   *common.Address `json:"to"`
+	} `json:"action"`
+	TraceAddress    []int       `json:"traceAddress"`
+	TransactionHash common.Hash `json:"transactionHash"`
+	Type            string      `json:"type"`
+}
+
+func decodeParityTraces(t *testing.T, raws []json.RawMessage) []parityTrace {
+	t.Helper()
+
+	traces := make([]parityTrace, 0, len(raws))
+
+	for _, raw := range raws {
+		var trace parityTrace
+		require.NoError(t, json.Unmarshal(raw, &trace))
+
+		traces = append(traces, trace)
+	}
+
+	return traces
+}
+
+func TestTraceAPI(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend    = newStateSyncBackend(t)
+		api        = tracers.NewTraceAPI(backend)
+		block      = backend.chain.GetBlockByNumber(2)
+		key        = types.BorReceiptKey(2, block.Hash())
+		txHash     = block.Transactions()[0].Hash()
+		stateSyncs = []common.Hash{types.GetDerivedStateSyncTxHash(key, 1), types.GetDerivedStateSyncTxHash(key, 2)}
+		ctx        = context.Background()
+	)
+
+	// The block traces include the state syncs and their subcalls
+	raws, err := api.Block(ctx, 2)
+	require.NoError(t, err)
+
+	traces := decodeParityTraces(t, raws)
+	require.Len(t, traces, 5)
+	require.Equal(t, txHash, traces[0].TransactionHash)
+
+	for i, hash := range stateSyncs {
+		top, sub := traces[1+2*i], traces[2+2*i]
+
+		require.Equal(t, hash, top.TransactionHash)
+		require.Equal(t, types.SystemAddress, *top.Action.From)
+		require.Equal(t, stateReceiver, *top.Action.To)
+		require.Empty(t, top.TraceAddress)
+		require.Equal(t, []int{0}, sub.TraceAddress)
+		require.Equal(t, stateSyncee, *sub.Action.To)
+	}
+
+	// Transactions and state syncs are traced by hash
+	raws, err = api.Transaction(ctx, txHash)
+	require.NoError(t, err)
+	require.Len(t, raws, 1)
+
+	raw, err := api.Get(ctx, stateSyncs[1], []hexutil.Uint64{0})
+	require.NoError(t, err)
+
+	traces = decodeParityTraces(t, []json.RawMessage{raw})
+	require.Equal(t, stateReceiver, *traces[0].Action.From)
+	require.Equal(t, stateSyncee, *traces[0].Action.To)
+
+	raw, err = api.Get(ctx, stateSyncs[1], []hexutil.Uint64{1})
+	require.NoError(t, err)
+	require.Nil(t, raw)
+
+	// The traces of a range are filtered by sender and recipient
+	from, to := rpc.BlockNumber(1), rpc.BlockNumber(2)
+
+	raws, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &from, ToBlock: &to, ToAddress: []common.Address{stateSyncee}})
+	require.NoError(t, err)
+	require.Len(t, raws, 4)
+
+	raws, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &from, ToBlock: &to, FromAddress: []common.Address{types.SystemAddress}})
+	require.NoError(t, err)
+	require.Len(t, raws, 2)
+
+	after, count := uint64(1), uint64(2)
+
+	raws, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &from, ToAddress: []common.Address{stateSyncee}, After: &after, Count: &count})
+	require.NoError(t, err)
+
+	traces = decodeParityTraces(t, raws)
+	require.Len(t, traces, 2)
+	require.Equal(t, txHash, traces[0].TransactionHash)
+	require.Equal(t, stateSyncs[0], traces[1].TransactionHash)
+
+	_, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &to, ToBlock: &from})
+	require.Error(t, err)
+
+	// The ranges larger than the limit are rejected
+	backend.traceFilterRange = 1
+
+	_, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &from, ToBlock: &to})
+	require.Error(t, err)
+
+	raws, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &to, ToBlock: &to, ToAddress: []common.Address{stateSyncee}})
+	require.NoError(t, err)
+	require.Len(t, raws, 3)
+
+	backend.traceFilterRange = 0
+
+	// The transactions are replayed with the requested trace types
+	replays, err := api.ReplayBlockTransactions(ctx, rpc.BlockNumberOrHashWithNumber(2), []string{"trace"})
+	require.NoError(t, err)
+	require.Len(t, replays, 3)
+	require.Len(t, replays[1].Trace, 2)
+	require.Nil(t, replays[1].StateDiff)
+	require.Nil(t, replays[1].VmTrace)
+	require.Equal(t, hexutil.Bytes(common.LeftPadBytes([]byte{1}, 32)), replays[1].Output)
+
+	replays, err = api.ReplayBlockTransactions(ctx, rpc.BlockNumberOrHashWithHash(block.Hash(), false), []string{"stateDiff", "vmTrace"})
+	require.NoError(t, err)
+	require.Len(t, replays, 3)
+	require.Nil(t, replays[0].Trace)
+	require.Equal(t, stateSyncs[0], replays[1].TransactionHash)
+
+	encoded, err := json.Marshal(replays[1].StateDiff[stateReceiver])
+	require.NoError(t, err)
+	require.JSONEq(t, `{
+		"balance": "=",
+		"code": "=",
+		"nonce": "=",
+		"storage": {
+			"0x0000000000000000000000000000000000000000000000000000000000000000": {
+				"*": {
+					"from": "0x0000000000000000000000000000000000000000000000000000000000000000",
+					"to": "0x0000000000000000000000000000000000000000000000000000000000000001"
+				}
+			}
+		}
+	}`, string(encoded))
+
+	var vmTrace struct {
+		Code hexutil.Bytes `json:"code"`
+		Ops  []struct {
+			PC uint64 `json:"pc"`
+			Ex struct {
+				Push  []string `json:"push"`
+				Store *struct {
+					Key string `json:"key"`
+					Val string `json:"val"`
+				} `json:"store"`
+			} `json:"ex"`
+		} `json:"ops"`
+	}
+
+	require.NoError(t, json.Unmarshal(replays[1].VmTrace, &vmTrace))
+	require.Equal(t, hexutil.Bytes(stateReceiverCode), vmTrace.Code)
+	require.Equal(t, []string{"0x0"}, vmTrace.Ops[0].Ex.Push)
+
+	var stores int
+
+	for _, op := range vmTrace.Ops {
+		if op.Ex.Store != nil {
+			require.Equal(t, "0x0", op.Ex.Store.Key)
+			require.Equal(t, "0x1", op.Ex.Store.Val)
+
+			stores++
+		}
+	}
+
+	require.Equal(t, 1, stores)
+
+	_, err = api.ReplayBlockTransactions(ctx, rpc.BlockNumberOrHashWithNumber(2), []string{"unknown"})
+	require.Error(t, err)
+}
+
+func TestTraceAPIWithoutSystemCalls(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend = newStateSyncBackend(t)
+		api     = tracers.NewTraceAPI(backend)
+		block   = backend.chain.GetBlockByNumber(2)
+		txHash  = block.Transactions()[0].Hash()
+		ctx     = context.Background()
+	)
+
+	// Blocks imported before the system calls were stored, or by snap sync,
+	// only have their bor receipt
+	rawdb.WriteBorReceipt(backend.chain.DB(), block.Hash(), 2, &types.ReceiptForStorage{Status: types.ReceiptStatusSuccessful})
+	rawdb.DeleteSystemCalls(backend.chain.DB(), block.Hash(), 2)
+
+	// The transactions are traced without the state syncs
+	raws, err := api.Block(ctx, 2)
+	require.NoError(t, err)
+
+	traces := decodeParityTraces(t, raws)
+	require.Len(t, traces, 1)
+	require.Equal(t, txHash, traces[0].TransactionHash)
+
+	from, to := rpc.BlockNumber(1), rpc.BlockNumber(2)
+
+	raws, err = api.Filter(ctx, tracers.TraceFilterArgs{FromBlock: &from, ToBlock: &to, ToAddress: []common.Address{stateSyncee}})
+	require.NoError(t, err)
+	require.Len(t, raws, 2)
+
+	replays, err := api.ReplayBlockTransactions(ctx, rpc.BlockNumberOrHashWithNumber(2), []string{"trace", "stateDiff", "vmTrace"})
+	require.NoError(t, err)
+	require.Len(t, replays, 1)
+	require.Equal(t, txHash, replays[0].TransactionHash)
+	require.NotNil(t, replays[0].StateDiff)
+	require.NotNil(t, replays[0].VmTrace)
+}
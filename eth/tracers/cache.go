@@ -0,0 +1,293 @@
+package tracers
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers/logger"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var (
+	traceCacheHitMeter   = metrics.NewRegisteredMeter("tracers/cache/hit", nil)
+	traceCacheMissMeter  = metrics.NewRegisteredMeter("tracers/cache/miss", nil)
+	traceCacheEvictMeter = metrics.NewRegisteredMeter("tracers/cache/evict", nil)
+	traceCacheSizeGauge  = metrics.NewRegisteredGauge("tracers/cache/size", nil)
+)
+
+var (
+	traceCacheEntryPrefix = []byte("e") // traceCacheEntryPrefix + block hash + tx index (uint64 big endian) + config hash -> result
+	traceCacheOrderPrefix = []byte("o") // traceCacheOrderPrefix + sequence (uint64 big endian) -> entry key
+	traceCacheMetaKey     = []byte("TraceCacheMeta")
+)
+
+// traceCacheMeta tracks the entries of the cache, which are evicted in the
+// order they were added.
+type traceCacheMeta struct {
+	Size uint64 // Total size of the entries
+	Head uint64 // Sequence of the next entry
+	Tail uint64 // Sequence of the oldest entry
+}
+
+// TraceCache stores the results of transaction traces in a separate database,
+// so that tracing a transaction again doesn't re-execute its block. Results are
+// keyed by block hash, so they remain valid across reorgs. The oldest results
+// are evicted once the cache grows beyond its size limit.
+type TraceCache struct {
+	db    ethdb.KeyValueStore
+	limit uint64
+
+	meta traceCacheMeta
+	lock sync.Mutex
+}
+
+// NewTraceCache creates a trace cache over the given database, holding up to
+// limit bytes of results.
+func NewTraceCache(db ethdb.KeyValueStore, limit uint64) *TraceCache {
+	c := &TraceCache{
+		db:    db,
+		limit: limit,
+	}
+
+	if data, _ := db.Get(traceCacheMetaKey); len(data) > 0 {
+		if err := json.Unmarshal(data, &c.meta); err != nil {
+			log.Warn("Failed to decode trace cache metadata, resetting", "err", err)
+			c.meta = traceCacheMeta{}
+		}
+	}
+
+	traceCacheSizeGauge.Update(int64(c.meta.Size))
+
+	return c
+}
+
+// traceCacheConfig is the part of a trace config which changes trace results.
+type traceCacheConfig struct {
+	Tracer       *string         `json:"tracer,omitempty"`
+	Config       *logger.Config  `json:"config,omitempty"`
+	TracerConfig json.RawMessage `json:"tracerConfig,omitempty"`
+}
+
+// traceConfigHash returns the hash identifying the results of a trace config.
+func traceConfigHash(config *TraceConfig) common.Hash {
+	if config == nil {
+		config = &TraceConfig{}
+	}
+
+	cacheConfig := traceCacheConfig{
+		Tracer: config.Tracer,
+		Config: config.Config,
+	}
+
+	// Equivalent tracer configs differing in whitespace share results
+	if len(config.TracerConfig) > 0 {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, config.TracerConfig); err == nil {
+			cacheConfig.TracerConfig = compact.Bytes()
+		} else {
+			cacheConfig.TracerConfig = config.TracerConfig
+		}
+	}
+
+	data, _ := json.Marshal(cacheConfig)
+
+	return crypto.Keccak256Hash(data)
+}
+
+func traceCacheEntryKey(blockHash common.Hash, txIndex uint64, configHash common.Hash) []byte {
+	key := make([]byte, 0, len(traceCacheEntryPrefix)+2*common.HashLength+8)
+	key = append(key, traceCacheEntryPrefix...)
+	key = append(key, blockHash.Bytes()...)
+	key = binary.BigEndian.AppendUint64(key, txIndex)
+
+	return append(key, configHash.Bytes()...)
+}
+
+func traceCacheOrderKey(seq uint64) []byte {
+	return binary.BigEndian.AppendUint64(common.CopyBytes(traceCacheOrderPrefix), seq)
+}
+
+// Get returns the cached result of tracing the transaction at the index of the
+// block with the config, or nil if there is none.
+func (c *TraceCache) Get(blockHash common.Hash, txIndex uint64, config *TraceConfig) json.RawMessage {
+	data, _ := c.db.Get(traceCacheEntryKey(blockHash, txIndex, traceConfigHash(config)))
+	if len(data) == 0 {
+		traceCacheMissMeter.Mark(1)
+		return nil
+	}
+
+	traceCacheHitMeter.Mark(1)
+
+	return data
+}
+
+// Put stores the result of tracing the transaction at the index of the block
+// with the config, evicting the oldest results if the cache is full.
+func (c *TraceCache) Put(blockHash common.Hash, txIndex uint64, config *TraceConfig, result json.RawMessage) {
+	// Results larger than the whole cache would evict everything else
+	key := traceCacheEntryKey(blockHash, txIndex, traceConfigHash(config))
+	if len(result) == 0 || uint64(len(key)+len(result)) > c.limit {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if ok, _ := c.db.Has(key); ok {
+		return
+	}
+
+	batch := c.db.NewBatch()
+
+	if err := batch.Put(key, result); err != nil {
+		log.Warn("Failed to cache trace result", "err", err)
+		return
+	}
+
+	if err := batch.Put(traceCacheOrderKey(c.meta.Head), key); err != nil {
+		log.Warn("Failed to cache trace result", "err", err)
+		return
+	}
+
+	meta := c.meta
+	meta.Head++
+	meta.Size += uint64(len(key) + len(result))
+
+	// Evict the oldest entries until the new one fits
+	for meta.Size > c.limit && meta.Tail < meta.Head-1 {
+		orderKey := traceCacheOrderKey(meta.Tail)
+
+		entryKey, _ := c.db.Get(orderKey)
+		if len(entryKey) > 0 {
+			entry, _ := c.db.Get(entryKey)
+			meta.Size -= min(meta.Size, uint64(len(entryKey)+len(entry)))
+
+			if err := batch.Delete(entryKey); err != nil {
+				log.Warn("Failed to evict trace result", "err", err)
+				return
+			}
+
+			traceCacheEvictMeter.Mark(1)
+		}
+
+		if err := batch.Delete(orderKey); err != nil {
+			log.Warn("Failed to evict trace result", "err", err)
+			return
+		}
+
+		meta.Tail++
+	}
+
+	data, _ := json.Marshal(meta)
+	if err := batch.Put(traceCacheMetaKey, data); err != nil {
+		log.Warn("Failed to cache trace result", "err", err)
+		return
+	}
+
+	if err := batch.Write(); err != nil {
+		log.Warn("Failed to cache trace result", "err", err)
+		return
+	}
+
+	c.meta = meta
+
+	traceCacheSizeGauge.Update(int64(meta.Size))
+}
+
+// traceCacheBackend is implemented by the backends which cache trace results.
+type traceCacheBackend interface {
+	TraceCache() *TraceCache
+}
+
+// traceCache returns the trace cache of the backend, if any.
+func (api *API) traceCache() *TraceCache {
+	if b, ok := api.backend.(traceCacheBackend); ok {
+		return b.TraceCache()
+	}
+
+	return nil
+}
+
+// traceBlockCached is traceBlock serving the results of the transactions from
+// the trace cache when they are all cached, and caching them otherwise. The
+// state syncs of a block are not cached, so blocks with state syncs are traced
+// if they are requested.
+func (api *API) traceBlockCached(ctx context.Context, block *types.Block, config *TraceConfig) ([]*txTraceResult, error) {
+	cache := api.traceCache()
+	if cache == nil || block.NumberU64() == 0 {
+		return api.traceBlock(ctx, block, config)
+	}
+
+	txs := block.Transactions()
+
+	withStateSyncs := config.BorTraceEnabled != nil && *config.BorTraceEnabled &&
+		rawdb.ReadBorTxLookupEntry(api.backend.ChainDb(), types.GetDerivedBorTxHash(types.BorReceiptKey(block.NumberU64(), block.Hash()))) != nil
+
+	if !withStateSyncs {
+		results := make([]*txTraceResult, 0, len(txs))
+
+		for i, tx := range txs {
+			result := cache.Get(block.Hash(), uint64(i), config)
+			if result == nil {
+				break
+			}
+
+			results = append(results, &txTraceResult{TxHash: tx.Hash(), Result: result})
+		}
+
+		if len(results) == len(txs) {
+			return results, nil
+		}
+	}
+
+	results, err := api.traceBlock(ctx, block, config)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(txs) && i < len(results); i++ {
+		if raw, ok := results[i].Result.(json.RawMessage); ok && results[i].Error == "" {
+			cache.Put(block.Hash(), uint64(i), config, raw)
+		}
+	}
+
+	return results, nil
+}
+
+// PrefillTraceCache traces the transactions of the block with each of the
+// tracers and caches the results, so that they are available before they are
+// requested.
+func (api *API) PrefillTraceCache(ctx context.Context, block *types.Block, tracers []string) {
+	cache := api.traceCache()
+	if cache == nil || block.NumberU64() == 0 || len(block.Transactions()) == 0 {
+		return
+	}
+
+	for _, name := range tracers {
+		var (
+			tracer = name
+			config = &TraceConfig{Tracer: &tracer, BorTraceEnabled: newBoolPtr(false)}
+		)
+
+		results, err := api.traceBlock(ctx, block, config)
+		if err != nil {
+			log.Debug("Failed to prefill trace cache", "number", block.NumberU64(), "hash", block.Hash(), "tracer", name, "err", err)
+			continue
+		}
+
+		for i, result := range results {
+			if raw, ok := result.Result.(json.RawMessage); ok && result.Error == "" {
+				cache.Put(block.Hash(), uint64(i), config, raw)
+			}
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package tracers
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	// txHashTracer returns the hash of the traced transaction
+	DefaultDirectory.Register("txHashTracer", func(ctx *Context, cfg json.RawMessage) (*Tracer, error) {
+		return &Tracer{
+			Hooks: &tracing.Hooks{},
+			GetResult: func() (json.RawMessage, error) {
+				return json.Marshal(ctx.TxHash)
+			},
+			Stop: func(err error) {},
+		}, nil
+	}, false)
+}
+
+func TestTraceCache(t *testing.T) {
+	t.Parallel()
+
+	var (
+		db        = rawdb.NewMemoryDatabase()
+		cache     = NewTraceCache(db, 300)
+		blockHash = common.Hash{1}
+		tracer    = "callTracer"
+		config    = &TraceConfig{Tracer: &tracer, TracerConfig: json.RawMessage(`{"onlyTopCall": true}`)}
+		compact   = &TraceConfig{Tracer: &tracer, TracerConfig: json.RawMessage(`{"onlyTopCall":true}`)}
+		result    = json.RawMessage(`"` + string(make([]byte, 30)) + `"`)
+	)
+
+	require.Nil(t, cache.Get(blockHash, 0, config))
+
+	cache.Put(blockHash, 0, config, result)
+	require.Equal(t, result, cache.Get(blockHash, 0, config))
+
+	// Equivalent configs share results, others don't
+	require.Equal(t, result, cache.Get(blockHash, 0, compact))
+	require.Nil(t, cache.Get(blockHash, 0, nil))
+	require.Nil(t, cache.Get(blockHash, 1, config))
+	require.Nil(t, cache.Get(common.Hash{2}, 0, config))
+
+	// Each entry takes 105 bytes, the oldest are evicted beyond two of them
+	cache.Put(blockHash, 1, config, result)
+	cache.Put(blockHash, 2, config, result)
+
+	require.Nil(t, cache.Get(blockHash, 0, config))
+	require.Equal(t, result, cache.Get(blockHash, 1, config))
+	require.Equal(t, result, cache.Get(blockHash, 2, config))
+
+	// The cache is restored from its database
+	cache = NewTraceCache(db, 300)
+	cache.Put(blockHash, 3, config, result)
+
+	require.Nil(t, cache.Get(blockHash, 1, config))
+	require.Equal(t, result, cache.Get(blockHash, 2, config))
+	require.Equal(t, result, cache.Get(blockHash, 3, config))
+	require.Equal(t, uint64(210), cache.meta.Size)
+
+	// Results larger than the cache are not stored
+	cache.Put(blockHash, 4, config, make(json.RawMessage, 300))
+	require.Nil(t, cache.Get(blockHash, 4, config))
+	require.Equal(t, result, cache.Get(blockHash, 3, config))
+}
+
+// cachedTestBackend is a test backend with a trace cache
+type cachedTestBackend struct {
+	*testBackend
+	cache *TraceCache
+}
+
+func (b *cachedTestBackend) TraceCache() *TraceCache {
+	return b.cache
+}
+
+func TestTraceTransactionCache(t *testing.T) {
+	t.Parallel()
+
+	accounts := newAccounts(2)
+	genesis := &core.Genesis{
+		Config: params.TestChainConfig,
+		Alloc: types.GenesisAlloc{
+			accounts[0].addr: {Balance: big.NewInt(params.Ether)},
+		},
+	}
+	signer := types.HomesteadSigner{}
+	hashes := make([]common.Hash, 0, 2)
+	backend := newTestBackend(t, 1, genesis, func(i int, b *core.BlockGen) {
+		for j := 0; j < 2; j++ {
+			tx, _ := types.SignTx(types.NewTx(&types.LegacyTx{
+				Nonce:    uint64(j),
+				To:       &accounts[1].addr,
+				Value:    big.NewInt(1000),
+				Gas:      params.TxGas,
+				GasPrice: b.BaseFee(),
+			}), signer, accounts[0].key)
+			b.AddTx(tx)
+			hashes = append(hashes, tx.Hash())
+		}
+	})
+
+	defer backend.chain.Stop()
+
+	var (
+		cache  = NewTraceCache(rawdb.NewMemoryDatabase(), 1024*1024)
+		api    = NewAPI(&cachedTestBackend{testBackend: backend, cache: cache})
+		block  = backend.chain.GetBlockByNumber(1)
+		tracer = "txHashTracer"
+		config = &TraceConfig{Tracer: &tracer}
+	)
+
+	// The new blocks are traced ahead of the requests
+	api.PrefillTraceCache(context.Background(), block, []string{tracer})
+
+	for i, hash := range hashes {
+		want, _ := json.Marshal(hash)
+		require.JSONEq(t, string(want), string(cache.Get(block.Hash(), uint64(i), config)))
+	}
+
+	// The cached results are served without tracing
+	cache.Put(block.Hash(), 0, &TraceConfig{}, json.RawMessage(`"cached"`))
+
+	result, err := api.TraceTransaction(context.Background(), hashes[0], nil)
+	require.NoError(t, err)
+	require.Equal(t, json.RawMessage(`"cached"`), result)
+
+	// The traced results are cached
+	result, err = api.TraceTransaction(context.Background(), hashes[1], nil)
+	require.NoError(t, err)
+	require.Equal(t, result, cache.Get(block.Hash(), 1, nil))
+}
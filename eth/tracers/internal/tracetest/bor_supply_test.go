@@ -0,0 +1,352 @@
+package tracetest
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+type borSupplyInfoIssuance struct {
+	GenesisAlloc *hexutil.Big `json:"genesisAlloc,omitempty"`
+	StateSync    *hexutil.Big `json:"stateSync,omitempty"`
+	MRC20        *hexutil.Big `json:"mrc20,omitempty"`
+	Reward       *hexutil.Big `json:"reward,omitempty"`
+}
+
+type borSupplyInfoBurn struct {
+	BurntContract *hexutil.Big `json:"burntContract,omitempty"`
+	MRC20         *hexutil.Big `json:"mrc20,omitempty"`
+	Misc          *hexutil.Big `json:"misc,omitempty"`
+}
+
+type borSupplyInfo struct {
+	Issuance *borSupplyInfoIssuance `json:"issuance,omitempty"`
+	Burn     *borSupplyInfoBurn     `json:"burn,omitempty"`
+
+	// Block info
+	Number     uint64      `json:"blockNumber"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+}
+
+var (
+	borSupplyKey, _ = crypto.HexToECDSA("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+	borSupplyAddr   = crypto.PubkeyToAddress(borSupplyKey.PublicKey)
+	borSupplyEth1   = big.NewInt(params.Ether)
+)
+
+// payCallerCode sends 1 ether to the caller
+var payCallerCode = common.FromHex("0x600060006000600067" + "0de0b6b3a7640000" + "335af100")
+
+func TestBorSupplyGenesisAlloc(t *testing.T) {
+	var (
+		config = *params.AllEthashProtocolChanges
+		gspec  = &core.Genesis{
+			Config: &config,
+			Alloc: types.GenesisAlloc{
+				borSupplyAddr:                 {Balance: borSupplyEth1},
+				core.GetFeeAddress():          {Balance: new(big.Int).Mul(big.NewInt(100), borSupplyEth1)},
+				common.HexToAddress("0xdead"): {Balance: borSupplyEth1},
+			},
+		}
+	)
+
+	out, chain, err := testBorSupplyTracer(t, gspec, emptyBlockGenerationFunc)
+	if err != nil {
+		t.Fatalf("failed to test borSupply tracer: %v", err)
+	}
+
+	// The balances of the MRC20 and burnt contracts are not in circulation
+	expected := borSupplyInfo{
+		Issuance: &borSupplyInfoIssuance{
+			GenesisAlloc: (*hexutil.Big)(borSupplyEth1),
+		},
+		Number:     0,
+		Hash:       chain.Genesis().Hash(),
+		ParentHash: common.Hash{},
+	}
+
+	compareAsJSON(t, expected, out[0])
+}
+
+func TestBorSupplyBurntContract(t *testing.T) {
+	var (
+		config = *params.AllEthashProtocolChanges
+		aa     = common.HexToAddress("0xaaaa")
+		gspec  = &core.Genesis{
+			Config:  &config,
+			BaseFee: big.NewInt(params.InitialBaseFee),
+			Alloc: types.GenesisAlloc{
+				borSupplyAddr: {Balance: borSupplyEth1},
+			},
+		}
+	)
+
+	out, chain, err := testBorSupplyTracer(t, gspec, func(b *core.BlockGen) {
+		b.AddTx(signBorSupplyTx(t, gspec, 0, aa, nil, 21000))
+	})
+	if err != nil {
+		t.Fatalf("failed to test borSupply tracer: %v", err)
+	}
+
+	// The base fee is sent to the burnt contract
+	var (
+		head     = chain.CurrentBlock()
+		reward   = new(big.Int).Mul(common.Big2, borSupplyEth1)
+		burn     = new(big.Int).Mul(big.NewInt(21000), head.BaseFee)
+		expected = borSupplyInfo{
+			Issuance: &borSupplyInfoIssuance{
+				Reward: (*hexutil.Big)(reward),
+			},
+			Burn: &borSupplyInfoBurn{
+				BurntContract: (*hexutil.Big)(burn),
+			},
+			Number:     1,
+			Hash:       head.Hash(),
+			ParentHash: head.ParentHash,
+		}
+	)
+
+	compareAsJSON(t, expected, out[1])
+}
+
+func TestBorSupplyMRC20(t *testing.T) {
+	var (
+		config = *params.AllEthashProtocolChanges
+		mrc20  = core.GetFeeAddress()
+		aa     = common.HexToAddress("0xaaaa")
+		gspec  = &core.Genesis{
+			Config:  &config,
+			BaseFee: big.NewInt(params.InitialBaseFee),
+			Alloc: types.GenesisAlloc{
+				borSupplyAddr: {Balance: new(big.Int).Mul(big.NewInt(10), borSupplyEth1)},
+				mrc20:         {Balance: new(big.Int).Mul(big.NewInt(100), borSupplyEth1), Code: payCallerCode},
+			},
+		}
+	)
+
+	withdraw := new(big.Int).Mul(big.NewInt(3), borSupplyEth1)
+
+	out, chain, err := testBorSupplyTracer(t, gspec, func(b *core.BlockGen) {
+		// Withdraws 3 ether, and gets 1 ether back from the contract
+		b.AddTx(signBorSupplyTx(t, gspec, 0, mrc20, withdraw, 100000))
+		// Sends to an account, nothing changes
+		b.AddTx(signBorSupplyTx(t, gspec, 1, aa, borSupplyEth1, 21000))
+	})
+	if err != nil {
+		t.Fatalf("failed to test borSupply tracer: %v", err)
+	}
+
+	var (
+		head     = chain.CurrentBlock()
+		reward   = new(big.Int).Mul(common.Big2, borSupplyEth1)
+		receipts = chain.GetReceiptsByHash(head.Hash())
+		gasUsed  = receipts[0].GasUsed + receipts[1].GasUsed
+		burn     = new(big.Int).Mul(new(big.Int).SetUint64(gasUsed), head.BaseFee)
+		expected = borSupplyInfo{
+			Issuance: &borSupplyInfoIssuance{
+				MRC20:  (*hexutil.Big)(borSupplyEth1),
+				Reward: (*hexutil.Big)(reward),
+			},
+			Burn: &borSupplyInfoBurn{
+				BurntContract: (*hexutil.Big)(burn),
+				MRC20:         (*hexutil.Big)(withdraw),
+			},
+			Number:     1,
+			Hash:       head.Hash(),
+			ParentHash: head.ParentHash,
+		}
+	)
+
+	compareAsJSON(t, expected, out[1])
+}
+
+func TestBorSupplyMRC20Revert(t *testing.T) {
+	var (
+		config = *params.AllEthashProtocolChanges
+		mrc20  = core.GetFeeAddress()
+		aa     = common.HexToAddress("0xaaaa")
+		gspec  = &core.Genesis{
+			Config:  &config,
+			BaseFee: big.NewInt(params.InitialBaseFee),
+			Alloc: types.GenesisAlloc{
+				borSupplyAddr: {Balance: borSupplyEth1},
+				mrc20:         {Balance: new(big.Int).Mul(big.NewInt(100), borSupplyEth1), Code: payCallerCode},
+				// Calls the MRC20 contract, which pays it 1 ether, then reverts
+				aa: {Balance: common.Big0, Code: common.FromHex("0x6000600060006000600061" + "1010" + "5af160006000fd")},
+			},
+		}
+	)
+
+	out, chain, err := testBorSupplyTracer(t, gspec, func(b *core.BlockGen) {
+		b.AddTx(signBorSupplyTx(t, gspec, 0, aa, nil, 100000))
+	})
+	if err != nil {
+		t.Fatalf("failed to test borSupply tracer: %v", err)
+	}
+
+	// The reverted payment of the MRC20 contract is not counted
+	var (
+		head     = chain.CurrentBlock()
+		reward   = new(big.Int).Mul(common.Big2, borSupplyEth1)
+		receipts = chain.GetReceiptsByHash(head.Hash())
+		burn     = new(big.Int).Mul(new(big.Int).SetUint64(receipts[0].GasUsed), head.BaseFee)
+		expected = borSupplyInfo{
+			Issuance: &borSupplyInfoIssuance{
+				Reward: (*hexutil.Big)(reward),
+			},
+			Burn: &borSupplyInfoBurn{
+				BurntContract: (*hexutil.Big)(burn),
+			},
+			Number:     1,
+			Hash:       head.Hash(),
+			ParentHash: head.ParentHash,
+		}
+	)
+
+	if receipts[0].Status != types.ReceiptStatusFailed {
+		t.Fatalf("expected the transaction to revert")
+	}
+
+	compareAsJSON(t, expected, out[1])
+}
+
+func TestBorSupplyStateSync(t *testing.T) {
+	var (
+		mrc20    = core.GetFeeAddress()
+		receiver = common.HexToAddress("0xbeef")
+		deposit  = new(big.Int).Mul(big.NewInt(5), borSupplyEth1)
+		balance  = new(big.Int).Mul(big.NewInt(100), borSupplyEth1)
+		after    = new(big.Int).Sub(balance, deposit)
+		block    = types.NewBlockWithHeader(&types.Header{Number: big.NewInt(16), ParentHash: common.HexToHash("0x01")})
+	)
+
+	traceOutputPath := filepath.ToSlash(t.TempDir())
+
+	tracer, err := tracers.LiveDirectory.New("borSupply", json.RawMessage(fmt.Sprintf(`{"path":"%s"}`, traceOutputPath)))
+	if err != nil {
+		t.Fatalf("failed to create borSupply tracer: %v", err)
+	}
+
+	// Bor commits the state syncs in system calls at the end of the sprint,
+	// the MRC20 contract releases the deposits
+	tracer.OnBlockchainInit(params.AllEthashProtocolChanges)
+	tracer.OnBlockStart(tracing.BlockEvent{Block: block})
+	tracer.OnSystemCallStart()
+	tracer.OnEnter(0, byte(vm.CALL), common.Address{}, mrc20, nil, 0, nil)
+	tracer.OnBalanceChange(mrc20, balance, after, tracing.BalanceChangeTransfer)
+	tracer.OnBalanceChange(receiver, common.Big0, deposit, tracing.BalanceChangeTransfer)
+	tracer.OnExit(0, nil, 0, nil, false)
+	tracer.OnSystemCallEnd()
+	tracer.OnBlockEnd(nil)
+	tracer.OnClose()
+
+	out, err := readBorSupplyOutput(traceOutputPath)
+	if err != nil {
+		t.Fatalf("failed to read borSupply tracer output: %v", err)
+	}
+
+	expected := borSupplyInfo{
+		Issuance: &borSupplyInfoIssuance{
+			StateSync: (*hexutil.Big)(deposit),
+		},
+		Number:     16,
+		Hash:       block.Hash(),
+		ParentHash: block.ParentHash(),
+	}
+
+	compareAsJSON(t, expected, out[0])
+}
+
+func signBorSupplyTx(t *testing.T, gspec *core.Genesis, nonce uint64, to common.Address, value *big.Int, gas uint64) *types.Transaction {
+	t.Helper()
+
+	tx, err := types.SignNewTx(borSupplyKey, types.LatestSigner(gspec.Config), &types.DynamicFeeTx{
+		ChainID:   gspec.Config.ChainID,
+		Nonce:     nonce,
+		To:        &to,
+		Value:     value,
+		Gas:       gas,
+		GasFeeCap: new(big.Int).Mul(big.NewInt(5), big.NewInt(params.GWei)),
+		GasTipCap: big.NewInt(2),
+	})
+	if err != nil {
+		t.Fatalf("failed to sign transaction: %v", err)
+	}
+
+	return tx
+}
+
+func testBorSupplyTracer(t *testing.T, genesis *core.Genesis, gen func(*core.BlockGen)) ([]borSupplyInfo, *core.BlockChain, error) {
+	var (
+		engine = beacon.New(ethash.NewFaker())
+	)
+
+	traceOutputPath := filepath.ToSlash(t.TempDir())
+
+	// Load borSupply tracer
+	tracer, err := tracers.LiveDirectory.New("borSupply", json.RawMessage(fmt.Sprintf(`{"path":"%s"}`, traceOutputPath)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create borSupply tracer: %v", err)
+	}
+
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), core.DefaultCacheConfigWithScheme(rawdb.PathScheme), genesis, nil, engine, vm.Config{Tracer: tracer}, nil, nil, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	_, blocks, _ := core.GenerateChainWithGenesis(genesis, engine, 1, func(i int, b *core.BlockGen) {
+		b.SetCoinbase(common.Address{1})
+		gen(b)
+	})
+
+	if n, err := chain.InsertChain(blocks); err != nil {
+		return nil, chain, fmt.Errorf("block %d: failed to insert into chain: %v", n, err)
+	}
+
+	output, err := readBorSupplyOutput(traceOutputPath)
+
+	return output, chain, err
+}
+
+func readBorSupplyOutput(dir string) ([]borSupplyInfo, error) {
+	file, err := os.OpenFile(path.Join(dir, "bor_supply.jsonl"), os.O_RDONLY, 0666)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open output file: %v", err)
+	}
+	defer file.Close()
+
+	var output []borSupplyInfo
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		var info borSupplyInfo
+		if err := json.Unmarshal(scanner.Bytes(), &info); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal result: %v", err)
+		}
+
+		output = append(output, info)
+	}
+
+	return output, nil
+}
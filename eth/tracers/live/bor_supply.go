@@ -0,0 +1,320 @@
+package live
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	lumberjack "gopkg.in/natefinch/lumberjack.v2"
+)
+
+func init() {
+	tracers.LiveDirectory.Register("borSupply", newBorSupply)
+}
+
+// The native token of a bor chain is held by the MRC20 contract until it is
+// deposited through a state sync, and goes back to it when it is withdrawn.
+// The base fees are sent to the burnt contract instead of being destroyed.
+// The borSupply tracer tracks the supply in circulation, which excludes the
+// balances of both contracts.
+
+type borSupplyIssuance struct {
+	GenesisAlloc *hexutil.Big `json:"genesisAlloc,omitempty"` // Genesis allocation out of the MRC20 and burnt contracts
+	StateSync    *hexutil.Big `json:"stateSync,omitempty"`    // Released by the MRC20 contract when committing state syncs (deposits)
+	MRC20        *hexutil.Big `json:"mrc20,omitempty"`        // Released by the MRC20 contract in transactions
+	Reward       *hexutil.Big `json:"reward,omitempty"`       // Block and uncle rewards
+}
+
+type borSupplyBurn struct {
+	BurntContract *hexutil.Big `json:"burntContract,omitempty"` // Sent to the burnt contract (base fees), negative when it withdraws
+	MRC20         *hexutil.Big `json:"mrc20,omitempty"`         // Sent to the MRC20 contract (withdrawals)
+	Misc          *hexutil.Big `json:"misc,omitempty"`          // Destroyed (self destructs)
+}
+
+type borSupplyInfo struct {
+	Issuance *borSupplyIssuance `json:"issuance,omitempty"`
+	Burn     *borSupplyBurn     `json:"burn,omitempty"`
+
+	// Block info
+	Number     uint64      `json:"blockNumber"`
+	Hash       common.Hash `json:"hash"`
+	ParentHash common.Hash `json:"parentHash"`
+}
+
+// borSupplyDelta is the change of the supply of a block or a call
+type borSupplyDelta struct {
+	stateSync     *big.Int
+	mrc20Issuance *big.Int
+	reward        *big.Int
+	burntContract *big.Int
+	mrc20Burn     *big.Int
+
+	// net is the sum of all the balance changes, the rewards minus the
+	// destroyed balances, as transfers cancel out
+	net *big.Int
+}
+
+func newBorSupplyDelta() *borSupplyDelta {
+	return &borSupplyDelta{
+		stateSync:     new(big.Int),
+		mrc20Issuance: new(big.Int),
+		reward:        new(big.Int),
+		burntContract: new(big.Int),
+		mrc20Burn:     new(big.Int),
+		net:           new(big.Int),
+	}
+}
+
+func (d *borSupplyDelta) add(other *borSupplyDelta) {
+	d.stateSync.Add(d.stateSync, other.stateSync)
+	d.mrc20Issuance.Add(d.mrc20Issuance, other.mrc20Issuance)
+	d.reward.Add(d.reward, other.reward)
+	d.burntContract.Add(d.burntContract, other.burntContract)
+	d.mrc20Burn.Add(d.mrc20Burn, other.mrc20Burn)
+	d.net.Add(d.net, other.net)
+}
+
+type borSupply struct {
+	chainConfig *params.ChainConfig
+	mrc20       common.Address
+
+	info          borSupplyInfo
+	delta         *borSupplyDelta
+	burntContract common.Address
+
+	// callstack has the deltas of the calls being executed, they are
+	// dropped if the call reverts
+	callstack  []*borSupplyDelta
+	systemCall bool
+
+	logger *lumberjack.Logger
+}
+
+func newBorSupply(cfg json.RawMessage) (*tracing.Hooks, error) {
+	var config supplyTracerConfig
+	if cfg != nil {
+		if err := json.Unmarshal(cfg, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse config: %v", err)
+		}
+	}
+	if config.Path == "" {
+		return nil, errors.New("borSupply tracer output path is required")
+	}
+
+	// Store traces in a rotating file
+	logger := &lumberjack.Logger{
+		Filename: filepath.Join(config.Path, "bor_supply.jsonl"),
+	}
+	if config.MaxSize > 0 {
+		logger.MaxSize = config.MaxSize
+	}
+
+	t := &borSupply{
+		mrc20:  core.GetFeeAddress(),
+		delta:  newBorSupplyDelta(),
+		logger: logger,
+	}
+	return &tracing.Hooks{
+		OnBlockchainInit:  t.OnBlockchainInit,
+		OnBlockStart:      t.OnBlockStart,
+		OnBlockEnd:        t.OnBlockEnd,
+		OnGenesisBlock:    t.OnGenesisBlock,
+		OnTxStart:         t.OnTxStart,
+		OnSystemCallStart: t.OnSystemCallStart,
+		OnSystemCallEnd:   t.OnSystemCallEnd,
+		OnBalanceChange:   t.OnBalanceChange,
+		OnEnter:           t.OnEnter,
+		OnExit:            t.OnExit,
+		OnClose:           t.OnClose,
+	}, nil
+}
+
+func (s *borSupply) OnBlockchainInit(chainConfig *params.ChainConfig) {
+	s.chainConfig = chainConfig
+}
+
+// burntContractAt returns the burnt contract of the block, the zero address
+// if the chain has none
+func (s *borSupply) burntContractAt(number uint64) common.Address {
+	if s.chainConfig == nil || s.chainConfig.Bor == nil {
+		return common.Address{}
+	}
+
+	return common.HexToAddress(s.chainConfig.Bor.CalculateBurntContract(number))
+}
+
+func (s *borSupply) reset(b *types.Block) {
+	s.info = borSupplyInfo{
+		Number:     b.NumberU64(),
+		Hash:       b.Hash(),
+		ParentHash: b.ParentHash(),
+	}
+	s.delta = newBorSupplyDelta()
+	s.burntContract = s.burntContractAt(b.NumberU64())
+	s.callstack = s.callstack[:0]
+	s.systemCall = false
+}
+
+func (s *borSupply) OnBlockStart(ev tracing.BlockEvent) {
+	s.reset(ev.Block)
+}
+
+func (s *borSupply) OnBlockEnd(err error) {
+	s.write()
+}
+
+func (s *borSupply) OnGenesisBlock(b *types.Block, alloc types.GenesisAlloc) {
+	s.reset(b)
+
+	genesisAlloc := new(big.Int)
+
+	for addr, account := range alloc {
+		if account.Balance == nil || addr == s.mrc20 || addr == s.burntContract {
+			continue
+		}
+
+		genesisAlloc.Add(genesisAlloc, account.Balance)
+	}
+
+	s.info.Issuance = &borSupplyIssuance{GenesisAlloc: (*hexutil.Big)(genesisAlloc)}
+
+	s.write()
+}
+
+func (s *borSupply) OnTxStart(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	s.callstack = s.callstack[:0]
+}
+
+// OnSystemCallStart starts the system calls of the block, the native token
+// released by the MRC20 contract in system calls are state sync deposits
+func (s *borSupply) OnSystemCallStart() {
+	s.callstack = s.callstack[:0]
+	s.systemCall = true
+}
+
+func (s *borSupply) OnSystemCallEnd() {
+	s.systemCall = false
+}
+
+func (s *borSupply) OnBalanceChange(a common.Address, prevBalance, newBalance *big.Int, reason tracing.BalanceChangeReason) {
+	// NOTE: don't handle "BalanceIncreaseGenesisBalance" because it is handled in OnGenesisBlock
+	if reason == tracing.BalanceIncreaseGenesisBalance {
+		return
+	}
+
+	delta := s.delta
+	if len(s.callstack) > 0 {
+		delta = s.callstack[len(s.callstack)-1]
+	}
+
+	diff := new(big.Int).Sub(newBalance, prevBalance)
+
+	delta.net.Add(delta.net, diff)
+
+	switch {
+	case reason == tracing.BalanceIncreaseRewardMineBlock || reason == tracing.BalanceIncreaseRewardMineUncle:
+		delta.reward.Add(delta.reward, diff)
+	case a == s.mrc20 && diff.Sign() > 0:
+		delta.mrc20Burn.Add(delta.mrc20Burn, diff)
+	case a == s.mrc20 && s.systemCall:
+		delta.stateSync.Sub(delta.stateSync, diff)
+	case a == s.mrc20:
+		delta.mrc20Issuance.Sub(delta.mrc20Issuance, diff)
+	case a == s.burntContract && a != (common.Address{}):
+		delta.burntContract.Add(delta.burntContract, diff)
+	}
+}
+
+func (s *borSupply) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	s.callstack = append(s.callstack, newBorSupplyDelta())
+}
+
+func (s *borSupply) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	size := len(s.callstack)
+	if size == 0 {
+		return
+	}
+
+	// Pop call
+	call := s.callstack[size-1]
+	s.callstack = s.callstack[:size-1]
+
+	// The balance changes of a reverted call and its subcalls are undone
+	if reverted {
+		return
+	}
+
+	if size > 1 {
+		s.callstack[size-2].add(call)
+	} else {
+		s.delta.add(call)
+	}
+}
+
+func (s *borSupply) OnClose() {
+	if err := s.logger.Close(); err != nil {
+		log.Warn("failed to close borSupply tracer log file", "error", err)
+	}
+}
+
+// nonZero returns x, or nil if it is zero so that it is omitted
+func nonZero(x *big.Int) *hexutil.Big {
+	if x == nil || x.Sign() == 0 {
+		return nil
+	}
+
+	return (*hexutil.Big)(x)
+}
+
+func (s *borSupply) write() {
+	info := s.info
+	delta := s.delta
+
+	// The balances only move between accounts, apart from the rewards,
+	// whatever else the balances lost was destroyed
+	misc := new(big.Int).Sub(delta.reward, delta.net)
+
+	if info.Issuance == nil {
+		info.Issuance = &borSupplyIssuance{}
+	}
+
+	info.Issuance.StateSync = nonZero(delta.stateSync)
+	info.Issuance.MRC20 = nonZero(delta.mrc20Issuance)
+	info.Issuance.Reward = nonZero(delta.reward)
+
+	if info.Issuance.GenesisAlloc != nil && info.Issuance.GenesisAlloc.ToInt().Sign() == 0 {
+		info.Issuance.GenesisAlloc = nil
+	}
+
+	if *info.Issuance == (borSupplyIssuance{}) {
+		info.Issuance = nil
+	}
+
+	info.Burn = &borSupplyBurn{
+		BurntContract: nonZero(delta.burntContract),
+		MRC20:         nonZero(delta.mrc20Burn),
+		Misc:          nonZero(misc),
+	}
+
+	if *info.Burn == (borSupplyBurn{}) {
+		info.Burn = nil
+	}
+
+	out, _ := json.Marshal(info)
+	if _, err := s.logger.Write(out); err != nil {
+		log.Warn("failed to write to borSupply tracer log file", "error", err)
+	}
+	if _, err := s.logger.Write([]byte{'\n'}); err != nil {
+		log.Warn("failed to write to borSupply tracer log file", "error", err)
+	}
+}
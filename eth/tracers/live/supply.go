@@ -98,14 +98,15 @@ func newSupply(cfg json.RawMessage) (*tracing.Hooks, error) {
 		logger: logger,
 	}
 	return &tracing.Hooks{
-		OnBlockStart:    t.OnBlockStart,
-		OnBlockEnd:      t.OnBlockEnd,
-		OnGenesisBlock:  t.OnGenesisBlock,
-		OnTxStart:       t.OnTxStart,
-		OnBalanceChange: t.OnBalanceChange,
-		OnEnter:         t.OnEnter,
-		OnExit:          t.OnExit,
-		OnClose:         t.OnClose,
+		OnBlockStart:      t.OnBlockStart,
+		OnBlockEnd:        t.OnBlockEnd,
+		OnGenesisBlock:    t.OnGenesisBlock,
+		OnTxStart:         t.OnTxStart,
+		OnSystemCallStart: t.OnSystemCallStart,
+		OnBalanceChange:   t.OnBalanceChange,
+		OnEnter:           t.OnEnter,
+		OnExit:            t.OnExit,
+		OnClose:           t.OnClose,
 	}, nil
 }
 
@@ -197,6 +198,12 @@ func (s *supply) OnTxStart(vm *tracing.VMContext, tx *types.Transaction, from co
 	s.txCallstack = make([]supplyTxCallstack, 0, 1)
 }
 
+// OnSystemCallStart starts a new callstack, the calls of system contracts
+// are handled like transactions
+func (s *supply) OnSystemCallStart() {
+	s.txCallstack = make([]supplyTxCallstack, 0, 1)
+}
+
 // internalTxsHandler handles internal transactions burned amount
 func (s *supply) internalTxsHandler(call *supplyTxCallstack) {
 	// Handle Burned amount
@@ -0,0 +1,249 @@
+package native
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+)
+
+func init() {
+	tracers.DefaultDirectory.Register("parityVmTracer", newParityVmTracer, false)
+}
+
+// vmTrace is the Parity/OpenEthereum style trace of the execution of some code.
+type vmTrace struct {
+	Code hexutil.Bytes `json:"code"`
+	Ops  []*vmTraceOp  `json:"ops"`
+}
+
+type vmTraceOp struct {
+	Cost uint64     `json:"cost"`
+	Ex   *vmTraceEx `json:"ex"`
+	PC   uint64     `json:"pc"`
+	Sub  *vmTrace   `json:"sub"`
+
+	op     vm.OpCode
+	memOff uint64 // Memory written by the op, captured after its execution
+	memLen uint64
+}
+
+type vmTraceEx struct {
+	Mem   *vmTraceMem   `json:"mem"`
+	Push  []string      `json:"push"`
+	Store *vmTraceStore `json:"store"`
+	Used  uint64        `json:"used"`
+}
+
+type vmTraceMem struct {
+	Data hexutil.Bytes `json:"data"`
+	Off  uint64        `json:"off"`
+}
+
+type vmTraceStore struct {
+	Key string `json:"key"`
+	Val string `json:"val"`
+}
+
+// vmTraceFrame is the trace of a call being executed, along with its op whose
+// effects are known once the next op of the call starts.
+type vmTraceFrame struct {
+	trace   *vmTrace
+	pending *vmTraceOp
+}
+
+// parityVmTracer reports the ops of a tx in the Parity/OpenEthereum vmTrace
+// format, with the values pushed to the stack, the memory and storage written
+// by each op, and the ops of the calls in nested traces.
+type parityVmTracer struct {
+	env       *tracing.VMContext
+	root      *vmTrace
+	frames    []*vmTraceFrame
+	interrupt atomic.Bool // Atomic flag to signal execution interruption
+	reason    error       // Textual reason for the interruption
+}
+
+// newParityVmTracer returns a native go tracer which reports the vmTrace of a
+// tx, and implements vm.EVMLogger.
+func newParityVmTracer(ctx *tracers.Context, _ json.RawMessage) (*tracers.Tracer, error) {
+	t := &parityVmTracer{}
+
+	return &tracers.Tracer{
+		Hooks: &tracing.Hooks{
+			OnTxStart: t.OnTxStart,
+			OnEnter:   t.OnEnter,
+			OnExit:    t.OnExit,
+			OnOpcode:  t.OnOpcode,
+		},
+		GetResult: t.GetResult,
+		Stop:      t.Stop,
+	}, nil
+}
+
+func (t *parityVmTracer) OnTxStart(env *tracing.VMContext, tx *types.Transaction, from common.Address) {
+	t.env = env
+}
+
+func (t *parityVmTracer) OnEnter(depth int, typ byte, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if t.interrupt.Load() {
+		return
+	}
+
+	trace := &vmTrace{Ops: []*vmTraceOp{}}
+
+	switch vm.OpCode(typ) {
+	case vm.CREATE, vm.CREATE2:
+		trace.Code = common.CopyBytes(input)
+	default:
+		if t.env != nil {
+			trace.Code = common.CopyBytes(t.env.StateDB.GetCode(to))
+		}
+	}
+
+	// Like Parity, calls which don't run any code (precompiles and accounts
+	// without code) have no nested trace
+	if len(t.frames) == 0 {
+		t.root = trace
+	} else if parent := t.frames[len(t.frames)-1]; parent.pending != nil && len(trace.Code) > 0 {
+		parent.pending.Sub = trace
+	}
+
+	t.frames = append(t.frames, &vmTraceFrame{trace: trace})
+}
+
+func (t *parityVmTracer) OnExit(depth int, output []byte, gasUsed uint64, err error, reverted bool) {
+	if len(t.frames) == 0 {
+		return
+	}
+
+	// The last op of the call has no effects to capture
+	t.frames = t.frames[:len(t.frames)-1]
+}
+
+func (t *parityVmTracer) OnOpcode(pc uint64, op byte, gas, cost uint64, scope tracing.OpContext, rData []byte, depth int, err error) {
+	if t.interrupt.Load() || len(t.frames) == 0 {
+		return
+	}
+
+	var (
+		frame = t.frames[len(t.frames)-1]
+		stack = scope.StackData()
+	)
+
+	// The effects of the previous op are visible now
+	if prev := frame.pending; prev != nil {
+		prev.Ex.Used = gas
+
+		if n := vmTracePushCount(prev.op); n > 0 && n <= len(stack) {
+			for _, item := range stack[len(stack)-n:] {
+				prev.Ex.Push = append(prev.Ex.Push, item.Hex())
+			}
+		}
+
+		if prev.memLen > 0 {
+			memory := scope.MemoryData()
+			if size := uint64(len(memory)); prev.memOff <= size && prev.memLen <= size-prev.memOff {
+				prev.Ex.Mem = &vmTraceMem{
+					Data: common.CopyBytes(memory[prev.memOff : prev.memOff+prev.memLen]),
+					Off:  prev.memOff,
+				}
+			}
+		}
+	}
+
+	next := &vmTraceOp{
+		Cost: cost,
+		Ex:   &vmTraceEx{Push: []string{}},
+		PC:   pc,
+		op:   vm.OpCode(op),
+	}
+
+	if gas >= cost {
+		next.Ex.Used = gas - cost
+	}
+
+	// peek returns the nth item from the top of the stack
+	peek := func(n int) uint64 {
+		if n >= len(stack) {
+			return 0
+		}
+		return stack[len(stack)-1-n].Uint64()
+	}
+
+	switch vm.OpCode(op) {
+	case vm.SSTORE:
+		if len(stack) >= 2 {
+			next.Ex.Store = &vmTraceStore{
+				Key: stack[len(stack)-1].Hex(),
+				Val: stack[len(stack)-2].Hex(),
+			}
+		}
+	case vm.MSTORE:
+		next.memOff, next.memLen = peek(0), 32
+	case vm.MSTORE8:
+		next.memOff, next.memLen = peek(0), 1
+	case vm.CALLDATACOPY, vm.CODECOPY, vm.RETURNDATACOPY, vm.MCOPY:
+		next.memOff, next.memLen = peek(0), peek(2)
+	case vm.EXTCODECOPY:
+		next.memOff, next.memLen = peek(1), peek(3)
+	case vm.CALL, vm.CALLCODE:
+		next.memOff, next.memLen = peek(5), peek(6)
+	case vm.DELEGATECALL, vm.STATICCALL:
+		next.memOff, next.memLen = peek(4), peek(5)
+	}
+
+	frame.trace.Ops = append(frame.trace.Ops, next)
+	frame.pending = next
+}
+
+// vmTracePushCount returns the number of stack items reported as pushed by
+// the op. Like Parity, DUP and SWAP report all the items they touch.
+func vmTracePushCount(op vm.OpCode) int {
+	switch {
+	case op.IsPush():
+		return 1
+	case op >= vm.DUP1 && op <= vm.DUP16:
+		return int(op-vm.DUP1) + 2
+	case op >= vm.SWAP1 && op <= vm.SWAP16:
+		return int(op-vm.SWAP1) + 2
+	case op >= vm.LOG0 && op <= vm.LOG4:
+		return 0
+	}
+
+	switch op {
+	case vm.STOP, vm.POP, vm.MSTORE, vm.MSTORE8, vm.SSTORE, vm.TSTORE, vm.JUMP, vm.JUMPI, vm.JUMPDEST,
+		vm.CALLDATACOPY, vm.CODECOPY, vm.EXTCODECOPY, vm.RETURNDATACOPY, vm.MCOPY,
+		vm.RETURN, vm.REVERT, vm.INVALID, vm.SELFDESTRUCT:
+		return 0
+	}
+
+	return 1
+}
+
+// GetResult returns the json-encoded vmTrace, and any error arising from the
+// encoding or forceful termination (via `Stop`).
+func (t *parityVmTracer) GetResult() (json.RawMessage, error) {
+	if t.root == nil {
+		return nil, errors.New("incorrect number of top-level calls")
+	}
+
+	res, err := json.Marshal(t.root)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, t.reason
+}
+
+// Stop terminates execution of the tracer at the first opportune moment.
+func (t *parityVmTracer) Stop(err error) {
+	t.reason = err
+	t.interrupt.Store(true)
+}
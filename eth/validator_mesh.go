@@ -0,0 +1,211 @@
+package eth
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	borproto "github.com/ethereum/go-ethereum/eth/protocols/bor"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	// meshBackups is the number of backup producers kept connected after the
+	// in-turn producer of each upcoming sprint.
+	meshBackups = 2
+
+	// meshRetention is the time the connection to a producer is kept once it is
+	// no longer upcoming, to avoid reconnecting at each sprint.
+	meshRetention = 10 * time.Minute
+)
+
+// producersFn returns the producers expected to seal the blocks after the header.
+type producersFn func(header *types.Header, backups int) ([]common.Address, error)
+
+// validatorMesh keeps direct connections to the upcoming block producers whose
+// nodes are known, so that new blocks are pushed to them first.
+type validatorMesh struct {
+	server    *p2p.Server
+	chain     *core.BlockChain
+	producers producersFn
+
+	lock       sync.RWMutex
+	nodes      map[common.Address]*enode.Node // Configured nodes of the validators
+	discovered map[common.Address]*enode.Node // Nodes attesting the validators in their bor ENR entry
+	added      map[enode.ID]*enode.Node       // Nodes connected by the mesh
+	lastSeen   map[enode.ID]time.Time         // Last time the connected nodes were upcoming producers
+	priority   map[enode.ID]struct{}          // Nodes of the upcoming producers
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+func newValidatorMesh(server *p2p.Server, chain *core.BlockChain, producers producersFn, nodes map[common.Address]*enode.Node) *validatorMesh {
+	m := &validatorMesh{
+		server:     server,
+		chain:      chain,
+		producers:  producers,
+		nodes:      make(map[common.Address]*enode.Node, len(nodes)),
+		discovered: make(map[common.Address]*enode.Node),
+		added:      make(map[enode.ID]*enode.Node),
+		lastSeen:   make(map[enode.ID]time.Time),
+		priority:   make(map[enode.ID]struct{}),
+		quit:       make(chan struct{}),
+	}
+
+	for address, node := range nodes {
+		m.nodes[address] = node
+	}
+
+	return m
+}
+
+// start connects to the upcoming producers at each new head.
+func (m *validatorMesh) start() {
+	m.wg.Add(1)
+
+	go m.loop()
+}
+
+// stop terminates the mesh maintenance.
+func (m *validatorMesh) stop() {
+	close(m.quit)
+	m.wg.Wait()
+}
+
+// observe records the node if its bor ENR entry attests a validator. The nodes
+// configured for the validators take precedence.
+func (m *validatorMesh) observe(n *enode.Node) {
+	entry := borproto.LoadENREntry(n)
+	if entry == nil {
+		return
+	}
+
+	validator, err := entry.Validator(n.ID())
+	if err != nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if old, ok := m.discovered[validator]; !ok || old.ID() != n.ID() || old.Seq() < n.Seq() {
+		log.Trace("Discovered validator node", "validator", validator, "id", n.ID())
+		m.discovered[validator] = n
+	}
+}
+
+// node returns the node of the validator, nil if it isn't known. The lock must
+// be held.
+func (m *validatorMesh) node(validator common.Address) *enode.Node {
+	if node, ok := m.nodes[validator]; ok {
+		return node
+	}
+
+	return m.discovered[validator]
+}
+
+// isPriority reports whether the peer is the node of an upcoming producer.
+func (m *validatorMesh) isPriority(id enode.ID) bool {
+	if m == nil {
+		return false
+	}
+
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	_, ok := m.priority[id]
+
+	return ok
+}
+
+func (m *validatorMesh) loop() {
+	defer m.wg.Done()
+
+	headCh := make(chan core.ChainHeadEvent, 10)
+	headSub := m.chain.SubscribeChainHeadEvent(headCh)
+
+	defer headSub.Unsubscribe()
+
+	m.update(m.chain.CurrentBlock())
+
+	for {
+		select {
+		case head := <-headCh:
+			m.update(head.Block.Header())
+
+		case <-headSub.Err():
+			return
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// update connects to the nodes of the producers upcoming after the header, and
+// disconnects the nodes no longer upcoming for a while.
+func (m *validatorMesh) update(header *types.Header) {
+	producers, err := m.producers(header, meshBackups)
+	if err != nil {
+		log.Debug("Failed to retrieve upcoming producers", "number", header.Number, "err", err)
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var (
+		now      = time.Now()
+		self     = m.server.LocalNode().ID()
+		priority = make(map[enode.ID]struct{}, len(producers))
+	)
+
+	for _, producer := range producers {
+		node := m.node(producer)
+		if node == nil || node.ID() == self {
+			continue
+		}
+
+		priority[node.ID()] = struct{}{}
+
+		// Leave the nodes configured by the operator to the p2p server, including
+		// the ones added since the mesh connected to them, e.g. by a reload
+		if m.server.IsReserved(node.ID()) {
+			delete(m.added, node.ID())
+			delete(m.lastSeen, node.ID())
+
+			continue
+		}
+
+		m.lastSeen[node.ID()] = now
+
+		// The nodes are dialed like static nodes, but they're not configured by
+		// the operator: they're still subject to the peer limit, the node and IP
+		// bans, the sentry isolation and the global serving budget.
+		if _, ok := m.added[node.ID()]; !ok {
+			log.Debug("Connecting to upcoming producer", "producer", producer, "id", node.ID())
+
+			m.added[node.ID()] = node
+			m.server.AddDialedPeer(node)
+		}
+	}
+
+	for id, node := range m.added {
+		if now.Sub(m.lastSeen[id]) < meshRetention {
+			continue
+		}
+
+		log.Debug("Disconnecting from past producer", "id", id)
+
+		delete(m.added, id)
+		delete(m.lastSeen, id)
+		m.server.RemoveDialedPeer(node)
+	}
+
+	m.priority = priority
+}
@@ -0,0 +1,152 @@
+package eth
+
+import (
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	borproto "github.com/ethereum/go-ethereum/eth/protocols/bor"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/p2p/enr"
+)
+
+func newMeshTestNode(t *testing.T) *enode.Node {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return enode.NewV4(&key.PublicKey, []byte{127, 0, 0, 1}, 30303, 30303)
+}
+
+func TestValidatorMeshUpdate(t *testing.T) {
+	t.Parallel()
+
+	key, _ := crypto.GenerateKey()
+	server := &p2p.Server{Config: p2p.Config{PrivateKey: key, MaxPeers: 10, NoDiscovery: true, NoDial: true}}
+
+	if err := server.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+
+	var (
+		validators = []common.Address{{1}, {2}, {3}}
+		nodes      = map[common.Address]*enode.Node{
+			validators[0]: newMeshTestNode(t),
+			validators[1]: newMeshTestNode(t),
+		}
+		upcoming []common.Address
+	)
+
+	producers := func(header *types.Header, backups int) ([]common.Address, error) {
+		return upcoming, nil
+	}
+	mesh := newValidatorMesh(server, nil, producers, nodes)
+
+	// Only the upcoming producers with known nodes are prioritised
+	upcoming = []common.Address{validators[0], validators[2]}
+	mesh.update(&types.Header{Number: big.NewInt(1)})
+
+	if !mesh.isPriority(nodes[validators[0]].ID()) || mesh.isPriority(nodes[validators[1]].ID()) {
+		t.Fatal("wrong priority nodes for the first producers")
+	}
+
+	if _, ok := mesh.added[nodes[validators[0]].ID()]; !ok || len(mesh.added) != 1 {
+		t.Fatalf("unexpected connected nodes: %v", mesh.added)
+	}
+
+	// Past producers lose the priority, but stay connected until the retention ends
+	upcoming = []common.Address{validators[1]}
+	mesh.update(&types.Header{Number: big.NewInt(2)})
+
+	if mesh.isPriority(nodes[validators[0]].ID()) || !mesh.isPriority(nodes[validators[1]].ID()) {
+		t.Fatal("wrong priority nodes for the next producers")
+	}
+
+	if len(mesh.added) != 2 {
+		t.Fatalf("unexpected connected nodes: %v", mesh.added)
+	}
+
+	mesh.lastSeen[nodes[validators[0]].ID()] = time.Now().Add(-meshRetention)
+	mesh.update(&types.Header{Number: big.NewInt(3)})
+
+	if _, ok := mesh.added[nodes[validators[0]].ID()]; ok || len(mesh.added) != 1 {
+		t.Fatalf("past producer still connected: %v", mesh.added)
+	}
+
+	// The nodes made static by the operator, e.g. on a reload, are left to the
+	// server, until they're removed again
+	static := nodes[validators[1]]
+	server.AddPeer(static)
+	mesh.update(&types.Header{Number: big.NewInt(4)})
+
+	if _, ok := mesh.added[static.ID()]; ok || !mesh.isPriority(static.ID()) {
+		t.Fatalf("static node still connected by the mesh: %v", mesh.added)
+	}
+
+	server.RemovePeer(static)
+	mesh.update(&types.Header{Number: big.NewInt(5)})
+
+	if _, ok := mesh.added[static.ID()]; !ok {
+		t.Fatalf("removed static node not connected by the mesh: %v", mesh.added)
+	}
+
+	// A disabled mesh has no priority nodes
+	var disabled *validatorMesh
+	if disabled.isPriority(nodes[validators[1]].ID()) {
+		t.Fatal("disabled mesh has priority nodes")
+	}
+}
+
+func TestValidatorMeshObserve(t *testing.T) {
+	t.Parallel()
+
+	validatorKey, _ := crypto.GenerateKey()
+	validator := crypto.PubkeyToAddress(validatorKey.PublicKey)
+
+	nodeKey, _ := crypto.GenerateKey()
+	id := enode.PubkeyToIDV4(&nodeKey.PublicKey)
+
+	// A node attesting the validator in its bor ENR entry
+	entry := borproto.NewENREntry(137, borproto.RoleValidator)
+	entry.Attestation, _ = crypto.Sign(crypto.Keccak256(borproto.AttestationData(id, entry.ChainID)), validatorKey)
+
+	var r enr.Record
+
+	r.Set(enr.IP(net.IP{127, 0, 0, 1}))
+	r.Set(enr.TCP(30303))
+	r.Set(entry)
+
+	if err := enode.SignV4(&r, nodeKey); err != nil {
+		t.Fatal(err)
+	}
+
+	attesting, err := enode.New(enode.ValidSchemes, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mesh := newValidatorMesh(nil, nil, nil, nil)
+	mesh.observe(newMeshTestNode(t))
+	mesh.observe(attesting)
+
+	if node := mesh.node(validator); node == nil || node.ID() != id {
+		t.Fatalf("attesting node not recorded: %v", node)
+	}
+
+	// The configured nodes take precedence
+	configured := newMeshTestNode(t)
+	mesh.nodes[validator] = configured
+
+	if node := mesh.node(validator); node.ID() != configured.ID() {
+		t.Fatalf("configured node not preferred: %v", node)
+	}
+}
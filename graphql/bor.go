@@ -0,0 +1,277 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/bor"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var (
+	errNotBorChain       = errors.New("only available on bor chains")
+	errNoHeimdallClient  = errors.New("heimdall client is not available")
+	errNoCurrentBorBlock = errors.New("current block not found")
+)
+
+// borEngine returns the bor consensus engine of the backend, if any.
+func (r *Resolver) borEngine() (*bor.Bor, error) {
+	engine, ok := r.backend.Engine().(*bor.Bor)
+	if !ok {
+		return nil, errNotBorChain
+	}
+	return engine, nil
+}
+
+// Author returns the account that sealed the block, as recovered by the
+// consensus engine.
+func (b *Block) Author(ctx context.Context, args BlockNumberArgs) (*Account, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	author, err := b.r.backend.Engine().Author(header)
+	if err != nil {
+		return nil, err
+	}
+	return &Account{
+		r:             b.r,
+		address:       author,
+		blockNrOrHash: args.NumberOrLatest(),
+	}, nil
+}
+
+// StateSyncTransaction returns the bor transaction committing the state syncs
+// of the block, if any.
+func (b *Block) StateSyncTransaction(ctx context.Context) (*Transaction, error) {
+	header, err := b.resolveHeader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	txHash := types.GetDerivedBorTxHash(types.BorReceiptKey(header.Number.Uint64(), b.hash))
+	tx, _, _, index, err := b.r.backend.GetBorBlockTransactionWithBlockHash(ctx, txHash, b.hash)
+	if err != nil || tx == nil {
+		return nil, err
+	}
+	return &Transaction{
+		r:         b.r,
+		hash:      txHash,
+		tx:        tx,
+		block:     b,
+		index:     index,
+		stateSync: true,
+	}, nil
+}
+
+// TxDependency returns the transaction dependencies recorded in the extra
+// data of a bor block, if any.
+func (b *Block) TxDependency(ctx context.Context) (*[][]hexutil.Uint64, error) {
+	if b.r.backend.ChainConfig().Bor == nil {
+		return nil, nil
+	}
+	block, err := b.resolve(ctx)
+	if err != nil || block == nil {
+		return nil, err
+	}
+	deps := block.GetTxDependency()
+	if deps == nil {
+		return nil, nil
+	}
+	ret := make([][]hexutil.Uint64, 0, len(deps))
+	for _, dep := range deps {
+		txs := make([]hexutil.Uint64, 0, len(dep))
+		for _, tx := range dep {
+			txs = append(txs, hexutil.Uint64(tx))
+		}
+		ret = append(ret, txs)
+	}
+	return &ret, nil
+}
+
+// getStateSyncLogs returns the logs of the state sync transaction, which are
+// kept in the bor receipt.
+func (t *Transaction) getStateSyncLogs(ctx context.Context) (*[]*Log, error) {
+	receipt, err := t.getReceipt(ctx)
+	if err != nil || receipt == nil {
+		return nil, err
+	}
+	ret := make([]*Log, 0, len(receipt.Logs))
+	for _, log := range receipt.Logs {
+		ret = append(ret, &Log{
+			r:           t.r,
+			transaction: t,
+			log:         log,
+		})
+	}
+	return &ret, nil
+}
+
+// Validator represents a validator of a bor span.
+type Validator struct {
+	validator valset.Validator
+}
+
+func (v *Validator) ID() hexutil.Uint64 {
+	return hexutil.Uint64(v.validator.ID)
+}
+
+func (v *Validator) Address() common.Address {
+	return v.validator.Address
+}
+
+func (v *Validator) VotingPower() hexutil.Uint64 {
+	return hexutil.Uint64(v.validator.VotingPower)
+}
+
+func (v *Validator) ProposerPriority() hexutil.Big {
+	return hexutil.Big(*big.NewInt(v.validator.ProposerPriority))
+}
+
+func newValidators(validators []*valset.Validator) []*Validator {
+	ret := make([]*Validator, 0, len(validators))
+	for _, validator := range validators {
+		ret = append(ret, &Validator{validator: *validator})
+	}
+	return ret
+}
+
+// Span represents a bor span fetched from heimdall.
+type Span struct {
+	id                uint64
+	startBlock        uint64
+	endBlock          uint64
+	validators        []*Validator
+	selectedProducers []*Validator
+	chainID           string
+}
+
+func (s *Span) ID() hexutil.Uint64 {
+	return hexutil.Uint64(s.id)
+}
+
+func (s *Span) StartBlock() hexutil.Uint64 {
+	return hexutil.Uint64(s.startBlock)
+}
+
+func (s *Span) EndBlock() hexutil.Uint64 {
+	return hexutil.Uint64(s.endBlock)
+}
+
+func (s *Span) Validators() []*Validator {
+	return s.validators
+}
+
+func (s *Span) SelectedProducers() []*Validator {
+	return s.selectedProducers
+}
+
+func (s *Span) ChainID() string {
+	return s.chainID
+}
+
+// Milestone represents the latest milestone whitelisted by the node.
+type Milestone struct {
+	r      *Resolver
+	number uint64
+	hash   common.Hash
+}
+
+func (m *Milestone) Number() hexutil.Uint64 {
+	return hexutil.Uint64(m.number)
+}
+
+func (m *Milestone) Hash() common.Hash {
+	return m.hash
+}
+
+func (m *Milestone) Block(ctx context.Context) (*Block, error) {
+	numberOrHash := rpc.BlockNumberOrHashWithHash(m.hash, false)
+	block := &Block{
+		r:            m.r,
+		numberOrHash: &numberOrHash,
+		hash:         m.hash,
+	}
+	// Return nil if the block is not known yet.
+	h, err := block.resolveHeader(ctx)
+	if err != nil || h == nil {
+		return nil, err
+	}
+	return block, nil
+}
+
+func (r *Resolver) Span(ctx context.Context, args struct{ Id Long }) (*Span, error) {
+	engine, err := r.borEngine()
+	if err != nil {
+		return nil, err
+	}
+	if engine.HeimdallClient == nil {
+		return nil, errNoHeimdallClient
+	}
+	if args.Id < 0 {
+		return nil, nil
+	}
+	span, err := engine.HeimdallClient.Span(ctx, uint64(args.Id))
+	if err != nil || span == nil {
+		return nil, err
+	}
+	producers := make([]*Validator, 0, len(span.SelectedProducers))
+	for _, producer := range span.SelectedProducers {
+		producers = append(producers, &Validator{validator: producer})
+	}
+	return &Span{
+		id:                span.ID,
+		startBlock:        span.StartBlock,
+		endBlock:          span.EndBlock,
+		validators:        newValidators(span.ValidatorSet.Validators),
+		selectedProducers: producers,
+		chainID:           span.ChainID,
+	}, nil
+}
+
+func (r *Resolver) CurrentValidators(ctx context.Context) ([]*Validator, error) {
+	engine, err := r.borEngine()
+	if err != nil {
+		return nil, err
+	}
+	header := r.backend.CurrentHeader()
+	if header == nil {
+		return nil, errNoCurrentBorBlock
+	}
+	validators, err := engine.GetCurrentValidators(ctx, header.Hash(), header.Number.Uint64())
+	if err != nil {
+		return nil, err
+	}
+	return newValidators(validators), nil
+}
+
+func (r *Resolver) Milestone(ctx context.Context) *Milestone {
+	exists, number, hash := r.backend.GetWhitelistedMilestone()
+	if !exists {
+		return nil
+	}
+	return &Milestone{
+		r:      r,
+		number: number,
+		hash:   hash,
+	}
+}
+
+func (r *Resolver) Finalized(ctx context.Context) *Block {
+	// The backend fails if no milestone or checkpoint finalized a known block yet.
+	header, err := r.backend.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
+	if err != nil || header == nil {
+		return nil
+	}
+	numberOrHash := rpc.BlockNumberOrHashWithHash(header.Hash(), false)
+	return &Block{
+		r:            r,
+		numberOrHash: &numberOrHash,
+		hash:         header.Hash(),
+		header:       header,
+	}
+}
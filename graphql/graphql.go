@@ -219,6 +219,9 @@ type Transaction struct {
 	tx    *types.Transaction
 	block *Block
 	index uint64
+	// stateSync marks the bor transaction committing the state syncs of the
+	// block, its receipt and logs are stored apart from the block ones
+	stateSync bool
 }
 
 // resolve returns the internal transaction object, fetching it if needed.
@@ -242,6 +245,19 @@ func (t *Transaction) resolve(ctx context.Context) (*types.Transaction, *Block)
 		t.index = index
 		return t.tx, t.block
 	}
+	// Try to return a bor state sync transaction
+	if tx, blockHash, _, index, _ := t.r.backend.GetBorBlockTransaction(ctx, t.hash); tx != nil {
+		t.tx = tx
+		blockNrOrHash := rpc.BlockNumberOrHashWithHash(blockHash, false)
+		t.block = &Block{
+			r:            t.r,
+			numberOrHash: &blockNrOrHash,
+			hash:         blockHash,
+		}
+		t.index = index
+		t.stateSync = true
+		return t.tx, t.block
+	}
 	// No finalized transaction, try to retrieve it from the pool
 	t.tx = t.r.backend.GetPoolTransaction(t.hash)
 	return t.tx, nil
@@ -446,6 +462,9 @@ func (t *Transaction) getReceipt(ctx context.Context) (*types.Receipt, error) {
 	if block == nil {
 		return nil, nil
 	}
+	if t.stateSync {
+		return t.r.backend.GetBorBlockReceipt(ctx, block.hash)
+	}
 	receipts, err := block.resolveReceipts(ctx)
 	if err != nil {
 		return nil, err
@@ -535,6 +554,9 @@ func (t *Transaction) Logs(ctx context.Context) (*[]*Log, error) {
 	if block == nil {
 		return nil, nil
 	}
+	if t.stateSync {
+		return t.getStateSyncLogs(ctx)
+	}
 	h, err := block.Hash(ctx)
 	if err != nil {
 		return nil, err
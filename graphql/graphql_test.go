@@ -439,6 +439,96 @@ func TestWithdrawals(t *testing.T) {
 	}
 }
 
+func TestGraphQLBor(t *testing.T) {
+	var (
+		key, _ = crypto.GenerateKey()
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+
+		genesis = &core.Genesis{
+			Config:     params.AllEthashProtocolChanges,
+			GasLimit:   11500000,
+			Difficulty: big.NewInt(1048576),
+			Alloc: types.GenesisAlloc{
+				addr: {Balance: big.NewInt(params.Ether)},
+			},
+		}
+		signer = types.LatestSigner(genesis.Config)
+		stack  = createNode(t)
+	)
+	defer stack.Close()
+
+	ethBackend, chain := newGQLBackend(t, stack, false, genesis, 2, func(i int, gen *core.BlockGen) {
+		gen.SetCoinbase(common.Address{1})
+		tx, _ := types.SignNewTx(key, signer, &types.LegacyTx{Nonce: uint64(i), To: &common.Address{}, Gas: 100000, GasPrice: big.NewInt(params.InitialBaseFee)})
+		gen.AddTx(tx)
+	})
+	// Store the state syncs of the second block
+	var (
+		block  = chain[1]
+		txHash = types.GetDerivedBorTxHash(types.BorReceiptKey(block.NumberU64(), block.Hash()))
+	)
+	rawdb.WriteBorReceipt(ethBackend.ChainDb(), block.Hash(), block.NumberU64(), &types.ReceiptForStorage{
+		Status: types.ReceiptStatusSuccessful,
+		Logs:   []*types.Log{{Address: common.Address{2}, Data: []byte{1}}},
+	})
+	rawdb.WriteBorTxLookupEntry(ethBackend.ChainDb(), block.Hash(), block.NumberU64())
+
+	filterSystem := filters.NewFilterSystem(ethBackend.APIBackend, filters.Config{})
+	handler, err := newHandler(stack, ethBackend.APIBackend, filterSystem, []string{}, []string{})
+	if err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+
+	for i, tt := range []struct {
+		body string
+		want string
+		err  string
+	}{
+		{
+			body: "{block(number: 1) { author { address } stateSyncTransaction { hash } txDependency } }",
+			want: `{"block":{"author":{"address":"0x0100000000000000000000000000000000000000"},"stateSyncTransaction":null,"txDependency":null}}`,
+		},
+		{
+			body: "{block(number: 2) { stateSyncTransaction { hash index status logs { account { address } data } block { number } } } }",
+			want: fmt.Sprintf(`{"block":{"stateSyncTransaction":{"hash":"%s","index":"0x1","status":"0x1","logs":[{"account":{"address":"0x0200000000000000000000000000000000000000"},"data":"0x01"}],"block":{"number":"0x2"}}}}`, txHash.Hex()),
+		},
+		{
+			body: fmt.Sprintf(`{transaction(hash: "%s") { index status block { number } } }`, txHash.Hex()),
+			want: `{"transaction":{"index":"0x1","status":"0x1","block":{"number":"0x2"}}}`,
+		},
+		{
+			body: "{milestone { number } finalized { number } }",
+			want: `{"milestone":null,"finalized":null}`,
+		},
+		{
+			body: "{span(id: 1) { id } }",
+			err:  "only available on bor chains",
+		},
+		{
+			body: "{currentValidators { address } }",
+			err:  "only available on bor chains",
+		},
+	} {
+		res := handler.Schema.Exec(context.Background(), tt.body, "", map[string]interface{}{})
+		if tt.err != "" {
+			if len(res.Errors) == 0 || res.Errors[0].Message != tt.err {
+				t.Errorf("unexpected errors for testcase #%d: have %v, want %s", i, res.Errors, tt.err)
+			}
+			continue
+		}
+		if res.Errors != nil {
+			t.Fatalf("failed to execute query for testcase #%d: %v", i, res.Errors)
+		}
+		have, err := json.Marshal(res.Data)
+		if err != nil {
+			t.Fatalf("failed to encode graphql response for testcase #%d: %s", i, err)
+		}
+		if string(have) != tt.want {
+			t.Errorf("response unmatch for testcase #%d.\nhave:\n%s\nwant:\n%s", i, have, tt.want)
+		}
+	}
+}
+
 func createNode(t *testing.T) *node.Node {
 	t.Helper()
 	stack, err := node.New(&node.Config{
@@ -455,6 +545,18 @@ func createNode(t *testing.T) *node.Node {
 }
 
 func newGQLService(t *testing.T, stack *node.Node, shanghai bool, gspec *core.Genesis, genBlocks int, genfunc func(i int, gen *core.BlockGen)) (*handler, []*types.Block) {
+	t.Helper()
+	ethBackend, chain := newGQLBackend(t, stack, shanghai, gspec, genBlocks, genfunc)
+	// Set up handler
+	filterSystem := filters.NewFilterSystem(ethBackend.APIBackend, filters.Config{})
+	handler, err := newHandler(stack, ethBackend.APIBackend, filterSystem, []string{}, []string{})
+	if err != nil {
+		t.Fatalf("could not create graphql service: %v", err)
+	}
+	return handler, chain
+}
+
+func newGQLBackend(t *testing.T, stack *node.Node, shanghai bool, gspec *core.Genesis, genBlocks int, genfunc func(i int, gen *core.BlockGen)) (*eth.Ethereum, []*types.Block) {
 	t.Helper()
 	ethConf := &ethconfig.Config{
 		Genesis:        gspec,
@@ -487,11 +589,5 @@ func newGQLService(t *testing.T, stack *node.Node, shanghai bool, gspec *core.Ge
 	if err != nil {
 		t.Fatalf("could not create import blocks: %v", err)
 	}
-	// Set up handler
-	filterSystem := filters.NewFilterSystem(ethBackend.APIBackend, filters.Config{})
-	handler, err := newHandler(stack, ethBackend.APIBackend, filterSystem, []string{}, []string{})
-	if err != nil {
-		t.Fatalf("could not create graphql service: %v", err)
-	}
-	return handler, chain
+	return ethBackend, chain
 }
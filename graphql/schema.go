@@ -279,6 +279,16 @@ const schema string = `
         blobGasUsed: Long
         # ExcessBlobGas is a running total of blob gas consumed in excess of the target, prior to the block.
         excessBlobGas: Long
+        # Author is the account that sealed this block. On bor chains it is
+        # recovered from the signature in the extra data, unlike miner.
+        author(block: Long): Account
+        # StateSyncTransaction is the bor transaction committing the state syncs
+        # of this block. If the block has no state syncs, this field will be null.
+        stateSyncTransaction: Transaction
+        # TxDependency is the dependency of each transaction of this block on
+        # the preceding ones, as recorded by the block producer for parallel
+        # execution. If the block has none, this field will be null.
+        txDependency: [[Long!]!]
     }
 
     # CallData represents the data associated with a local contract call.
@@ -362,6 +372,47 @@ const schema string = `
         estimateGas(data: CallData!): Long!
     }
 
+    # Validator is a validator of a bor span.
+    type Validator {
+        # ID is the validator ID on the root chain.
+        id: Long!
+        # Address is the signer address of the validator.
+        address: Address!
+        # VotingPower is the stake based voting power of the validator.
+        votingPower: Long!
+        # ProposerPriority is the accumulated priority used to select proposers,
+        # it can be negative.
+        proposerPriority: BigInt!
+    }
+
+    # Span is a range of bor blocks produced by a validator set, as committed
+    # by heimdall.
+    type Span {
+        # ID is the span number.
+        id: Long!
+        # StartBlock is the first block of the span.
+        startBlock: Long!
+        # EndBlock is the last block of the span.
+        endBlock: Long!
+        # Validators is the validator set of the span.
+        validators: [Validator!]!
+        # SelectedProducers is the list of validators selected to produce blocks
+        # in the span.
+        selectedProducers: [Validator!]!
+        # ChainID is the bor chain ID of the span.
+        chainID: String!
+    }
+
+    # Milestone is the latest milestone whitelisted by the node.
+    type Milestone {
+        # Number is the number of the last block of the milestone.
+        number: Long!
+        # Hash is the hash of the last block of the milestone.
+        hash: Bytes32!
+        # Block is the last block of the milestone.
+        block: Block
+    }
+
     type Query {
         # Block fetches an Ethereum block by number or by hash. If neither is
         # supplied, the most recent known block is returned.
@@ -385,6 +436,17 @@ const schema string = `
         syncing: SyncState
         # ChainID returns the current chain ID for transaction replay protection.
         chainID: BigInt!
+        # Span fetches a bor span by number from heimdall.
+        span(id: Long!): Span
+        # CurrentValidators returns the validator set of the most recent known
+        # block of a bor chain.
+        currentValidators: [Validator!]!
+        # Milestone returns the latest milestone whitelisted by the node, or
+        # null if there is none.
+        milestone: Milestone
+        # Finalized returns the most recent block finalized by a milestone or a
+        # checkpoint, or null if there is none.
+        finalized: Block
     }
 
     type Mutation {
@@ -18,6 +18,7 @@ func (c *ChainCommand) MarkDown() string {
 		"The ```chain``` command groups actions to interact with the blockchain in the client:",
 		"- [```chain sethead```](./chain_sethead.md): Set the current chain to a certain block.",
 		"- [```chain watch```](./chain_watch.md): Watch the chainHead, reorg and fork events in real-time.",
+		"- [```chain init```](./chain_init.md): Create the genesis of a custom chain.",
 	}
 
 	return strings.Join(items, "\n\n")
@@ -31,7 +32,11 @@ func (c *ChainCommand) Help() string {
 
   Set the new head of the chain:
 
-    $ bor chain sethead <number>`
+    $ bor chain sethead <number>
+
+  Create the genesis of a custom chain:
+
+    $ bor chain init [options] <name>`
 }
 
 // Synopsis implements the cli.Command interface
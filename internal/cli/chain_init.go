@@ -0,0 +1,270 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server"
+	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
+
+	"github.com/mitchellh/cli"
+)
+
+// ChainInitCommand is the command to create the genesis of a custom chain
+type ChainInitCommand struct {
+	UI cli.Ui
+
+	datadir    string
+	output     string
+	validators []string
+	alloc      []string
+	bootnodes  []string
+	burnt      string
+	config     chains.CustomChainConfig
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *ChainInitCommand) MarkDown() string {
+	items := []string{
+		"# Chain init",
+		"The ```chain init <name>``` command creates the genesis of a custom bor chain (e.g. a private devnet) " +
+			"from a list of validators and the consensus parameters. The genesis holds the validator set, state receiver " +
+			"and MRC20 contracts and the validators of the first span. The chain is written to ```<datadir>/chains/<name>.json``` " +
+			"and can be used with ```bor server --chain <name>```.",
+		"## Arguments",
+		"- ```name```: The name of the chain.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *ChainInitCommand) Help() string {
+	return `Usage: bor chain init [options] <name>
+
+  Create the genesis of a custom chain:
+
+    $ bor chain init --chain-id 4242 --validators 0x...:10000,0x...:10000 devnet
+
+  ` + c.Flags().Help()
+}
+
+func (c *ChainInitCommand) Flags() *flagset.Flagset {
+	flags := flagset.NewFlagSet("chain init")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "datadir",
+		Usage: "Path of the data directory to store the chain in",
+		Value: &c.datadir,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "output",
+		Usage: "Path of the chain file (default: <datadir>/chains/<name>.json)",
+		Value: &c.output,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:  "chain-id",
+		Usage: "Chain id and network id of the chain",
+		Value: &c.config.ChainID,
+	})
+	flags.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:  "validators",
+		Usage: "Comma separated validators of the first span as address:power",
+		Value: &c.validators,
+	})
+	flags.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:  "alloc",
+		Usage: "Comma separated prefunded accounts as address:balance (in wei)",
+		Value: &c.alloc,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "period",
+		Usage:   "Number of seconds between blocks",
+		Value:   &c.config.Period,
+		Default: 2,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "producer-delay",
+		Usage:   "Delay in seconds of the first block of a sprint",
+		Value:   &c.config.ProducerDelay,
+		Default: 4,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "sprint",
+		Usage:   "Number of blocks produced by the same validator",
+		Value:   &c.config.Sprint,
+		Default: 16,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "backup-multiplier",
+		Usage:   "Multiplier of the wiggle time of backup producers",
+		Value:   &c.config.BackupMultiplier,
+		Default: 2,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "state-sync-delay",
+		Usage:   "Confirmation delay in seconds of the state sync events",
+		Value:   &c.config.StateSyncConfirmationDelay,
+		Default: 128,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "burnt-contract",
+		Usage:   "Address receiving the burnt base fees",
+		Value:   &c.burnt,
+		Default: "0x000000000000000000000000000000000000dead",
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "gas-limit",
+		Usage:   "Gas limit of the genesis block",
+		Value:   &c.config.GasLimit,
+		Default: 30_000_000,
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:  "timestamp",
+		Usage: "Timestamp of the genesis block (default: now)",
+		Value: &c.config.Timestamp,
+	})
+	flags.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:  "bootnodes",
+		Usage: "Comma separated enode URLs of the bootnodes of the chain",
+		Value: &c.bootnodes,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *ChainInitCommand) Synopsis() string {
+	return "Create the genesis of a custom chain"
+}
+
+// Run implements the cli.Command interface
+func (c *ChainInitCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("No chain name provided")
+		return 1
+	}
+
+	name := args[0]
+	if err := chains.ValidateCustomChainName(name); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if err := c.parse(); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	chain, err := chains.NewCustomChain(&c.config)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	datadir := c.datadir
+	if datadir == "" {
+		datadir = server.DefaultDataDir()
+	}
+
+	path := c.output
+	if path == "" {
+		path = chains.CustomChainPath(datadir, name)
+	}
+
+	if _, err := os.Stat(path); err == nil {
+		c.UI.Error(fmt.Sprintf("Chain file %s already exists", path))
+		return 1
+	}
+
+	if err := chains.WriteCustomChain(path, chain); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(formatKV([]string{
+		fmt.Sprintf("Name|%s", name),
+		fmt.Sprintf("Path|%s", path),
+		fmt.Sprintf("Chain id|%d", c.config.ChainID),
+		fmt.Sprintf("Validators|%d", len(c.config.Validators)),
+		fmt.Sprintf("Genesis|%s", chain.Hash),
+	}))
+
+	return 0
+}
+
+// parse fills the chain config with the list flags
+func (c *ChainInitCommand) parse() error {
+	for _, val := range c.validators {
+		addr, value, err := parseAddressValue(val)
+		if err != nil {
+			return fmt.Errorf("invalid validator %q: %v", val, err)
+		}
+
+		power, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid validator %q: %v", val, err)
+		}
+
+		c.config.Validators = append(c.config.Validators, chains.GenesisValidator{Address: addr, Power: power})
+	}
+
+	c.config.Alloc = map[common.Address]*big.Int{}
+
+	for _, account := range c.alloc {
+		addr, value, err := parseAddressValue(account)
+		if err != nil {
+			return fmt.Errorf("invalid alloc %q: %v", account, err)
+		}
+
+		balance, ok := math.ParseBig256(value)
+		if !ok {
+			return fmt.Errorf("invalid alloc %q: invalid balance", account)
+		}
+
+		c.config.Alloc[addr] = balance
+	}
+
+	if !common.IsHexAddress(c.burnt) {
+		return fmt.Errorf("invalid burnt contract %q", c.burnt)
+	}
+
+	c.config.BurntContract = common.HexToAddress(c.burnt)
+	c.config.Bootnodes = c.bootnodes
+
+	if c.config.Timestamp == 0 {
+		c.config.Timestamp = uint64(time.Now().Unix())
+	}
+
+	return nil
+}
+
+// parseAddressValue parses an address:value pair
+func parseAddressValue(s string) (common.Address, string, error) {
+	addr, value, ok := strings.Cut(s, ":")
+	if !ok {
+		return common.Address{}, "", errors.New("expected address:value")
+	}
+
+	if !common.IsHexAddress(addr) {
+		return common.Address{}, "", fmt.Errorf("invalid address %s", addr)
+	}
+
+	return common.HexToAddress(addr), value, nil
+}
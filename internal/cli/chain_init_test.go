@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
+)
+
+func TestChainInitCommand(t *testing.T) {
+	t.Parallel()
+
+	datadir := t.TempDir()
+
+	ui := cli.NewMockUi()
+	cmd := &ChainInitCommand{UI: ui}
+
+	args := []string{
+		"--datadir", datadir,
+		"--chain-id", "4242",
+		"--validators", "0x00000000000000000000000000000000000000a1:10,0x00000000000000000000000000000000000000b2:20",
+		"--alloc", "0x00000000000000000000000000000000000000c3:1000000000000000000",
+		"--sprint", "8",
+		"devnet",
+	}
+
+	require.Equal(t, 0, cmd.Run(args), ui.ErrorWriter.String())
+	require.Contains(t, ui.OutputWriter.String(), filepath.Join(datadir, "chains", "devnet.json"))
+
+	chain, err := chains.GetCustomChain(datadir, "devnet")
+	require.NoError(t, err)
+	require.NotNil(t, chain)
+	require.Equal(t, uint64(4242), chain.NetworkId)
+	require.Equal(t, uint64(8), chain.Genesis.Config.Bor.CalculateSprint(0))
+
+	// existing chains are not overwritten
+	ui = cli.NewMockUi()
+	cmd = &ChainInitCommand{UI: ui}
+
+	require.Equal(t, 1, cmd.Run(args))
+	require.Contains(t, ui.ErrorWriter.String(), "already exists")
+
+	// invalid validators are rejected
+	ui = cli.NewMockUi()
+	cmd = &ChainInitCommand{UI: ui}
+
+	require.Equal(t, 1, cmd.Run([]string{"--datadir", datadir, "--chain-id", "4242", "--validators", "0xa1", "other"}))
+	require.Contains(t, ui.ErrorWriter.String(), "invalid validator")
+}
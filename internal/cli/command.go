@@ -103,6 +103,21 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta2: meta2,
 			}, nil
 		},
+		"debug trace": func() (MarkDownCommand, error) {
+			return &DebugTraceCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"debug verbosity": func() (MarkDownCommand, error) {
+			return &DebugVerbosityCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"debug vmodule": func() (MarkDownCommand, error) {
+			return &DebugVmoduleCommand{
+				Meta2: meta2,
+			}, nil
+		},
 		"chain": func() (MarkDownCommand, error) {
 			return &ChainCommand{
 				UI: ui,
@@ -118,6 +133,11 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta2: meta2,
 			}, nil
 		},
+		"chain init": func() (MarkDownCommand, error) {
+			return &ChainInitCommand{
+				UI: ui,
+			}, nil
+		},
 		"account": func() (MarkDownCommand, error) {
 			return &Account{
 				UI: ui,
@@ -163,6 +183,81 @@ func Commands() map[string]MarkDownCommandFactory {
 				Meta2: meta2,
 			}, nil
 		},
+		"txpool": func() (MarkDownCommand, error) {
+			return &TxPoolCommand{
+				UI: ui,
+			}, nil
+		},
+		"txpool status": func() (MarkDownCommand, error) {
+			return &TxPoolStatusCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"txpool inspect": func() (MarkDownCommand, error) {
+			return &TxPoolInspectCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"txpool drop": func() (MarkDownCommand, error) {
+			return &TxPoolDropCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"miner": func() (MarkDownCommand, error) {
+			return &MinerCommand{
+				UI: ui,
+			}, nil
+		},
+		"miner start": func() (MarkDownCommand, error) {
+			return &MinerStartCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"miner stop": func() (MarkDownCommand, error) {
+			return &MinerStopCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"miner gasceil": func() (MarkDownCommand, error) {
+			return &MinerGasCeilCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"whitelist": func() (MarkDownCommand, error) {
+			return &WhitelistCommand{
+				UI: ui,
+			}, nil
+		},
+		"whitelist status": func() (MarkDownCommand, error) {
+			return &WhitelistStatusCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"whitelist purge": func() (MarkDownCommand, error) {
+			return &WhitelistPurgeCommand{
+				Meta2: meta2,
+			}, nil
+		},
+		"config": func() (MarkDownCommand, error) {
+			return &ConfigCommand{
+				UI: ui,
+			}, nil
+		},
+		"config check": func() (MarkDownCommand, error) {
+			return &ConfigCheckCommand{
+				UI: ui,
+			}, nil
+		},
+		"config migrate": func() (MarkDownCommand, error) {
+			return &ConfigMigrateCommand{
+				UI: ui,
+			}, nil
+		},
+		"config reload": func() (MarkDownCommand, error) {
+			return &ConfigReloadCommand{
+				Meta2: meta2,
+			}, nil
+		},
 		"status": func() (MarkDownCommand, error) {
 			return &StatusCommand{
 				Meta2: meta2,
@@ -185,6 +280,11 @@ func Commands() map[string]MarkDownCommandFactory {
 				UI: ui,
 			}, nil
 		},
+		"light": func() (MarkDownCommand, error) {
+			return &LightCommand{
+				UI: ui,
+			}, nil
+		},
 		"removedb": func() (MarkDownCommand, error) {
 			return &RemoveDBCommand{
 				Meta2: meta2,
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// ConfigCommand is the command to group the config commands
+type ConfigCommand struct {
+	UI cli.Ui
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *ConfigCommand) MarkDown() string {
+	items := []string{
+		"# Config",
+		"The ```config``` command groups actions to manage the configuration of the node:",
+		"- [```config check```](./config_check.md): Validates a config file.",
+		"- [```config migrate```](./config_migrate.md): Rewrites a config file into the current layout.",
+		"- [```config reload```](./config_reload.md): Reloads the configuration of a running node.",
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *ConfigCommand) Help() string {
+	return `Usage: bor config <subcommand>
+
+  This command groups actions to manage the configuration of the node.
+
+  Validate a config file:
+
+    $ bor config check <file>
+
+  Rewrite a config file into the current layout:
+
+    $ bor config migrate <file>
+
+  Reload the configuration of a running node:
+
+    $ bor config reload`
+}
+
+// Synopsis implements the cli.Command interface
+func (c *ConfigCommand) Synopsis() string {
+	return "Manage the configuration of the node"
+}
+
+// Run implements the cli.Command interface
+func (c *ConfigCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server"
+
+	"github.com/mitchellh/cli"
+)
+
+// ConfigCheckCommand is the command to validate a config file
+type ConfigCheckCommand struct {
+	UI cli.Ui
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *ConfigCheckCommand) MarkDown() string {
+	items := []string{
+		"# Config check",
+		"The ```config check <file>``` command validates a config file (hcl or toml) with the same loader as ```bor server```. " +
+			"It reports syntax errors, unknown keys, invalid values and port collisions as errors, and deprecated keys and conflicting settings " +
+			"(e.g. a heimdall url together with ```bor.without```) as warnings, along with their position in the file. " +
+			"The command exits with a non-zero status if any error is found.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *ConfigCheckCommand) Help() string {
+	return `Usage: bor config check <file>
+
+  Validates a config file and reports errors and warnings.
+
+  ` + c.Flags().Help()
+}
+
+func (c *ConfigCheckCommand) Flags() *flagset.Flagset {
+	return flagset.NewFlagSet("config check")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *ConfigCheckCommand) Synopsis() string {
+	return "Validate a config file"
+}
+
+// Run implements the cli.Command interface
+func (c *ConfigCheckCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("No config file provided")
+		return 1
+	}
+
+	path := args[0]
+
+	issues, err := server.CheckConfigFile(path)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	var errs, warnings int
+
+	for _, issue := range issues {
+		if issue.Warning {
+			warnings++
+
+			c.UI.Warn(fmt.Sprintf("%s: warning: %s", issue.Position(path), issue.Message))
+		} else {
+			errs++
+
+			c.UI.Error(fmt.Sprintf("%s: error: %s", issue.Position(path), issue.Message))
+		}
+	}
+
+	c.UI.Output(fmt.Sprintf("%d error(s), %d warning(s)", errs, warnings))
+
+	if errs != 0 {
+		return 1
+	}
+
+	return 0
+}
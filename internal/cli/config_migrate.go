@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"os"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server"
+
+	"github.com/mitchellh/cli"
+)
+
+// ConfigMigrateCommand is the command to rewrite a config file in the current layout
+type ConfigMigrateCommand struct {
+	UI cli.Ui
+
+	output string
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *ConfigMigrateCommand) MarkDown() string {
+	items := []string{
+		"# Config migrate",
+		"The ```config migrate <file>``` command rewrites a config file (hcl or toml) into the current toml layout. " +
+			"Keys of older layouts are moved to their current place (e.g. ```p2p.bootnodes``` to ```p2p.discovery.bootnodes```), " +
+			"dotted keys written without quotes are quoted, unknown keys are removed and every key is commented with its usage. " +
+			"The changes are reported on stderr.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *ConfigMigrateCommand) Help() string {
+	return `Usage: bor config migrate <file>
+
+  Rewrites a config file into the current toml layout.
+
+  ` + c.Flags().Help()
+}
+
+func (c *ConfigMigrateCommand) Flags() *flagset.Flagset {
+	flags := flagset.NewFlagSet("config migrate")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "output",
+		Value: &c.output,
+		Usage: "Path of the migrated config file (default: stdout)",
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *ConfigMigrateCommand) Synopsis() string {
+	return "Rewrite a config file into the current layout"
+}
+
+// Run implements the cli.Command interface
+func (c *ConfigMigrateCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("No config file provided")
+		return 1
+	}
+
+	data, notes, err := server.MigrateConfigFile(args[0])
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	for _, note := range notes {
+		c.UI.Warn(note)
+	}
+
+	if c.output == "" {
+		c.UI.Output(strings.TrimSuffix(string(data), "\n"))
+		return 0
+	}
+
+	if err := os.WriteFile(c.output, data, 0600); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// ConfigReloadCommand is the command to reload the configuration of the node
+type ConfigReloadCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *ConfigReloadCommand) MarkDown() string {
+	items := []string{
+		"# Config reload",
+		"The ```config reload``` command re-reads the config file and the flags of a running node and applies the changed fields which do not require a restart. " +
+			"These are the logging verbosity, the txpool limits, the gas price oracle, the rpc batch and return data limits, " +
+			"the miner gas ceiling, extra data and recommit interval, and the static and trusted nodes. " +
+			"Sending ```SIGHUP``` to the node has the same effect.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *ConfigReloadCommand) Help() string {
+	return `Usage: bor config reload
+
+  Reloads the configuration of a running node. Sending SIGHUP to the node has the same effect.
+
+  ` + c.Flags().Help()
+}
+
+func (c *ConfigReloadCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("config reload")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *ConfigReloadCommand) Synopsis() string {
+	return "Reload the configuration of a running node"
+}
+
+// Run implements the cli.Command interface
+func (c *ConfigReloadCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	resp, err := borClt.ConfigReload(context.Background(), &proto.ConfigReloadRequest{})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	applied := resp.Applied
+	if len(applied) == 0 {
+		applied = []string{emptyPlaceHolder}
+	}
+
+	restart := resp.RestartRequired
+	if len(restart) == 0 {
+		restart = []string{emptyPlaceHolder}
+	}
+
+	ignored := resp.Ignored
+	if len(ignored) == 0 {
+		ignored = []string{emptyPlaceHolder}
+	}
+
+	c.UI.Output("Applied:")
+	c.UI.Output(formatList(applied))
+	c.UI.Output("\nRestart required:")
+	c.UI.Output(formatList(restart))
+	c.UI.Output("\nIgnored:")
+	c.UI.Output(formatList(ignored))
+
+	return 0
+}
@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"os"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigCheckCommand(t *testing.T) {
+	t.Parallel()
+
+	ui := cli.NewMockUi()
+	cmd := &ConfigCheckCommand{UI: ui}
+
+	require.Equal(t, 1, cmd.Run([]string{"./server/testdata/check/legacy.toml"}))
+	require.Contains(t, ui.ErrorWriter.String(), "legacy.toml:18: error: unknown key txpool.unknown")
+	require.Contains(t, ui.ErrorWriter.String(), "legacy.toml:7: warning: p2p.bootnodes is not read anymore")
+	require.Contains(t, ui.OutputWriter.String(), "2 error(s), 4 warning(s)")
+
+	ui = cli.NewMockUi()
+	cmd = &ConfigCheckCommand{UI: ui}
+
+	require.Equal(t, 0, cmd.Run([]string{"./server/testdata/check/valid.hcl"}))
+	require.Equal(t, 1, cmd.Run([]string{}))
+}
+
+func TestConfigMigrateCommand(t *testing.T) {
+	t.Parallel()
+
+	output := t.TempDir() + "/config.toml"
+
+	ui := cli.NewMockUi()
+	cmd := &ConfigMigrateCommand{UI: ui}
+
+	require.Equal(t, 0, cmd.Run([]string{"--output", output, "./server/testdata/check/legacy.toml"}))
+	require.Contains(t, ui.ErrorWriter.String(), "moved p2p.bootnodes to p2p.discovery.bootnodes")
+
+	_, err := os.Stat(output)
+	require.NoError(t, err)
+
+	// the migrated file has no deprecated keys left
+	ui = cli.NewMockUi()
+	check := &ConfigCheckCommand{UI: ui}
+
+	check.Run([]string{output})
+	require.NotContains(t, ui.ErrorWriter.String(), "bor config migrate")
+}
@@ -50,6 +50,9 @@ func (c *DebugCommand) MarkDown() string {
 		"The ```bor debug``` command takes a debug dump of the running client.",
 		"- [```bor debug pprof```](./debug_pprof.md): Dumps bor pprof traces.",
 		"- [```bor debug block <number>```](./debug_block.md): Dumps bor block traces.",
+		"- [```bor debug trace <hash>```](./debug_trace.md): Dumps a transaction trace.",
+		"- [```bor debug verbosity <level>```](./debug_verbosity.md): Changes the log level.",
+		"- [```bor debug vmodule <pattern>```](./debug_vmodule.md): Changes the per-module log verbosity.",
 	}
 	items = append(items, examples...)
 
@@ -68,7 +71,19 @@ func (c *DebugCommand) Help() string {
 
 	Get the block traces:
 
-		$ bor debug block <number>`
+		$ bor debug block <number>
+
+	Get the trace of a transaction:
+
+		$ bor debug trace <hash>
+
+	Change the log level:
+
+		$ bor debug verbosity <level>
+
+	Change the per-module log verbosity:
+
+		$ bor debug vmodule <pattern>`
 }
 
 // Synopsis implements the cli.Command interface
@@ -0,0 +1,135 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// DebugTraceCommand is the command to trace a transaction
+type DebugTraceCommand struct {
+	*Meta2
+
+	tracer       string
+	tracerConfig string
+	timeout      string
+	output       string
+}
+
+func (c *DebugTraceCommand) MarkDown() string {
+	items := []string{
+		"# Debug trace",
+		"The ```bor debug trace <hash>``` command will create an archive containing the trace of a transaction.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *DebugTraceCommand) Help() string {
+	return `Usage: bor debug trace <hash>
+
+  This command is used to trace a transaction
+
+  ` + c.Flags().Help()
+}
+
+func (c *DebugTraceCommand) Flags() *flagset.Flagset {
+	flags := c.NewFlagSet("debug trace")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "tracer",
+		Value: &c.tracer,
+		Usage: "Name of the tracer to run (e.g. callTracer). The struct logger is used if empty",
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "tracer-config",
+		Value: &c.tracerConfig,
+		Usage: "JSON encoded configuration of the tracer",
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "timeout",
+		Value: &c.timeout,
+		Usage: "Timeout of the trace execution (e.g. 10s)",
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "output",
+		Value: &c.output,
+		Usage: "Output directory",
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DebugTraceCommand) Synopsis() string {
+	return "Get trace of a transaction"
+}
+
+// Run implements the cli.Command interface
+func (c *DebugTraceCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("No transaction hash provided")
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	dEnv := &debugEnv{
+		output: c.output,
+		prefix: "bor-tx-trace-",
+	}
+	if err := dEnv.init(); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Starting transaction tracer...")
+	c.UI.Output("")
+
+	req := &proto.DebugTraceTransactionRequest{
+		Hash:         args[0],
+		Tracer:       c.tracer,
+		TracerConfig: c.tracerConfig,
+		Timeout:      c.timeout,
+	}
+
+	stream, err := borClt.DebugTraceTransaction(context.Background(), req)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if err := dEnv.writeFromStream("trace.json", stream); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if err := dEnv.finish(); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if c.output != "" {
+		c.UI.Output(fmt.Sprintf("Created debug directory: %s", dEnv.dst))
+	} else {
+		c.UI.Output(fmt.Sprintf("Created transaction trace archive: %s", dEnv.tarName()))
+	}
+
+	return 0
+}
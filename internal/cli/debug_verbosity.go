@@ -0,0 +1,102 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// DebugVerbosityCommand is the command to change the log level
+type DebugVerbosityCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *DebugVerbosityCommand) MarkDown() string {
+	items := []string{
+		"# Debug verbosity",
+		"The ```debug verbosity <level>``` command changes the log level of the running client.",
+		"## Arguments",
+		"- ```level```: The log level, either by name (crit, error, warn, info, debug, trace) or by number (0-5).",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *DebugVerbosityCommand) Help() string {
+	return `Usage: bor debug verbosity <level>
+
+  Changes the log level of the running client.
+
+  ` + c.Flags().Help()
+}
+
+func (c *DebugVerbosityCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("debug verbosity")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DebugVerbosityCommand) Synopsis() string {
+	return "Change the log level"
+}
+
+// Run implements the cli.Command interface
+func (c *DebugVerbosityCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("No log level provided")
+		return 1
+	}
+
+	level, err := parseVerbosity(args[0])
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.DebugVerbosity(context.Background(), &proto.DebugVerbosityRequest{Level: int32(level)}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Done!")
+
+	return 0
+}
+
+// parseVerbosity parses a log level given either by name or by its legacy number.
+func parseVerbosity(level string) (int, error) {
+	if n, err := strconv.Atoi(level); err == nil {
+		if server.VerbosityIntToString(n) == "" {
+			return 0, fmt.Errorf("invalid log level: %s", level)
+		}
+
+		return n, nil
+	}
+
+	n := server.VerbosityStringToInt(strings.ToLower(level))
+	if server.VerbosityIntToString(n) != strings.ToLower(level) {
+		return 0, fmt.Errorf("invalid log level: %s", level)
+	}
+
+	return n, nil
+}
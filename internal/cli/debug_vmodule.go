@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// DebugVmoduleCommand is the command to change the per-module log levels
+type DebugVmoduleCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *DebugVmoduleCommand) MarkDown() string {
+	items := []string{
+		"# Debug vmodule",
+		"The ```debug vmodule <pattern>``` command changes the per-module log verbosity of the running client.",
+		"## Arguments",
+		"- ```pattern```: Comma separated list of ```<pattern>=<level>``` (e.g. ```eth/*=5,p2p=4```). An empty pattern resets it.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *DebugVmoduleCommand) Help() string {
+	return `Usage: bor debug vmodule <pattern>
+
+  Changes the per-module log verbosity of the running client.
+
+  ` + c.Flags().Help()
+}
+
+func (c *DebugVmoduleCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("debug vmodule")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *DebugVmoduleCommand) Synopsis() string {
+	return "Change the per-module log verbosity"
+}
+
+// Run implements the cli.Command interface
+func (c *DebugVmoduleCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) > 1 {
+		c.UI.Error("Too many arguments provided")
+		return 1
+	}
+
+	var pattern string
+	if len(args) == 1 {
+		pattern = args[0]
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.DebugVmodule(context.Background(), &proto.DebugVmoduleRequest{Pattern: pattern}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Done!")
+
+	return 0
+}
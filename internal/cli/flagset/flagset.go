@@ -74,17 +74,20 @@ func (f *Flagset) Help() string {
 }
 
 func (f *Flagset) GetAllFlags() []string {
-	i := 0
 	flags := make([]string, 0, len(f.flags))
 
 	for name := range f.flags {
-		flags[i] = name
-		i++
+		flags = append(flags, name)
 	}
 
 	return flags
 }
 
+// GetFlag returns the flag with the given name or nil if it does not exist
+func (f *Flagset) GetFlag(name string) *FlagVar {
+	return f.flags[name]
+}
+
 // MarkDown implements cli.MarkDown interface
 func (f *Flagset) MarkDown() string {
 	if len(f.flags) == 0 {
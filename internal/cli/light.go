@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdall"
+	"github.com/ethereum/go-ethereum/consensus/bor/light"
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/mitchellh/cli"
+)
+
+// LightCommand is the command to run a bor light client
+type LightCommand struct {
+	UI cli.Ui
+
+	chain     string
+	upstream  string
+	heimdall  string
+	httpHost  string
+	httpPort  uint64
+	verbosity int
+}
+
+// MarkDown implements cli.MarkDown interface
+func (l *LightCommand) MarkDown() string {
+	items := []string{
+		"# Light",
+		"The ```light``` command runs a light client following the headers of an upstream full node. The headers are verified against the validator sets of the heimdall spans and anchored to the heimdall milestones, without executing the state.",
+		"The verified headers are served through ```eth_getBlockByNumber```, ```eth_getBlockByHash```, ```eth_getHeaderByNumber``` and ```eth_getHeaderByHash```, without the transactions. ```eth_getProof``` requests are forwarded to the upstream node, and the proofs are verified against the state root of the headers.",
+		l.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (l *LightCommand) Help() string {
+	return `Usage: bor light --upstream <url>
+
+  Run a light client following the headers of an upstream full node
+
+  ` + l.Flags().Help()
+}
+
+func (l *LightCommand) Flags() *flagset.Flagset {
+	flags := flagset.NewFlagSet("light")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "chain",
+		Usage:   "Name of the chain to follow ('mainnet', 'amoy') or path to a genesis file",
+		Value:   &l.chain,
+		Default: "mainnet",
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "upstream",
+		Usage: "URL of the RPC endpoint of the full node serving the headers and the proofs",
+		Value: &l.upstream,
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "bor.heimdall",
+		Usage:   "URL of Heimdall service",
+		Value:   &l.heimdall,
+		Default: "http://localhost:1317",
+	})
+	flags.StringFlag(&flagset.StringFlag{
+		Name:    "http.addr",
+		Usage:   "HTTP-RPC server listening interface",
+		Value:   &l.httpHost,
+		Default: "localhost",
+	})
+	flags.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "http.port",
+		Usage:   "HTTP-RPC server listening port",
+		Value:   &l.httpPort,
+		Default: 8545,
+	})
+	flags.IntFlag(&flagset.IntFlag{
+		Name:    "verbosity",
+		Usage:   "Logging verbosity (5=trace|4=debug|3=info|2=warn|1=error|0=crit)",
+		Value:   &l.verbosity,
+		Default: 3,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (l *LightCommand) Synopsis() string {
+	return "Run a light client verifying the headers of an upstream node"
+}
+
+// Run implements the cli.Command interface
+func (l *LightCommand) Run(args []string) int {
+	flags := l.Flags()
+	if err := flags.Parse(args); err != nil {
+		l.UI.Error(err.Error())
+		return 1
+	}
+
+	if l.upstream == "" {
+		l.UI.Error("upstream node is required")
+		return 1
+	}
+
+	glogger := log.NewGlogHandler(log.NewTerminalHandler(os.Stderr, false))
+	glogger.Verbosity(log.FromLegacyLevel(l.verbosity))
+	log.SetDefault(log.NewLogger(glogger))
+
+	chain, err := chains.GetChain(l.chain)
+	if err != nil {
+		l.UI.Error(err.Error())
+		return 1
+	}
+
+	if chain.Genesis.Config.Bor == nil {
+		l.UI.Error(fmt.Sprintf("chain %s is not a bor chain", l.chain))
+		return 1
+	}
+
+	upstream, err := rpc.DialContext(context.Background(), l.upstream)
+	if err != nil {
+		l.UI.Error(fmt.Sprintf("failed to dial upstream node: %v", err))
+		return 1
+	}
+	defer upstream.Close()
+
+	heimdallClient := heimdall.NewHeimdallClient(l.heimdall)
+	defer heimdallClient.Close()
+
+	client := light.New(chain.Genesis.Config, upstream, heimdallClient)
+	if err := client.Start(); err != nil {
+		l.UI.Error(fmt.Sprintf("failed to start light client: %v", err))
+		return 1
+	}
+	defer client.Stop()
+
+	srv := rpc.NewServer("", 0, 0)
+	if err := srv.RegisterName("eth", light.NewAPI(client)); err != nil {
+		l.UI.Error(err.Error())
+		return 1
+	}
+	defer srv.Stop()
+
+	httpServer := &http.Server{
+		Addr:              net.JoinHostPort(l.httpHost, strconv.FormatUint(l.httpPort, 10)),
+		Handler:           srv,
+		ReadHeaderTimeout: 30 * time.Second,
+	}
+
+	go func() {
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Error("Failure in running HTTP-RPC server", "err", err)
+		}
+	}()
+
+	log.Info("HTTP-RPC server started", "endpoint", httpServer.Addr)
+
+	signalCh := make(chan os.Signal, 4)
+	signal.Notify(signalCh, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+
+	sig := <-signalCh
+
+	l.UI.Output(fmt.Sprintf("Caught signal: %v", sig))
+	l.UI.Output("Gracefully shutting down light client...")
+
+	if err := httpServer.Shutdown(context.Background()); err != nil {
+		log.Error("Failed to shutdown HTTP-RPC server", "err", err)
+	}
+
+	return 0
+}
@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// MinerCommand is the command to group the miner commands
+type MinerCommand struct {
+	UI cli.Ui
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *MinerCommand) MarkDown() string {
+	items := []string{
+		"# Miner",
+		"The ```miner``` command groups actions to control block production:",
+		"- [```miner start```](./miner_start.md): Starts sealing new blocks.",
+		"- [```miner stop```](./miner_stop.md): Stops sealing new blocks.",
+		"- [```miner gasceil```](./miner_gasceil.md): Sets the gas ceiling targeted by new blocks.",
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *MinerCommand) Help() string {
+	return `Usage: bor miner <subcommand>
+
+  This command groups actions to control block production.
+
+  Start sealing new blocks:
+
+    $ bor miner start
+
+  Stop sealing new blocks:
+
+    $ bor miner stop
+
+  Set the gas ceiling targeted by new blocks:
+
+    $ bor miner gasceil <gas>`
+}
+
+// Synopsis implements the cli.Command interface
+func (c *MinerCommand) Synopsis() string {
+	return "Control block production"
+}
+
+// Run implements the cli.Command interface
+func (c *MinerCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// MinerGasCeilCommand is the command to set the block gas ceiling
+type MinerGasCeilCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *MinerGasCeilCommand) MarkDown() string {
+	items := []string{
+		"# Miner gasceil",
+		"The ```miner gasceil <gas>``` command sets the gas ceiling targeted by newly sealed blocks.",
+		"## Arguments",
+		"- ```gas```: The target gas ceiling.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *MinerGasCeilCommand) Help() string {
+	return `Usage: bor miner gasceil <gas>
+
+  Sets the gas ceiling targeted by newly sealed blocks.
+
+  ` + c.Flags().Help()
+}
+
+func (c *MinerGasCeilCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("miner gasceil")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *MinerGasCeilCommand) Synopsis() string {
+	return "Set the block gas ceiling"
+}
+
+// Run implements the cli.Command interface
+func (c *MinerGasCeilCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) != 1 {
+		c.UI.Error("No gas ceiling provided")
+		return 1
+	}
+
+	gasCeil, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.MinerSetGasCeil(context.Background(), &proto.MinerSetGasCeilRequest{GasCeil: gasCeil}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Done!")
+
+	return 0
+}
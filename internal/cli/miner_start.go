@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// MinerStartCommand is the command to start sealing blocks
+type MinerStartCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *MinerStartCommand) MarkDown() string {
+	items := []string{
+		"# Miner start",
+		"The ```miner start``` command starts sealing new blocks. The node must have an unlocked etherbase account.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *MinerStartCommand) Help() string {
+	return `Usage: bor miner start
+
+  Starts sealing new blocks.
+
+  ` + c.Flags().Help()
+}
+
+func (c *MinerStartCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("miner start")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *MinerStartCommand) Synopsis() string {
+	return "Start sealing new blocks"
+}
+
+// Run implements the cli.Command interface
+func (c *MinerStartCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.MinerStart(context.Background(), &proto.MinerStartRequest{}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Done!")
+
+	return 0
+}
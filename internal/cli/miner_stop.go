@@ -0,0 +1,67 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// MinerStopCommand is the command to stop sealing blocks
+type MinerStopCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *MinerStopCommand) MarkDown() string {
+	items := []string{
+		"# Miner stop",
+		"The ```miner stop``` command stops sealing new blocks.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *MinerStopCommand) Help() string {
+	return `Usage: bor miner stop
+
+  Stops sealing new blocks.
+
+  ` + c.Flags().Help()
+}
+
+func (c *MinerStopCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("miner stop")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *MinerStopCommand) Synopsis() string {
+	return "Stop sealing new blocks"
+}
+
+// Run implements the cli.Command interface
+func (c *MinerStopCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.MinerStop(context.Background(), &proto.MinerStopRequest{}); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Done!")
+
+	return 0
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/internal/cli/flagset"
 	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
@@ -89,7 +90,17 @@ func formatPeer(peer *proto.Peer) string {
 		fmt.Sprintf("Enode|%s", peer.Enode),
 		fmt.Sprintf("Static|%v", peer.Static),
 		fmt.Sprintf("Trusted|%v", peer.Trusted),
+		fmt.Sprintf("Score|%.2f", peer.Score),
+		fmt.Sprintf("Last ban expiry|%s", formatBannedUntil(peer.BannedUntil)),
 	})
 
 	return base
 }
+
+func formatBannedUntil(bannedUntil int64) string {
+	if bannedUntil == 0 {
+		return "-"
+	}
+
+	return time.Unix(bannedUntil, 0).UTC().Format(time.RFC3339)
+}
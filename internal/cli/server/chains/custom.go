@@ -0,0 +1,380 @@
+package chains
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var (
+	validatorContract     = common.HexToAddress("0x0000000000000000000000000000000000001000")
+	stateReceiverContract = common.HexToAddress("0x0000000000000000000000000000000000001001")
+	maticTokenContract    = common.HexToAddress("0x0000000000000000000000000000000000001010")
+)
+
+const (
+	// firstSpanEndBlock is the last block of the span stored in the genesis,
+	// the same as the FIRST_END_BLOCK of the BorValidatorSet contract
+	firstSpanEndBlock = 255
+
+	// spanEndSlot and producersSlot are the storage slots of the end block
+	// of the last span and of the number of its producers in the validator set
+	// contract, the layout is described in validatorset.evm
+	spanEndSlot   = 0x03
+	producersSlot = 0x10
+)
+
+var (
+	// validatorAddressSlot and validatorPowerSlot are the first storage slots
+	// of the addresses and voting powers of the producers of the first span
+	validatorAddressSlot = new(big.Int).Lsh(big.NewInt(1), 128)
+	validatorPowerSlot   = new(big.Int).Lsh(big.NewInt(2), 128)
+
+	// maticTotalSupply is the balance of the MRC20 contract minus the balances
+	// of the genesis accounts (10 billion MATIC)
+	maticTotalSupply = new(big.Int).Mul(big.NewInt(10_000_000_000), big.NewInt(params.Ether))
+
+	// validatorBalance is the balance given to every genesis validator
+	validatorBalance = new(big.Int).Mul(big.NewInt(1_000), big.NewInt(params.Ether))
+)
+
+var customChainName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+//go:embed validatorset.evm
+var validatorSetSource []byte
+
+var (
+	validatorSetCode     []byte
+	validatorSetCodeErr  error
+	validatorSetCodeOnce sync.Once
+)
+
+// ValidatorSetCode returns the runtime code of the validator set contract of
+// the custom chains
+func ValidatorSetCode() ([]byte, error) {
+	validatorSetCodeOnce.Do(func() {
+		compiler := asm.NewCompiler(false)
+		compiler.Feed(asm.Lex(validatorSetSource, false))
+
+		code, errs := compiler.Compile()
+		if len(errs) != 0 {
+			validatorSetCodeErr = fmt.Errorf("failed to compile validator set contract: %v", errs[0])
+			return
+		}
+
+		validatorSetCode, validatorSetCodeErr = hex.DecodeString(code)
+	})
+
+	return validatorSetCode, validatorSetCodeErr
+}
+
+// GenesisValidator is a validator of the first span of a custom chain
+type GenesisValidator struct {
+	Address common.Address
+	Power   uint64
+}
+
+// CustomChainConfig holds the parameters of a custom bor chain
+type CustomChainConfig struct {
+	// ChainID is the chain id and network id of the chain
+	ChainID uint64
+
+	// Validators are the validators of the chain. They are funded with 1000 MATIC.
+	Validators []GenesisValidator
+
+	// Alloc are the balances (in wei) of other prefunded accounts
+	Alloc map[common.Address]*big.Int
+
+	// Period is the number of seconds between blocks
+	Period uint64
+
+	// ProducerDelay is the delay in seconds of the first block of a sprint
+	ProducerDelay uint64
+
+	// Sprint is the number of blocks produced by the same validator
+	Sprint uint64
+
+	// BackupMultiplier is the multiplier of the wiggle time of backup producers
+	BackupMultiplier uint64
+
+	// StateSyncConfirmationDelay is the delay in seconds of the state sync events
+	StateSyncConfirmationDelay uint64
+
+	// BurntContract receives the burnt base fees
+	BurntContract common.Address
+
+	// GasLimit is the gas limit of the genesis block
+	GasLimit uint64
+
+	// Timestamp is the time of the genesis block
+	Timestamp uint64
+
+	// Bootnodes are the enodes to connect to
+	Bootnodes []string
+}
+
+func (c *CustomChainConfig) validate() error {
+	if c.ChainID == 0 {
+		return errors.New("chain id is required")
+	}
+
+	if len(c.Validators) == 0 {
+		return errors.New("at least one validator is required")
+	}
+
+	seen := map[common.Address]bool{}
+
+	for _, val := range c.Validators {
+		if val.Address == (common.Address{}) {
+			return errors.New("validator address is required")
+		}
+
+		if val.Power == 0 || val.Power > uint64(1<<62) {
+			return fmt.Errorf("invalid voting power %d of validator %s", val.Power, val.Address)
+		}
+
+		if seen[val.Address] {
+			return fmt.Errorf("duplicate validator %s", val.Address)
+		}
+
+		seen[val.Address] = true
+	}
+
+	for addr, balance := range c.Alloc {
+		if addr == validatorContract || addr == stateReceiverContract || addr == maticTokenContract {
+			return fmt.Errorf("cannot allocate to the genesis contract %s", addr)
+		}
+
+		if balance == nil || balance.Sign() < 0 {
+			return fmt.Errorf("invalid balance of %s", addr)
+		}
+	}
+
+	if c.Period == 0 {
+		return errors.New("period must be greater than 0")
+	}
+
+	if c.Sprint == 0 || (firstSpanEndBlock+1)%c.Sprint != 0 {
+		return fmt.Errorf("sprint must divide the length of the first span (%d)", firstSpanEndBlock+1)
+	}
+
+	if c.GasLimit == 0 {
+		return errors.New("gas limit must be greater than 0")
+	}
+
+	return nil
+}
+
+// NewCustomChain builds the chain described by cfg. The genesis holds the
+// validator set, state receiver and MRC20 contracts at their usual addresses
+// and the validators of the first span.
+func NewCustomChain(cfg *CustomChainConfig) (*Chain, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	alloc, err := customChainAlloc(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &params.ChainConfig{
+		ChainID:             new(big.Int).SetUint64(cfg.ChainID),
+		HomesteadBlock:      big.NewInt(0),
+		DAOForkSupport:      true,
+		EIP150Block:         big.NewInt(0),
+		EIP155Block:         big.NewInt(0),
+		EIP158Block:         big.NewInt(0),
+		ByzantiumBlock:      big.NewInt(0),
+		ConstantinopleBlock: big.NewInt(0),
+		PetersburgBlock:     big.NewInt(0),
+		IstanbulBlock:       big.NewInt(0),
+		MuirGlacierBlock:    big.NewInt(0),
+		BerlinBlock:         big.NewInt(0),
+		LondonBlock:         big.NewInt(0),
+		ShanghaiBlock:       big.NewInt(0),
+		CancunBlock:         big.NewInt(0),
+		Bor: &params.BorConfig{
+			JaipurBlock:    big.NewInt(0),
+			DelhiBlock:     big.NewInt(0),
+			IndoreBlock:    big.NewInt(0),
+			AhmedabadBlock: big.NewInt(0),
+			StateSyncConfirmationDelay: map[string]uint64{
+				"0": cfg.StateSyncConfirmationDelay,
+			},
+			Period: map[string]uint64{
+				"0": cfg.Period,
+			},
+			ProducerDelay: map[string]uint64{
+				"0": cfg.ProducerDelay,
+			},
+			Sprint: map[string]uint64{
+				"0": cfg.Sprint,
+			},
+			BackupMultiplier: map[string]uint64{
+				"0": cfg.BackupMultiplier,
+			},
+			ValidatorContract:     validatorContract.Hex(),
+			StateReceiverContract: stateReceiverContract.Hex(),
+			BurntContract: map[string]string{
+				"0": cfg.BurntContract.Hex(),
+			},
+		},
+	}
+
+	genesis := &core.Genesis{
+		Config:     config,
+		Timestamp:  cfg.Timestamp,
+		GasLimit:   cfg.GasLimit,
+		Difficulty: big.NewInt(1),
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+		Alloc:      alloc,
+	}
+
+	bootnodes := cfg.Bootnodes
+	if bootnodes == nil {
+		bootnodes = []string{}
+	}
+
+	return &Chain{
+		Hash:      genesis.ToBlock().Hash(),
+		Genesis:   genesis,
+		Bootnodes: bootnodes,
+		NetworkId: cfg.ChainID,
+	}, nil
+}
+
+// customChainAlloc returns the genesis accounts of the chain described by cfg
+func customChainAlloc(cfg *CustomChainConfig) (types.GenesisAlloc, error) {
+	code, err := ValidatorSetCode()
+	if err != nil {
+		return nil, err
+	}
+
+	validators := make([]GenesisValidator, len(cfg.Validators))
+	copy(validators, cfg.Validators)
+
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Address.Cmp(validators[j].Address) < 0
+	})
+
+	storage := map[common.Hash]common.Hash{
+		common.BigToHash(big.NewInt(spanEndSlot)):   common.BigToHash(big.NewInt(firstSpanEndBlock)),
+		common.BigToHash(big.NewInt(producersSlot)): common.BigToHash(big.NewInt(int64(len(validators)))),
+	}
+
+	alloc := types.GenesisAlloc{}
+	supply := new(big.Int).Set(maticTotalSupply)
+
+	for i, val := range validators {
+		index := big.NewInt(int64(i))
+
+		storage[common.BigToHash(new(big.Int).Add(validatorAddressSlot, index))] = common.BytesToHash(val.Address.Bytes())
+		storage[common.BigToHash(new(big.Int).Add(validatorPowerSlot, index))] = common.BigToHash(new(big.Int).SetUint64(val.Power))
+
+		alloc[val.Address] = types.Account{Balance: new(big.Int).Set(validatorBalance)}
+		supply.Sub(supply, validatorBalance)
+	}
+
+	for addr, balance := range cfg.Alloc {
+		if account, ok := alloc[addr]; ok {
+			account.Balance = new(big.Int).Add(account.Balance, balance)
+			alloc[addr] = account
+		} else {
+			alloc[addr] = types.Account{Balance: new(big.Int).Set(balance)}
+		}
+
+		supply.Sub(supply, balance)
+	}
+
+	if supply.Sign() < 0 {
+		return nil, errors.New("the genesis balances exceed the MATIC supply")
+	}
+
+	amoy := readPrealloc("allocs/amoy.json")
+
+	alloc[validatorContract] = types.Account{
+		Balance: new(big.Int),
+		Code:    code,
+		Storage: storage,
+	}
+	alloc[stateReceiverContract] = types.Account{
+		Balance: new(big.Int),
+		Code:    amoy[stateReceiverContract].Code,
+	}
+	alloc[maticTokenContract] = types.Account{
+		Balance: supply,
+		Code:    amoy[maticTokenContract].Code,
+	}
+
+	return alloc, nil
+}
+
+// CustomChainPath returns the path of the custom chain name in datadir
+func CustomChainPath(datadir, name string) string {
+	return filepath.Join(datadir, "chains", name+".json")
+}
+
+// ValidateCustomChainName checks that name can be used for a custom chain
+func ValidateCustomChainName(name string) error {
+	if !customChainName.MatchString(name) {
+		return fmt.Errorf("invalid chain name %q, only letters, digits, '-' and '_' are allowed", name)
+	}
+
+	if _, ok := chains[name]; ok {
+		return fmt.Errorf("chain name %s is reserved", name)
+	}
+
+	return nil
+}
+
+// WriteCustomChain writes the chain as json to path
+func WriteCustomChain(path string, chain *Chain) error {
+	data, err := json.MarshalIndent(chain, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(data, '\n'), 0600)
+}
+
+// GetCustomChain returns the custom chain name created in datadir. It returns
+// nil if there is no such chain.
+func GetCustomChain(datadir, name string) (*Chain, error) {
+	if datadir == "" || !customChainName.MatchString(name) {
+		return nil, nil
+	}
+
+	path := CustomChainPath(datadir, name)
+
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	chain, err := ImportFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error importing chain %s: %v", name, err)
+	}
+
+	return chain, nil
+}
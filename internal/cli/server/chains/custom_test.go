@@ -0,0 +1,309 @@
+package chains
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/bor/clerk"
+	"github.com/ethereum/go-ethereum/consensus/bor/contract"
+	"github.com/ethereum/go-ethereum/consensus/bor/statefull"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func testCustomChainConfig() *CustomChainConfig {
+	return &CustomChainConfig{
+		ChainID: 4242,
+		Validators: []GenesisValidator{
+			{Address: common.HexToAddress("0x00000000000000000000000000000000000000b2"), Power: 20},
+			{Address: common.HexToAddress("0x00000000000000000000000000000000000000a1"), Power: 10},
+		},
+		Alloc: map[common.Address]*big.Int{
+			common.HexToAddress("0x00000000000000000000000000000000000000c3"): big.NewInt(params.Ether),
+		},
+		Period:           2,
+		ProducerDelay:    4,
+		Sprint:           16,
+		BackupMultiplier: 2,
+		GasLimit:         30_000_000,
+	}
+}
+
+// customChainState returns the state of the genesis accounts of the chain.
+func customChainState(t *testing.T, chain *Chain) *state.StateDB {
+	t.Helper()
+
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+
+	for addr, account := range chain.Genesis.Alloc {
+		statedb.SetCode(addr, account.Code)
+
+		for key, value := range account.Storage {
+			statedb.SetState(addr, key, value)
+		}
+	}
+
+	return statedb
+}
+
+func TestNewCustomChain(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NewCustomChain(testCustomChainConfig())
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(4242), chain.NetworkId)
+	require.Equal(t, chain.Genesis.ToBlock().Hash(), chain.Hash)
+	require.Equal(t, uint64(16), chain.Genesis.Config.Bor.CalculateSprint(0))
+
+	// the balances of the genesis accounts are taken from the MATIC supply
+	total := new(big.Int)
+	for _, account := range chain.Genesis.Alloc {
+		total.Add(total, account.Balance)
+	}
+
+	require.Equal(t, maticTotalSupply, total)
+
+	statedb := customChainState(t, chain)
+
+	vABI := contract.ValidatorSet()
+
+	call := func(number uint64, from common.Address, method string, args ...interface{}) ([]byte, error) {
+		input, err := vABI.Pack(method, args...)
+		require.NoError(t, err)
+
+		ret, _, err := runtime.Call(validatorContract, input, &runtime.Config{
+			ChainConfig: chain.Genesis.Config,
+			State:       statedb,
+			Origin:      from,
+			BlockNumber: new(big.Int).SetUint64(number),
+		})
+
+		return ret, err
+	}
+
+	validators := func(number uint64, method string) ([]common.Address, []*big.Int) {
+		var args []interface{}
+		if method == "getBorValidators" {
+			args = append(args, new(big.Int).SetUint64(number))
+		}
+
+		ret, err := call(number, common.Address{}, method, args...)
+		require.NoError(t, err)
+
+		out, err := vABI.Unpack(method, ret)
+		require.NoError(t, err)
+
+		return out[0].([]common.Address), out[1].([]*big.Int)
+	}
+
+	// validators are sorted by address
+	for _, method := range []string{"getBorValidators", "getValidators"} {
+		addrs, powers := validators(1, method)
+		require.Equal(t, []common.Address{
+			common.HexToAddress("0x00000000000000000000000000000000000000a1"),
+			common.HexToAddress("0x00000000000000000000000000000000000000b2"),
+		}, addrs)
+		require.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(20)}, powers)
+	}
+
+	currentSpan := func(number uint64) [3]uint64 {
+		ret, err := call(number, common.Address{}, "getCurrentSpan")
+		require.NoError(t, err)
+
+		out, err := vABI.Unpack("getCurrentSpan", ret)
+		require.NoError(t, err)
+
+		return [3]uint64{out[0].(*big.Int).Uint64(), out[1].(*big.Int).Uint64(), out[2].(*big.Int).Uint64()}
+	}
+
+	require.Equal(t, [3]uint64{0, 0, firstSpanEndBlock}, currentSpan(1))
+
+	commitSpan := func(from common.Address, id, start, end uint64, producers []valset.MinimalVal) error {
+		producerBytes, err := rlp.EncodeToBytes(producers)
+		require.NoError(t, err)
+
+		_, err = call(start-16, from, "commitSpan", new(big.Int).SetUint64(id), new(big.Int).SetUint64(start), new(big.Int).SetUint64(end), producerBytes, producerBytes)
+
+		return err
+	}
+
+	// only the system address can commit spans
+	require.Error(t, commitSpan(common.Address{}, 1, 256, 6655, nil))
+
+	// ids and powers of all sizes, the list is long enough for a multi-byte prefix
+	producers := []valset.MinimalVal{
+		{ID: 0, VotingPower: 1, Signer: common.HexToAddress("0xc1")},
+		{ID: 1, VotingPower: 127, Signer: common.HexToAddress("0xc2")},
+		{ID: 128, VotingPower: 128, Signer: common.HexToAddress("0xc3")},
+		{ID: 1 << 40, VotingPower: 1 << 60, Signer: common.HexToAddress("0xffffffffffffffffffffffffffffffffffffffff")},
+	}
+	require.NoError(t, commitSpan(params.SystemAddress, 1, 256, 6655, producers))
+
+	// the new span is current once its start block is reached
+	require.Equal(t, [3]uint64{0, 0, firstSpanEndBlock}, currentSpan(255))
+	require.Equal(t, [3]uint64{1, 256, 6655}, currentSpan(256))
+
+	addrs, powers := validators(255, "getBorValidators")
+	require.Len(t, addrs, 2)
+	require.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(20)}, powers)
+
+	addrs, powers = validators(256, "getBorValidators")
+	require.Len(t, addrs, len(producers))
+
+	for i, producer := range producers {
+		require.Equal(t, producer.Signer, addrs[i])
+		require.Equal(t, new(big.Int).SetUint64(producer.VotingPower), powers[i])
+	}
+
+	// the next span replaces the producers of the first span
+	require.NoError(t, commitSpan(params.SystemAddress, 2, 6656, 13055, producers[:1]))
+
+	addrs, _ = validators(6655, "getValidators")
+	require.Len(t, addrs, len(producers))
+
+	addrs, _ = validators(6656, "getValidators")
+	require.Equal(t, []common.Address{producers[0].Signer}, addrs)
+
+	ret, err := call(6700, common.Address{}, "currentSpanNumber")
+	require.NoError(t, err)
+	require.Equal(t, common.BigToHash(big.NewInt(2)).Bytes(), ret)
+}
+
+func TestCustomChainCommitState(t *testing.T) {
+	t.Parallel()
+
+	chain, err := NewCustomChain(testCustomChainConfig())
+	require.NoError(t, err)
+
+	statedb := customChainState(t, chain)
+
+	// the receiver stores the id of the state it's sent
+	receiver := common.HexToAddress("0x00000000000000000000000000000000000000d4")
+	statedb.SetCode(receiver, []byte{
+		byte(vm.PUSH1), 0x04, byte(vm.CALLDATALOAD),
+		byte(vm.PUSH1), 0x00, byte(vm.SSTORE),
+		byte(vm.STOP),
+	})
+
+	config := chain.Genesis.Config
+	header := &types.Header{
+		Number:     big.NewInt(16),
+		Time:       chain.Genesis.Timestamp + 32,
+		Difficulty: big.NewInt(1),
+		GasLimit:   chain.Genesis.GasLimit,
+	}
+
+	// commit the state the way bor does at the start of a sprint
+	client := contract.NewGenesisContractsClient(config, config.Bor.ValidatorContract, config.Bor.StateReceiverContract, nil)
+	event := &clerk.EventRecordWithTime{
+		EventRecord: clerk.EventRecord{
+			ID:       1,
+			Contract: receiver,
+			Data:     hexutil.Bytes{0x01},
+			ChainID:  config.ChainID.String(),
+		},
+		Time: time.Unix(int64(chain.Genesis.Timestamp), 0),
+	}
+	_, err = client.CommitState(event, statedb, header, statefull.ChainContext{})
+	require.NoError(t, err)
+
+	require.Equal(t, common.BigToHash(big.NewInt(1)), statedb.GetState(receiver, common.Hash{}))
+
+	call := func(addr common.Address, contractABI abi.ABI, method string) []interface{} {
+		input, err := contractABI.Pack(method)
+		require.NoError(t, err)
+
+		ret, _, err := runtime.Call(addr, input, &runtime.Config{
+			ChainConfig: config,
+			State:       statedb,
+			BlockNumber: header.Number,
+		})
+		require.NoError(t, err)
+
+		out, err := contractABI.Unpack(method, ret)
+		require.NoError(t, err)
+
+		return out
+	}
+
+	require.Equal(t, uint64(1), call(stateReceiverContract, contract.StateReceiver(), "lastStateId")[0].(*big.Int).Uint64())
+
+	// the span is still read from the same state
+	span := call(validatorContract, contract.ValidatorSet(), "getCurrentSpan")
+	require.Equal(t, [3]uint64{0, 0, firstSpanEndBlock}, [3]uint64{span[0].(*big.Int).Uint64(), span[1].(*big.Int).Uint64(), span[2].(*big.Int).Uint64()})
+}
+
+func TestNewCustomChainInvalid(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]func(cfg *CustomChainConfig){
+		"chain id": func(cfg *CustomChainConfig) {
+			cfg.ChainID = 0
+		},
+		"no validators": func(cfg *CustomChainConfig) {
+			cfg.Validators = nil
+		},
+		"duplicate validator": func(cfg *CustomChainConfig) {
+			cfg.Validators = append(cfg.Validators, cfg.Validators[0])
+		},
+		"zero power": func(cfg *CustomChainConfig) {
+			cfg.Validators[0].Power = 0
+		},
+		"sprint": func(cfg *CustomChainConfig) {
+			cfg.Sprint = 24
+		},
+		"genesis contract": func(cfg *CustomChainConfig) {
+			cfg.Alloc[maticTokenContract] = big.NewInt(1)
+		},
+		"supply": func(cfg *CustomChainConfig) {
+			cfg.Alloc[common.HexToAddress("0xc3")] = new(big.Int).Set(maticTotalSupply)
+		},
+	}
+
+	for name, update := range cases {
+		cfg := testCustomChainConfig()
+		update(cfg)
+
+		_, err := NewCustomChain(cfg)
+		require.Error(t, err, name)
+	}
+}
+
+func TestGetCustomChain(t *testing.T) {
+	t.Parallel()
+
+	datadir := t.TempDir()
+
+	chain, err := GetCustomChain(datadir, "devnet")
+	require.NoError(t, err)
+	require.Nil(t, chain)
+
+	chain, err = NewCustomChain(testCustomChainConfig())
+	require.NoError(t, err)
+	require.NoError(t, WriteCustomChain(CustomChainPath(datadir, "devnet"), chain))
+	require.FileExists(t, filepath.Join(datadir, "chains", "devnet.json"))
+
+	imported, err := GetCustomChain(datadir, "devnet")
+	require.NoError(t, err)
+	require.Equal(t, chain.Hash, imported.Hash)
+	require.Equal(t, chain.Hash, imported.Genesis.ToBlock().Hash())
+
+	require.Error(t, ValidateCustomChainName("mainnet"))
+	require.Error(t, ValidateCustomChainName("../devnet"))
+	require.NoError(t, ValidateCustomChainName("my-devnet_1"))
+}
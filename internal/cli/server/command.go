@@ -172,7 +172,7 @@ func (c *Command) Run(args []string) int {
 		}()
 	}
 
-	srv, err := NewServer(c.config, WithGRPCAddress())
+	srv, err := NewServer(c.config, WithGRPCAddress(), WithConfigLoader(c.loadConfig(args)))
 	if err != nil {
 		c.UI.Error(err.Error())
 		return 1
@@ -189,6 +189,13 @@ func (c *Command) handleSignals() int {
 
 	sig := <-signalCh
 
+	// SIGHUP reloads the config instead of stopping the node
+	for sig == syscall.SIGHUP {
+		c.reload()
+
+		sig = <-signalCh
+	}
+
 	c.UI.Output(fmt.Sprintf("Caught signal: %v", sig))
 	c.UI.Output("Gracefully shutting down agent...")
 
@@ -210,6 +217,33 @@ func (c *Command) handleSignals() int {
 	return 1
 }
 
+// loadConfig returns a function which parses the config file and the flags
+// provided in args again, picking up any change made to the config file.
+func (c *Command) loadConfig(args []string) func() (*Config, error) {
+	return func() (*Config, error) {
+		cmd := &Command{UI: c.UI}
+		if err := cmd.extractFlags(args); err != nil {
+			return nil, err
+		}
+
+		return cmd.config, nil
+	}
+}
+
+func (c *Command) reload() {
+	c.UI.Output("Reloading configuration...")
+
+	_, restart, _, err := c.srv.Reload()
+	if err != nil {
+		log.Error("Failed to reload configuration", "err", err)
+		return
+	}
+
+	if len(restart) != 0 {
+		log.Warn("Some configuration changes require a restart", "fields", restart)
+	}
+}
+
 // GetConfig returns the user specified config
 func (c *Command) GetConfig() *Config {
 	return c.cliConfig
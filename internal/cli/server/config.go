@@ -2,6 +2,8 @@ package server
 
 import (
 	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"math/big"
@@ -28,6 +30,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/downloader"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
+	ethproto "github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/node"
@@ -42,6 +45,9 @@ import (
 type Config struct {
 	chain *chains.Chain
 
+	// devnet is the bor devnet started in developer mode
+	devnet *devnet
+
 	// Chain is the chain to sync with
 	Chain string `hcl:"chain,optional" toml:"chain,optional"`
 
@@ -90,9 +96,15 @@ type Config struct {
 	// Snapshot enables the snapshot database mode
 	Snapshot bool `hcl:"snapshot,optional" toml:"snapshot,optional"`
 
-	// BorLogs enables bor log retrieval
+	// BorLogs enables bor log retrieval, merging the state sync logs into eth_getLogs and eth_getFilterLogs
 	BorLogs bool `hcl:"bor.logs,optional" toml:"bor.logs,optional"`
 
+	// AddressIndex enables the index of the transactions each address is involved in
+	AddressIndex bool `hcl:"bor.addressindex,optional" toml:"bor.addressindex,optional"`
+
+	// LogIndex enables the log index, used instead of the bloom bits to filter logs
+	LogIndex bool `hcl:"bor.logindex,optional" toml:"bor.logindex,optional"`
+
 	// Ethstats is the address of the ethstats server to send telemetry
 	Ethstats string `hcl:"ethstats,optional" toml:"ethstats,optional"`
 
@@ -142,6 +154,12 @@ type Config struct {
 
 	// Pprof has the pprof related settings
 	Pprof *PprofConfig `hcl:"pprof,block" toml:"pprof,block"`
+
+	// VMTrace has the live tracer related settings
+	VMTrace *VMTraceConfig `hcl:"vmtrace,block" toml:"vmtrace,block"`
+
+	// Sentry has the sentry architecture related settings
+	Sentry *SentryConfig `hcl:"sentry,block" toml:"sentry,block"`
 }
 
 type LoggingConfig struct {
@@ -185,6 +203,65 @@ type PprofConfig struct {
 	// CPUProfile string `hcl:"cpuprofile,optional" toml:"cpuprofile,optional"`
 }
 
+type VMTraceConfig struct {
+	// Name is the name of the live tracer run during block import
+	Name string `hcl:"name,optional" toml:"name,optional"`
+
+	// JSONConfig is the json configuration of the live tracer
+	JSONConfig string `hcl:"jsonconfig,optional" toml:"jsonconfig,optional"`
+
+	// Path is the directory the live tracer writes its output to. It is passed
+	// to the tracer as the "path" field of its json configuration.
+	Path string `hcl:"path,optional" toml:"path,optional"`
+}
+
+type SentryConfig struct {
+	// Mode is the role of the node in a sentry architecture: "validator" for a
+	// validator node only reachable through its sentry nodes, "sentry" for a
+	// sentry node relaying for its private validator nodes, or empty if unused
+	Mode string `hcl:"mode,optional" toml:"mode,optional"`
+
+	// Sentries is the list of enode URLs of the sentry nodes of a validator node
+	Sentries []string `hcl:"sentries,optional" toml:"sentries,optional"`
+
+	// PrivatePeers is the list of enode URLs of the validator nodes behind a sentry node
+	PrivatePeers []string `hcl:"privatepeers,optional" toml:"privatepeers,optional"`
+}
+
+// buildJSONConfig returns the json configuration of the live tracer with
+// the output directory
+func (c *VMTraceConfig) buildJSONConfig() (string, error) {
+	if c.Path == "" {
+		return c.JSONConfig, nil
+	}
+
+	config := map[string]json.RawMessage{}
+
+	if c.JSONConfig != "" {
+		if err := json.Unmarshal([]byte(c.JSONConfig), &config); err != nil {
+			return "", fmt.Errorf("invalid vmtrace json config: %v", err)
+		}
+	}
+
+	if _, ok := config["path"]; ok {
+		return "", errors.New("vmtrace path is set both in the path and the json config")
+	}
+
+	path, err := json.Marshal(c.Path)
+	if err != nil {
+		return "", err
+	}
+
+	config["path"] = path
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
 type P2PConfig struct {
 	// MaxPeers sets the maximum number of connected peers
 	MaxPeers uint64 `hcl:"maxpeers,optional" toml:"maxpeers,optional"`
@@ -215,9 +292,19 @@ type P2PConfig struct {
 	// P2P node key as hex
 	NodeKeyHex string `hcl:"nodekeyhex,optional" toml:"nodekeyhex,optional"`
 
+	// NodeRole is the role of the node advertised in its bor ENR entry
+	NodeRole string `hcl:"noderole,optional" toml:"noderole,optional"`
+
 	// Discovery has the p2p discovery related settings
 	Discovery *P2PDiscovery `hcl:"discovery,block" toml:"discovery,block"`
 
+	// ValidatorNodes maps validator signer addresses to their nodes, to keep direct
+	// connections to the upcoming block producers
+	ValidatorNodes map[string]string `hcl:"validatornodes,optional" toml:"validatornodes,optional"`
+
+	// Serve has the limits on the headers, bodies and receipts served to the peers
+	Serve *P2PServe `hcl:"serve,block" toml:"serve,block"`
+
 	// TxArrivalWait sets the maximum duration the transaction fetcher will wait for
 	// an announced transaction to arrive before explicitly requesting it
 	TxArrivalWait    time.Duration `hcl:"-,optional" toml:"-"`
@@ -248,6 +335,30 @@ type P2PDiscovery struct {
 
 	// DNS is the list of enrtree:// URLs which will be queried for nodes to connect to
 	DNS []string `hcl:"dns,optional" toml:"dns,optional"`
+
+	// Roles restricts the discovered nodes to dial to the ones advertising one of
+	// the roles in their bor ENR entry
+	Roles []string `hcl:"roles,optional" toml:"roles,optional"`
+}
+
+type P2PServe struct {
+	// PeerRequests is the number of requests served per second to each peer
+	PeerRequests uint64 `hcl:"peerrequests,optional" toml:"peerrequests,optional"`
+
+	// PeerBytes is the number of response bytes served per second to each peer
+	PeerBytes uint64 `hcl:"peerbytes,optional" toml:"peerbytes,optional"`
+
+	// TrustedRequests is the number of requests served per second to each trusted or static peer
+	TrustedRequests uint64 `hcl:"trustedrequests,optional" toml:"trustedrequests,optional"`
+
+	// TrustedBytes is the number of response bytes served per second to each trusted or static peer
+	TrustedBytes uint64 `hcl:"trustedbytes,optional" toml:"trustedbytes,optional"`
+
+	// Requests is the number of requests served per second to all the peers except trusted and static ones
+	Requests uint64 `hcl:"requests,optional" toml:"requests,optional"`
+
+	// Bytes is the number of response bytes served per second to all the peers except trusted and static ones
+	Bytes uint64 `hcl:"bytes,optional" toml:"bytes,optional"`
 }
 
 type HeimdallConfig struct {
@@ -348,6 +459,9 @@ type JsonRPCConfig struct {
 	// TxFeeCap is the global transaction fee cap for send-transaction variants
 	TxFeeCap float64 `hcl:"txfeecap,optional" toml:"txfeecap,optional"`
 
+	// TraceFilterRange is the maximum number of blocks a trace_filter request can span (0=no limit)
+	TraceFilterRange uint64 `hcl:"tracefilterrange,optional" toml:"tracefilterrange,optional"`
+
 	// Http has the json-rpc http related settings
 	Http *APIConfig `hcl:"http,block" toml:"http,block"`
 
@@ -556,6 +670,12 @@ type CacheConfig struct {
 
 	// Raise the open file descriptor resource limit (default = system fd limit)
 	FDLimit int `hcl:"fdlimit,optional" toml:"fdlimit,optional"`
+
+	// TraceCache is the size in megabytes of the transaction trace result cache (0 = disabled)
+	TraceCache int `hcl:"tracecache,optional" toml:"tracecache,optional"`
+
+	// TraceCachePrefill is the list of tracers run on imported blocks to fill the trace cache
+	TraceCachePrefill []string `hcl:"tracecacheprefill,optional" toml:"tracecacheprefill,optional"`
 }
 
 type ExtraDBConfig struct {
@@ -591,12 +711,34 @@ type DeveloperConfig struct {
 
 	// Initial block gas limit
 	GasLimit uint64 `hcl:"gaslimit,optional" toml:"gaslimit,optional"`
+
+	// Bor has the bor devnet related settings
+	Bor *DeveloperBorConfig `hcl:"bor,block" toml:"bor,block"`
+}
+
+type DeveloperBorConfig struct {
+	// Enabled runs the developer mode as a bor devnet with in-process validators and heimdall
+	Enabled bool `hcl:"enabled,optional" toml:"enabled,optional"`
+
+	// Validators is the number of validators of the devnet
+	Validators uint64 `hcl:"validators,optional" toml:"validators,optional"`
+
+	// Sprint is the number of blocks produced by the same validator
+	Sprint uint64 `hcl:"sprint,optional" toml:"sprint,optional"`
+
+	// Span is the number of blocks of the spans after the first one
+	Span uint64 `hcl:"span,optional" toml:"span,optional"`
+
+	// Script is the path of the json file scripting the spans, state syncs and validator outages
+	Script string `hcl:"script,optional" toml:"script,optional"`
 }
 
 type ParallelEVMConfig struct {
 	Enable bool `hcl:"enable,optional" toml:"enable,optional"`
 
 	SpeculativeProcesses int `hcl:"procs,optional" toml:"procs,optional"`
+
+	Pipeline bool `hcl:"pipeline,optional" toml:"pipeline,optional"`
 }
 
 func DefaultConfig() *Config {
@@ -638,6 +780,16 @@ func DefaultConfig() *Config {
 				StaticNodes:  []string{},
 				TrustedNodes: []string{},
 				DNS:          []string{},
+				Roles:        []string{},
+			},
+			ValidatorNodes: map[string]string{},
+			Serve: &P2PServe{
+				PeerRequests:    0,
+				PeerBytes:       0,
+				TrustedRequests: 0,
+				TrustedBytes:    0,
+				Requests:        0,
+				Bytes:           0,
 			},
 		},
 		Heimdall: &HeimdallConfig{
@@ -685,6 +837,7 @@ func DefaultConfig() *Config {
 			IPCPath:             "",
 			GasCap:              ethconfig.Defaults.RPCGasCap,
 			TxFeeCap:            ethconfig.Defaults.RPCTxFeeCap,
+			TraceFilterRange:    ethconfig.Defaults.RPCTraceFilterRange,
 			RPCEVMTimeout:       ethconfig.Defaults.RPCEVMTimeout,
 			AllowUnprotectedTxs: false,
 			EnablePersonal:      false,
@@ -758,6 +911,8 @@ func DefaultConfig() *Config {
 			FilterLogCacheSize: ethconfig.Defaults.FilterLogCacheSize,
 			TrieTimeout:        60 * time.Minute,
 			FDLimit:            0,
+			TraceCache:         0,
+			TraceCachePrefill:  []string{},
 		},
 		ExtraDB: &ExtraDBConfig{
 			// These are LevelDB defaults, specifying here for clarity in code and in logging.
@@ -781,6 +936,13 @@ func DefaultConfig() *Config {
 			Enabled:  false,
 			Period:   0,
 			GasLimit: 11500000,
+			Bor: &DeveloperBorConfig{
+				Enabled:    false,
+				Validators: 2,
+				Sprint:     16,
+				Span:       128,
+				Script:     "",
+			},
 		},
 		DevFakeAuthor: false,
 		Pprof: &PprofConfig{
@@ -794,6 +956,17 @@ func DefaultConfig() *Config {
 		ParallelEVM: &ParallelEVMConfig{
 			Enable:               true,
 			SpeculativeProcesses: 8,
+			Pipeline:             false,
+		},
+		VMTrace: &VMTraceConfig{
+			Name:       "",
+			JSONConfig: "",
+			Path:       "",
+		},
+		Sentry: &SentryConfig{
+			Mode:         "",
+			Sentries:     []string{},
+			PrivatePeers: []string{},
 		},
 	}
 }
@@ -894,8 +1067,19 @@ func readConfigFile(path string) (*Config, error) {
 	return config, nil
 }
 
+// findChain returns the chain name, looking first at the custom chains of datadir
+func findChain(datadir, name string) (*chains.Chain, error) {
+	// chains created with `bor chain init` are stored in the data directory
+	chain, err := chains.GetCustomChain(datadir, name)
+	if err != nil || chain != nil {
+		return chain, err
+	}
+
+	return chains.GetChain(name)
+}
+
 func (c *Config) loadChain() error {
-	chain, err := chains.GetChain(c.Chain)
+	chain, err := findChain(c.DataDir, c.Chain)
 	if err != nil {
 		return err
 	}
@@ -1020,8 +1204,17 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 			err        error
 		)
 
-		// etherbase has been set above, configuring the miner address from command line flags.
-		if n.Miner.Etherbase != (common.Address{}) {
+		// the bor devnet is produced by its validators, the developer account is the first one
+		if c.Developer.Bor.Enabled {
+			if c.devnet, err = newDevnet(c.Developer); err != nil {
+				return nil, fmt.Errorf("failed to create the bor devnet: %v", err)
+			}
+
+			if developer, err = c.devnet.importDeveloperKey(ks); err != nil {
+				return nil, fmt.Errorf("failed to import developer account: %v", err)
+			}
+		} else if n.Miner.Etherbase != (common.Address{}) {
+			// etherbase has been set above, configuring the miner address from command line flags.
 			developer = accounts.Account{Address: n.Miner.Etherbase}
 		} else if accs := ks.Accounts(); len(accs) > 0 {
 			developer = ks.Accounts()[0]
@@ -1042,7 +1235,14 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 		n.Miner.Etherbase = developer.Address
 
 		// get developer mode chain config
-		c.chain = chains.GetDeveloperChain(c.Developer.Period, c.Developer.GasLimit, developer.Address)
+		if c.devnet != nil {
+			c.chain = c.devnet.chain
+
+			n.WithoutHeimdall = false
+			n.HeimdallClient = c.devnet.stub
+		} else {
+			c.chain = chains.GetDeveloperChain(c.Developer.Period, c.Developer.GasLimit, developer.Address)
+		}
 
 		// update the parameters
 		n.NetworkId = c.chain.NetworkId
@@ -1064,6 +1264,28 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 	{
 		n.EthDiscoveryURLs = c.P2P.Discovery.DNS
 		n.SnapDiscoveryURLs = c.P2P.Discovery.DNS
+		n.DialRoles = c.P2P.Discovery.Roles
+	}
+
+	// serving limits
+	{
+		n.Serve = ethproto.ServeConfig{
+			Peer:    ethproto.ServeLimits{Requests: c.P2P.Serve.PeerRequests, Bytes: c.P2P.Serve.PeerBytes},
+			Trusted: ethproto.ServeLimits{Requests: c.P2P.Serve.TrustedRequests, Bytes: c.P2P.Serve.TrustedBytes},
+			Global:  ethproto.ServeLimits{Requests: c.P2P.Serve.Requests, Bytes: c.P2P.Serve.Bytes},
+		}
+	}
+
+	// node role, advertised in the bor ENR entry
+	{
+		n.NodeRole = c.P2P.NodeRole
+		if n.NodeRole == "" && c.Sealer.Enabled {
+			n.NodeRole = "validator"
+		}
+
+		if n.NodeRole == "" && c.Sentry.Mode == "sentry" {
+			n.NodeRole = "sentry"
+		}
 	}
 
 	// RequiredBlocks
@@ -1085,6 +1307,24 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 		}
 	}
 
+	// ValidatorNodes
+	{
+		n.ValidatorNodes = map[common.Address]*enode.Node{}
+
+		for k, v := range c.P2P.ValidatorNodes {
+			if !common.IsHexAddress(k) {
+				return nil, fmt.Errorf("invalid validator address %s", k)
+			}
+
+			node, err := enode.Parse(enode.ValidSchemes, v)
+			if err != nil {
+				return nil, fmt.Errorf("invalid validator node %s: %v", v, err)
+			}
+
+			n.ValidatorNodes[common.HexToAddress(k)] = node
+		}
+	}
+
 	// cache
 	{
 		cache := c.Cache.Cache
@@ -1122,6 +1362,8 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 		n.TrieTimeout = c.Cache.TrieTimeout
 		n.TriesInMemory = c.Cache.TriesInMemory
 		n.FilterLogCacheSize = c.Cache.FilterLogCacheSize
+		n.TraceCache = c.Cache.TraceCache
+		n.TraceCachePrefill = c.Cache.TraceCachePrefill
 	}
 
 	// LevelDB
@@ -1143,7 +1385,9 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 
 	n.RPCTxFeeCap = c.JsonRPC.TxFeeCap
 
-	// sync mode. It can either be "fast", "full" or "snap". We disable
+	n.RPCTraceFilterRange = c.JsonRPC.TraceFilterRange
+
+	// sync mode. It can either be "full", "snap" or "checkpoint". We disable
 	// for now the "light" mode.
 	switch c.SyncMode {
 	case "full":
@@ -1153,6 +1397,8 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 		n.SyncMode = downloader.FullSync
 
 		log.Warn("Bor doesn't support Snap Sync yet, switching to Full Sync mode")
+	case "checkpoint":
+		n.SyncMode = downloader.CheckpointSync
 	default:
 		return nil, fmt.Errorf("sync mode '%s' not found", c.SyncMode)
 	}
@@ -1182,7 +1428,7 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 
 	// snapshot disable check
 	if !c.Snapshot {
-		if n.SyncMode == downloader.SnapSync {
+		if n.SyncMode == downloader.SnapSync || n.SyncMode == downloader.CheckpointSync {
 			log.Info("Snap sync requested, enabling --snapshot")
 		} else {
 			// disable snapshot
@@ -1192,10 +1438,13 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 	}
 
 	n.BorLogs = c.BorLogs
+	n.AddressIndex = c.AddressIndex
+	n.LogIndex = c.LogIndex
 	n.DatabaseHandles = dbHandles
 
 	n.ParallelEVM.Enable = c.ParallelEVM.Enable
 	n.ParallelEVM.SpeculativeProcesses = c.ParallelEVM.SpeculativeProcesses
+	n.ParallelEVM.Pipeline = c.ParallelEVM.Pipeline
 	n.RPCReturnDataLimit = c.RPCReturnDataLimit
 
 	if c.Ancient != "" {
@@ -1204,6 +1453,17 @@ func (c *Config) buildEth(stack *node.Node, accountManager *accounts.Manager) (*
 
 	n.EnableBlockTracking = c.Logging.EnableBlockTracking
 
+	// live tracer
+	if c.VMTrace.Name != "" {
+		jsonConfig, err := c.VMTrace.buildJSONConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		n.VMTrace = c.VMTrace.Name
+		n.VMTraceJsonConfig = jsonConfig
+	}
+
 	return &n, nil
 }
 
@@ -1388,6 +1648,17 @@ func (c *Config) buildNode() (*node.Config, error) {
 		cfg.P2P.NoDial = true
 		cfg.P2P.DiscoveryV5 = false
 
+		// the bor devnet is kept in memory like its other validators, which
+		// connect on localhost. The ipc endpoint stays in the data directory.
+		if c.Developer.Bor.Enabled {
+			cfg.DataDir = ""
+			cfg.P2P.ListenAddr = "127.0.0.1:0"
+
+			if ipcPath != "" && filepath.Base(ipcPath) == ipcPath {
+				cfg.IPCPath = filepath.Join(c.DataDir, ipcPath)
+			}
+		}
+
 		// enable JsonRPC HTTP API
 		c.JsonRPC.Http.Enabled = true
 		cfg.HTTPModules = []string{"admin", "debug", "eth", "miner", "net", "personal", "txpool", "web3", "bor"}
@@ -1447,6 +1718,40 @@ func (c *Config) buildNode() (*node.Config, error) {
 		}
 	}
 
+	// sentry architecture
+	switch c.Sentry.Mode {
+	case "":
+	case "validator":
+		// accept only the sentry nodes, which are always connected, and never
+		// advertise the node on the discovery
+		sentries, err := parseBootnodes(c.Sentry.Sentries)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(sentries) == 0 {
+			return nil, fmt.Errorf("sentry nodes are required in the validator sentry mode")
+		}
+
+		cfg.P2P.StaticNodes = append(cfg.P2P.StaticNodes, sentries...)
+		cfg.P2P.TrustedNodes = append(cfg.P2P.TrustedNodes, sentries...)
+		cfg.P2P.TrustedOnly = true
+		c.P2P.NoDiscover = true
+	case "sentry":
+		// stay connected to the private validator nodes, keeping them out of the
+		// discovery responses and of the peer lists
+		private, err := parseBootnodes(c.Sentry.PrivatePeers)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.P2P.StaticNodes = append(cfg.P2P.StaticNodes, private...)
+		cfg.P2P.TrustedNodes = append(cfg.P2P.TrustedNodes, private...)
+		cfg.P2P.PrivateNodes = private
+	default:
+		return nil, fmt.Errorf("unknown sentry mode %q, want validator or sentry", c.Sentry.Mode)
+	}
+
 	if c.P2P.NoDiscover {
 		// Disable peer discovery
 		cfg.P2P.NoDiscovery = true
@@ -0,0 +1,590 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsimple"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+// ConfigIssue is a problem found while checking a config file
+type ConfigIssue struct {
+	// Key is the path of the config field (e.g. "p2p.port"), if any
+	Key string
+
+	// Line and Column are the position of the key in the file, zero if unknown.
+	// The column is only known for hcl files.
+	Line   int
+	Column int
+
+	Message string
+
+	// Warning is set for issues which do not prevent the node from starting
+	Warning bool
+}
+
+// Position returns the position of the issue in the file at path
+func (i *ConfigIssue) Position(path string) string {
+	switch {
+	case i.Line == 0:
+		return path
+	case i.Column == 0:
+		return fmt.Sprintf("%s:%d", path, i.Line)
+	default:
+		return fmt.Sprintf("%s:%d:%d", path, i.Line, i.Column)
+	}
+}
+
+type configPos struct {
+	line, column int
+}
+
+// configFile is a config file decoded like readConfigFile does, along with the
+// keys it sets. The keys which are not part of the Config are the ones left
+// over by the decoder, i.e. the ones ignored (toml) or rejected (hcl) when the
+// node starts.
+type configFile struct {
+	config *Config
+
+	// pos holds the position of every key set in the file, keyed by its path
+	pos map[string]configPos
+
+	// set holds the keys set in the file as written in toml, since the paths
+	// of pos are ambiguous for keys containing a dot
+	set map[string]bool
+
+	// unknown holds the keys which are not part of the config, sorted
+	unknown [][]string
+
+	// strict is set if the node does not start with unknown keys, as hcl
+	// decoding fails on them while toml decoding ignores them
+	strict bool
+}
+
+func (f *configFile) isSet(path []string) bool {
+	return f.set[toml.Key(path).String()]
+}
+
+func (f *configFile) isUnknown(path []string) bool {
+	for _, key := range f.unknown {
+		if reflect.DeepEqual(key, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (f *configFile) issue(key string, warning bool, format string, args ...interface{}) *ConfigIssue {
+	pos := f.pos[key]
+
+	return &ConfigIssue{
+		Key:     key,
+		Line:    pos.line,
+		Column:  pos.column,
+		Message: fmt.Sprintf(format, args...),
+		Warning: warning,
+	}
+}
+
+// loadConfigFile decodes the config file at path in hcl or toml format.
+// Syntax and decoding errors are returned as issues.
+func loadConfigFile(path string) (*configFile, []*ConfigIssue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	if filepath.Ext(path) == ".toml" {
+		return loadTomlConfigFile(data)
+	}
+
+	return loadHclConfigFile(path, data)
+}
+
+func loadTomlConfigFile(data []byte) (*configFile, []*ConfigIssue, error) {
+	config, md, err := decodeLegacyConfig(data)
+	if err != nil {
+		var perr toml.ParseError
+		if errors.As(err, &perr) {
+			return nil, []*ConfigIssue{{Line: perr.Position.Line, Message: perr.Message}}, nil
+		}
+
+		return nil, []*ConfigIssue{{Message: err.Error()}}, nil
+	}
+
+	file := &configFile{
+		config: config,
+		pos:    map[string]configPos{},
+		set:    map[string]bool{},
+	}
+
+	pos := tomlKeyPositions(string(data))
+
+	for _, key := range md.Keys() {
+		file.pos[strings.Join(key, ".")] = pos[strings.Join(key, ".")]
+		file.set[key.String()] = true
+	}
+
+	// the tables holding an unknown key are undecoded as well, only report
+	// the innermost keys
+	undecoded := md.Undecoded()
+
+	for _, key := range undecoded {
+		if !hasNestedKey(undecoded, key) {
+			file.unknown = append(file.unknown, key)
+		}
+	}
+
+	sortKeys(file.unknown)
+
+	return file, nil, nil
+}
+
+// hasNestedKey reports whether one of keys is nested in key
+func hasNestedKey(keys []toml.Key, key toml.Key) bool {
+	for _, other := range keys {
+		if len(other) > len(key) && reflect.DeepEqual(other[:len(key)], key) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// tomlKeyPositions returns the line of every key and table header of a toml
+// document. It does not validate the document, which is done by the decoder,
+// and only tracks lines since toml errors carry no column either.
+func tomlKeyPositions(data string) map[string]configPos {
+	var (
+		pos   = map[string]configPos{}
+		table []string
+	)
+
+	for i, line := range strings.Split(data, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "", strings.HasPrefix(trimmed, "#"), strings.HasPrefix(trimmed, "[["):
+			continue
+
+		case strings.HasPrefix(trimmed, "["):
+			end := strings.Index(trimmed, "]")
+			if end == -1 {
+				continue
+			}
+
+			table = splitTomlKey(trimmed[1:end])
+
+		default:
+			eq := strings.Index(trimmed, "=")
+			if eq == -1 {
+				// continuation of a multi-line value
+				continue
+			}
+
+			keyPath := append(append([]string{}, table...), splitTomlKey(trimmed[:eq])...)
+			key := strings.Join(keyPath, ".")
+
+			if _, ok := pos[key]; !ok {
+				pos[key] = configPos{line: i + 1}
+			}
+
+			continue
+		}
+
+		key := strings.Join(table, ".")
+		if _, ok := pos[key]; !ok {
+			pos[key] = configPos{line: i + 1}
+		}
+	}
+
+	return pos
+}
+
+// splitTomlKey splits a dotted toml key into its parts, keeping quoted parts whole
+func splitTomlKey(key string) []string {
+	var (
+		parts  []string
+		part   strings.Builder
+		quoted rune
+	)
+
+	for _, r := range key {
+		switch {
+		case quoted != 0 && r == quoted:
+			quoted = 0
+		case quoted != 0:
+			part.WriteRune(r)
+		case r == '"' || r == '\'':
+			quoted = r
+		case r == '.':
+			parts = append(parts, strings.TrimSpace(part.String()))
+			part.Reset()
+		case r != ' ' && r != '\t':
+			part.WriteRune(r)
+		}
+	}
+
+	return append(parts, strings.TrimSpace(part.String()))
+}
+
+func loadHclConfigFile(path string, data []byte) (*configFile, []*ConfigIssue, error) {
+	parsed, diags := hclparse.NewParser().ParseHCL(data, path)
+	if diags.HasErrors() {
+		return nil, hclIssues(diags), nil
+	}
+
+	body, ok := parsed.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected hcl body %T", parsed.Body)
+	}
+
+	file := &configFile{
+		config: DefaultConfig(),
+		pos:    map[string]configPos{},
+		set:    map[string]bool{},
+		strict: true,
+	}
+
+	keys := map[configPos][]string{}
+	hclKeyPositions(body, nil, file.pos, keys)
+
+	for _, key := range keys {
+		file.set[toml.Key(key).String()] = true
+	}
+
+	// unlike readConfigFile the file is decoded on top of the default config,
+	// so that the blocks which are not set can be checked too
+	var issues []*ConfigIssue
+
+	// decoding carries on past invalid keys, so the config holds the valid
+	// values even if an error is returned
+	if err := hclsimple.DecodeFile(path, nil, file.config); err != nil {
+		if !errors.As(err, &diags) {
+			return nil, nil, err
+		}
+
+		for _, diag := range diags {
+			if diag.Subject != nil && (diag.Summary == "Unsupported argument" || diag.Summary == "Unsupported block type") {
+				if key, ok := keys[configPos{line: diag.Subject.Start.Line, column: diag.Subject.Start.Column}]; ok {
+					file.unknown = append(file.unknown, key)
+					continue
+				}
+			}
+
+			issues = append(issues, hclIssues(hcl.Diagnostics{diag})...)
+		}
+	}
+
+	if len(issues) != 0 {
+		return nil, issues, nil
+	}
+
+	if err := file.config.fillBigInt(); err != nil {
+		return nil, []*ConfigIssue{{Message: err.Error()}}, nil
+	}
+
+	if err := file.config.fillTimeDurations(); err != nil {
+		return nil, []*ConfigIssue{{Message: err.Error()}}, nil
+	}
+
+	sortKeys(file.unknown)
+
+	return file, nil, nil
+}
+
+// hclKeyPositions records the position of every attribute and block of body,
+// along with the key found at every position
+func hclKeyPositions(body *hclsyntax.Body, path []string, pos map[string]configPos, keys map[configPos][]string) {
+	for name, attr := range body.Attributes {
+		key := append(append([]string{}, path...), name)
+		at := configPos{line: attr.NameRange.Start.Line, column: attr.NameRange.Start.Column}
+
+		pos[strings.Join(key, ".")] = at
+		keys[at] = key
+	}
+
+	for _, block := range body.Blocks {
+		key := append(append([]string{}, path...), block.Type)
+		at := configPos{line: block.TypeRange.Start.Line, column: block.TypeRange.Start.Column}
+
+		pos[strings.Join(key, ".")] = at
+		keys[at] = key
+
+		hclKeyPositions(block.Body, key, pos, keys)
+	}
+}
+
+// sortKeys sorts config keys by their path
+func sortKeys(keys [][]string) {
+	sort.Slice(keys, func(i, j int) bool {
+		return strings.Join(keys[i], ".") < strings.Join(keys[j], ".")
+	})
+}
+
+func hclIssues(diags hcl.Diagnostics) []*ConfigIssue {
+	issues := make([]*ConfigIssue, 0, len(diags))
+
+	for _, diag := range diags {
+		issue := &ConfigIssue{
+			Message: diag.Summary,
+			Warning: diag.Severity == hcl.DiagWarning,
+		}
+
+		if diag.Detail != "" {
+			issue.Message += ": " + diag.Detail
+		}
+
+		if diag.Subject != nil {
+			issue.Line = diag.Subject.Start.Line
+			issue.Column = diag.Subject.Start.Column
+		}
+
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// configRename describes a key which moved to another place in the config
+type configRename struct {
+	from, to []string
+
+	// reason explains why the key has to move
+	reason string
+
+	// convert changes the format of the value, if needed
+	convert func(interface{}) (interface{}, error)
+}
+
+// configRenames returns the keys of older config layouts along with their
+// current place. Besides the explicit renames, keys containing a dot (e.g.
+// "db.engine") are a single key and must be quoted in toml. Written without
+// quotes they are parsed as nested tables and silently ignored.
+func configRenames() []configRename {
+	renames := []configRename{
+		{
+			from:   []string{"log-level"},
+			to:     []string{"verbosity"},
+			reason: "log-level will be deprecated soon",
+			convert: func(val interface{}) (interface{}, error) {
+				level, ok := val.(string)
+				if !ok {
+					return nil, fmt.Errorf("log-level must be a string")
+				}
+
+				level = strings.ToLower(level)
+
+				if verbosity := VerbosityStringToInt(level); VerbosityIntToString(verbosity) == level {
+					return int64(verbosity), nil
+				}
+
+				return nil, fmt.Errorf("invalid log-level '%s'", level)
+			},
+		},
+	}
+
+	walkConfig(reflect.ValueOf(DefaultConfig()).Elem(), nil, func(path []string, _ reflect.Value) {
+		// the discovery settings used to live in the p2p block
+		if len(path) == 3 && path[0] == "p2p" && path[1] == "discovery" {
+			renames = append(renames, configRename{
+				from:   []string{"p2p", path[2]},
+				to:     path,
+				reason: "p2p." + path[2] + " is not read anymore",
+			})
+		}
+
+		var split []string
+
+		for _, name := range path {
+			split = append(split, strings.Split(name, ".")...)
+		}
+
+		if len(split) != len(path) {
+			renames = append(renames, configRename{
+				from:   split,
+				to:     path,
+				reason: strings.Join(split, ".") + " is read as nested tables and ignored, the key must be quoted",
+			})
+		}
+	})
+
+	return renames
+}
+
+// CheckConfigFile validates the config file at path. It reports syntax errors,
+// unknown and deprecated keys, invalid values, conflicting settings and port
+// collisions. The returned error is only set if the file cannot be read.
+func CheckConfigFile(path string) ([]*ConfigIssue, error) {
+	file, issues, err := loadConfigFile(path)
+	if err != nil || len(issues) != 0 {
+		return issues, err
+	}
+
+	renamed := map[string]bool{}
+
+	for _, rename := range configRenames() {
+		if !file.isSet(rename.from) {
+			continue
+		}
+
+		from := strings.Join(rename.from, ".")
+		renamed[toml.Key(rename.from).String()] = true
+
+		// hcl files with a key which moved out of the config can't be loaded
+		warning := !file.strict || !file.isUnknown(rename.from)
+
+		if file.isSet(rename.to) {
+			issues = append(issues, file.issue(from, warning, "%s is ignored since %s is set", from, toml.Key(rename.to)))
+		} else {
+			issues = append(issues, file.issue(from, warning, "%s, use %s instead (see bor config migrate)", rename.reason, toml.Key(rename.to)))
+		}
+	}
+
+	for _, path := range file.unknown {
+		if key := strings.Join(path, "."); !renamed[toml.Key(path).String()] {
+			issues = append(issues, file.issue(key, false, "unknown key %s", key))
+		}
+	}
+
+	issues = append(issues, file.checkValues(file.config)...)
+	issues = append(issues, file.checkConflicts(file.config)...)
+	issues = append(issues, file.checkPorts(file.config)...)
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Line != issues[j].Line {
+			return issues[i].Line < issues[j].Line
+		}
+
+		return issues[i].Column < issues[j].Column
+	})
+
+	return issues, nil
+}
+
+func (f *configFile) checkValues(c *Config) []*ConfigIssue {
+	var issues []*ConfigIssue
+
+	if _, err := findChain(c.DataDir, c.Chain); err != nil {
+		issues = append(issues, f.issue("chain", false, "%v", err))
+	}
+
+	switch c.SyncMode {
+	case "full", "checkpoint":
+	case "snap":
+		issues = append(issues, f.issue("syncmode", true, "snap sync is not supported yet, full sync is used instead"))
+	default:
+		issues = append(issues, f.issue("syncmode", false, "sync mode '%s' not found", c.SyncMode))
+	}
+
+	if c.GcMode != "full" && c.GcMode != "archive" {
+		issues = append(issues, f.issue("gcmode", false, "gcmode '%s' not found", c.GcMode))
+	}
+
+	if c.StateScheme != "hash" && c.StateScheme != "path" {
+		issues = append(issues, f.issue("state.scheme", true, "state scheme '%s' not found, hash is used instead", c.StateScheme))
+	}
+
+	if c.DBEngine != "leveldb" && c.DBEngine != "pebble" {
+		issues = append(issues, f.issue("db.engine", false, "db engine '%s' not found", c.DBEngine))
+	}
+
+	return issues
+}
+
+func (f *configFile) checkConflicts(c *Config) []*ConfigIssue {
+	var issues []*ConfigIssue
+
+	if c.Heimdall.Without {
+		for _, key := range []string{"url", "grpc-address", "bor.runheimdall"} {
+			if f.isSet([]string{"heimdall", key}) {
+				issues = append(issues, f.issue("heimdall."+key, true, "heimdall.%s has no effect since heimdall.bor.without is set", key))
+			}
+		}
+	}
+
+	return issues
+}
+
+// configListener is a network listener opened by the node
+type configListener struct {
+	key  string
+	host string
+	port string
+}
+
+func (f *configFile) checkPorts(c *Config) []*ConfigIssue {
+	var listeners []configListener
+
+	if c.P2P.MaxPeers != 0 && c.P2P.Port != 0 {
+		listeners = append(listeners, configListener{"p2p.port", c.P2P.Bind, strconv.FormatUint(c.P2P.Port, 10)})
+	}
+
+	if c.JsonRPC.Http.Enabled && c.JsonRPC.Http.Port != 0 {
+		listeners = append(listeners, configListener{"jsonrpc.http.port", c.JsonRPC.Http.Host, strconv.FormatUint(c.JsonRPC.Http.Port, 10)})
+	}
+
+	if c.JsonRPC.Ws.Enabled && c.JsonRPC.Ws.Port != 0 {
+		// websockets can share the port of the http server if they bind the same host
+		if !c.JsonRPC.Http.Enabled || c.JsonRPC.Ws.Port != c.JsonRPC.Http.Port || c.JsonRPC.Ws.Host != c.JsonRPC.Http.Host {
+			listeners = append(listeners, configListener{"jsonrpc.ws.port", c.JsonRPC.Ws.Host, strconv.FormatUint(c.JsonRPC.Ws.Port, 10)})
+		}
+	}
+
+	if c.Pprof.Enabled {
+		listeners = append(listeners, configListener{"pprof.port", c.Pprof.Addr, strconv.Itoa(c.Pprof.Port)})
+	}
+
+	for _, addr := range []struct {
+		key     string
+		addr    string
+		enabled bool
+	}{
+		{"grpc.addr", c.GRPC.Addr, true},
+		{"telemetry.prometheus-addr", c.Telemetry.PrometheusAddr, c.Telemetry.Enabled},
+	} {
+		if !addr.enabled || addr.addr == "" {
+			continue
+		}
+
+		host, port, err := net.SplitHostPort(addr.addr)
+		if err != nil {
+			return []*ConfigIssue{f.issue(addr.key, false, "invalid address '%s': %v", addr.addr, err)}
+		}
+
+		listeners = append(listeners, configListener{addr.key, host, port})
+	}
+
+	var issues []*ConfigIssue
+
+	for i, a := range listeners {
+		for _, b := range listeners[:i] {
+			if a.port == b.port && hostsOverlap(a.host, b.host) {
+				issues = append(issues, f.issue(a.key, false, "port %s is already used by %s", a.port, b.key))
+			}
+		}
+	}
+
+	return issues
+}
+
+// hostsOverlap reports whether listening on both hosts with the same port collides
+func hostsOverlap(a, b string) bool {
+	isAny := func(host string) bool {
+		return host == "" || host == "0.0.0.0" || host == "::"
+	}
+
+	return a == b || isAny(a) || isAny(b)
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
+)
+
+func TestCheckConfigFile(t *testing.T) {
+	t.Parallel()
+
+	issues, err := CheckConfigFile("./testdata/check/legacy.toml")
+	require.NoError(t, err)
+
+	type issue struct {
+		key     string
+		line    int
+		warning bool
+	}
+
+	found := make([]issue, 0, len(issues))
+	for _, i := range issues {
+		found = append(found, issue{i.Key, i.Line, i.Warning})
+	}
+
+	require.Equal(t, []issue{
+		{"log-level", 2, true},
+		{"db.engine", 3, true},
+		{"p2p.bootnodes", 7, true},
+		{"heimdall.url", 10, true},
+		{"jsonrpc.http.port", 15, false},
+		{"txpool.unknown", 18, false},
+	}, found)
+
+	issues, err = CheckConfigFile("./testdata/check/valid.hcl")
+	require.NoError(t, err)
+	require.Empty(t, issues)
+
+	issues, err = CheckConfigFile("./testdata/check/invalid.hcl")
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "txpool.slots", issues[0].Key)
+	require.Equal(t, "./testdata/check/invalid.hcl:5:3", issues[0].Position("./testdata/check/invalid.hcl"))
+	require.False(t, issues[0].Warning)
+
+	_, err = CheckConfigFile("./testdata/check/missing.toml")
+	require.Error(t, err)
+}
+
+func TestCheckConfigFileCustomChain(t *testing.T) {
+	t.Parallel()
+
+	datadir := t.TempDir()
+
+	path := t.TempDir() + "/config.toml"
+	require.NoError(t, os.WriteFile(path, []byte(fmt.Sprintf("chain = \"devnet\"\ndatadir = %q\n", datadir)), 0600))
+
+	// the chain has to be created first
+	issues, err := CheckConfigFile(path)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	require.Equal(t, "chain", issues[0].Key)
+
+	chain, err := chains.NewCustomChain(&chains.CustomChainConfig{
+		ChainID:    4242,
+		Validators: []chains.GenesisValidator{{Address: common.HexToAddress("0xa1"), Power: 10}},
+		Period:     2,
+		Sprint:     16,
+		GasLimit:   30_000_000,
+	})
+	require.NoError(t, err)
+	require.NoError(t, chains.WriteCustomChain(chains.CustomChainPath(datadir, "devnet"), chain))
+
+	issues, err = CheckConfigFile(path)
+	require.NoError(t, err)
+	require.Empty(t, issues)
+}
+
+func TestMigrateConfigFile(t *testing.T) {
+	t.Parallel()
+
+	data, notes, err := MigrateConfigFile("./testdata/check/legacy.toml")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"moved log-level to verbosity",
+		`moved db.engine to "db.engine"`,
+		"moved p2p.bootnodes to p2p.discovery.bootnodes",
+		"removed unknown key txpool.unknown",
+	}, notes)
+
+	// the migrated file only contains known keys
+	path := t.TempDir() + "/config.toml"
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	issues, err := CheckConfigFile(path)
+	require.NoError(t, err)
+
+	for _, issue := range issues {
+		require.NotContains(t, issue.Message, "bor config migrate")
+		require.NotContains(t, issue.Message, "unknown key")
+	}
+
+	config, err := readLegacyConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, 4, config.Verbosity)
+	require.Equal(t, "pebble", config.DBEngine)
+	require.Len(t, config.P2P.Discovery.Bootnodes, 1)
+	require.True(t, config.Heimdall.Without)
+
+	// hcl files are converted to toml
+	data, notes, err = MigrateConfigFile("./testdata/check/valid.hcl")
+	require.NoError(t, err)
+	require.Empty(t, notes)
+	require.Contains(t, string(data), "[p2p.discovery]")
+	require.Contains(t, string(data), `lifetime = "1h"`)
+
+	// the keys of older layouts are moved in hcl files too, although the
+	// hcl decoder rejects them
+	data, notes, err = MigrateConfigFile("./testdata/check/legacy.hcl")
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"moved log-level to verbosity",
+		"moved p2p.bootnodes to p2p.discovery.bootnodes",
+		"removed unknown key txpool.unknown",
+	}, notes)
+
+	path = t.TempDir() + "/config.toml"
+	require.NoError(t, os.WriteFile(path, data, 0600))
+
+	config, err = readLegacyConfig(path)
+	require.NoError(t, err)
+	require.Equal(t, 4, config.Verbosity)
+	require.Equal(t, uint64(30303), config.P2P.Port)
+	require.Equal(t, uint64(40), config.P2P.MaxPeers)
+	require.Len(t, config.P2P.Discovery.Bootnodes, 1)
+	require.Equal(t, time.Hour, config.TxPool.LifeTime)
+}
@@ -9,25 +9,33 @@ import (
 
 func readLegacyConfig(path string) (*Config, error) {
 	data, err := os.ReadFile(path)
-	tomlData := string(data)
-
 	if err != nil {
 		return nil, fmt.Errorf("failed to read toml config file: %v", err)
 	}
 
+	conf, _, err := decodeLegacyConfig(data)
+
+	return conf, err
+}
+
+// decodeLegacyConfig decodes a toml config on top of the default config. The
+// returned metadata holds the keys of the document, including the ones which
+// are not part of the config.
+func decodeLegacyConfig(data []byte) (*Config, toml.MetaData, error) {
 	conf := *DefaultConfig()
 
-	if _, err := toml.Decode(tomlData, &conf); err != nil {
-		return nil, fmt.Errorf("failed to decode toml config file: %v", err)
+	md, err := toml.Decode(string(data), &conf)
+	if err != nil {
+		return nil, md, fmt.Errorf("failed to decode toml config file: %w", err)
 	}
 
 	if err := conf.fillBigInt(); err != nil {
-		return nil, err
+		return nil, md, err
 	}
 
 	if err := conf.fillTimeDurations(); err != nil {
-		return nil, err
+		return nil, md, err
 	}
 
-	return &conf, nil
+	return &conf, md, nil
 }
@@ -0,0 +1,474 @@
+package server
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclparse"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// maxAlignedWidth is the longest key/value pair whose comment is aligned with
+// the other pairs of its table
+const maxAlignedWidth = 60
+
+// MigrateConfigFile reads the config file at path (hcl or toml), moves the keys
+// of older layouts to their current place and returns the config as a toml
+// document with the usage of every key as comment. The returned notes describe
+// the changes made to the file.
+func MigrateConfigFile(path string) ([]byte, []string, error) {
+	file, issues, err := loadConfigFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(issues) != 0 {
+		return nil, nil, fmt.Errorf("%s: %s", issues[0].Position(path), issues[0].Message)
+	}
+
+	values, err := readConfigValues(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	migrations, err := values.migrate()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var (
+		notes []string
+		moved = map[string]bool{}
+	)
+
+	for _, m := range migrations {
+		moved[m.from] = true
+
+		if m.ignored {
+			notes = append(notes, fmt.Sprintf("removed %s since %s is set", m.from, m.toKey))
+		} else {
+			notes = append(notes, fmt.Sprintf("moved %s to %s", m.from, m.toKey))
+		}
+	}
+
+	for _, key := range file.unknown {
+		// keys of older layouts are already moved
+		if moved[strings.Join(key, ".")] {
+			continue
+		}
+
+		values.delete(key)
+
+		notes = append(notes, fmt.Sprintf("removed unknown key %s", strings.Join(key, ".")))
+	}
+
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "# Migrated from %s by bor config migrate\n\n", path)
+
+	if err := writeTomlConfig(&buf, values, reflect.TypeOf(Config{}), nil, configUsages()); err != nil {
+		return nil, nil, err
+	}
+
+	// make sure the result can be loaded
+	if _, _, err := decodeLegacyConfig(buf.Bytes()); err != nil {
+		return nil, nil, err
+	}
+
+	return buf.Bytes(), notes, nil
+}
+
+// configValues are the values set in a config file keyed by name, with the
+// nested blocks as maps. Unlike the Config, they keep the keys of older layouts
+// so that they can be moved.
+type configValues map[string]interface{}
+
+// readConfigValues reads the values set in the config file at path as written,
+// without decoding them into a Config, so that the keys of older layouts are
+// kept in both formats.
+func readConfigValues(path string) (configValues, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %v", err)
+	}
+
+	if filepath.Ext(path) == ".toml" {
+		values := map[string]interface{}{}
+
+		if _, err := toml.Decode(string(data), &values); err != nil {
+			return nil, err
+		}
+
+		return values, nil
+	}
+
+	parsed, diags := hclparse.NewParser().ParseHCL(data, path)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+
+	body, ok := parsed.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("unexpected hcl body %T", parsed.Body)
+	}
+
+	return hclBodyValues(body)
+}
+
+// hclBodyValues returns the values of the attributes of body along with its
+// nested blocks as maps, like toml decodes a table
+func hclBodyValues(body *hclsyntax.Body) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+
+	for name, attr := range body.Attributes {
+		val, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, diags
+		}
+
+		if values[name], diags = ctyValue(val); diags.HasErrors() {
+			return nil, diags
+		}
+	}
+
+	for _, block := range body.Blocks {
+		nested, err := hclBodyValues(block.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		values[block.Type] = nested
+	}
+
+	return values, nil
+}
+
+// ctyValue converts an hcl value to the type toml decodes the same value to
+func ctyValue(val cty.Value) (interface{}, hcl.Diagnostics) {
+	switch {
+	case val.IsNull():
+		return nil, nil
+
+	case val.Type() == cty.String:
+		return val.AsString(), nil
+
+	case val.Type() == cty.Bool:
+		return val.True(), nil
+
+	case val.Type() == cty.Number:
+		if i, acc := val.AsBigFloat().Int64(); acc == big.Exact {
+			return i, nil
+		}
+
+		f, _ := val.AsBigFloat().Float64()
+
+		return f, nil
+
+	case val.Type().IsTupleType(), val.Type().IsListType(), val.Type().IsSetType():
+		list := []interface{}{}
+
+		for it := val.ElementIterator(); it.Next(); {
+			_, elem := it.Element()
+
+			v, diags := ctyValue(elem)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			list = append(list, v)
+		}
+
+		return list, nil
+
+	case val.Type().IsObjectType(), val.Type().IsMapType():
+		entries := map[string]interface{}{}
+
+		for it := val.ElementIterator(); it.Next(); {
+			key, elem := it.Element()
+
+			v, diags := ctyValue(elem)
+			if diags.HasErrors() {
+				return nil, diags
+			}
+
+			entries[key.AsString()] = v
+		}
+
+		return entries, nil
+	}
+
+	return nil, hcl.Diagnostics{{
+		Severity: hcl.DiagError,
+		Summary:  fmt.Sprintf("unsupported value of type %s", val.Type().FriendlyName()),
+	}}
+}
+
+func (v configValues) get(path []string) (interface{}, bool) {
+	values := map[string]interface{}(v)
+
+	for i, name := range path {
+		val, ok := values[name]
+		if !ok {
+			return nil, false
+		}
+
+		if i == len(path)-1 {
+			return val, true
+		}
+
+		if values, ok = val.(map[string]interface{}); !ok {
+			return nil, false
+		}
+	}
+
+	return nil, false
+}
+
+func (v configValues) set(path []string, val interface{}) {
+	values := map[string]interface{}(v)
+
+	for _, name := range path[:len(path)-1] {
+		next, ok := values[name].(map[string]interface{})
+		if !ok {
+			next = map[string]interface{}{}
+			values[name] = next
+		}
+
+		values = next
+	}
+
+	values[path[len(path)-1]] = val
+}
+
+// delete removes the key at path along with the tables left empty by it
+func (v configValues) delete(path []string) {
+	var del func(values map[string]interface{}, path []string) bool
+
+	del = func(values map[string]interface{}, path []string) bool {
+		if len(path) > 1 {
+			next, ok := values[path[0]].(map[string]interface{})
+			if !ok || !del(next, path[1:]) {
+				return false
+			}
+		}
+
+		delete(values, path[0])
+
+		return len(values) == 0
+	}
+
+	del(v, path)
+}
+
+// configMigration is a key which was moved by configValues.migrate
+type configMigration struct {
+	from string
+
+	// toKey is the new key as written in toml (i.e. quoted if needed)
+	toKey string
+
+	// ignored is set if the key was dropped since its new place was already set
+	ignored bool
+}
+
+// migrate moves the keys of older layouts to their current place
+func (v configValues) migrate() ([]configMigration, error) {
+	var migrations []configMigration
+
+	for _, rename := range configRenames() {
+		val, ok := v.get(rename.from)
+		if !ok {
+			continue
+		}
+
+		migration := configMigration{
+			from:  strings.Join(rename.from, "."),
+			toKey: toml.Key(rename.to).String(),
+		}
+
+		v.delete(rename.from)
+
+		if _, migration.ignored = v.get(rename.to); migration.ignored {
+			migrations = append(migrations, migration)
+			continue
+		}
+
+		if rename.convert != nil {
+			var err error
+			if val, err = rename.convert(val); err != nil {
+				return nil, err
+			}
+		}
+
+		v.set(rename.to, val)
+
+		migrations = append(migrations, migration)
+	}
+
+	return migrations, nil
+}
+
+// writeTomlConfig writes the values of the config block of type t at path as
+// toml, in the order of the fields of the block. The keys which are set are
+// written first, followed by the nested blocks.
+func writeTomlConfig(buf *bytes.Buffer, values map[string]interface{}, t reflect.Type, path []string, usages map[string]string) error {
+	type line struct {
+		kv, usage string
+	}
+
+	var (
+		lines  []line
+		tables []configField
+		width  int
+		indent = strings.Repeat("  ", len(path))
+	)
+
+	for _, field := range configFields(t) {
+		val, ok := values[field.name]
+		if !ok {
+			continue
+		}
+
+		typ := t.Field(field.index).Type
+		if isConfigBlock(typ) || typ.Kind() == reflect.Map {
+			tables = append(tables, field)
+			continue
+		}
+
+		kv, err := formatTomlKeyValue(field.name, val)
+		if err != nil {
+			return err
+		}
+
+		lines = append(lines, line{kv, usages[strings.Join(append(append([]string{}, path...), field.name), ".")]})
+
+		if len(kv) > width && len(kv) <= maxAlignedWidth {
+			width = len(kv)
+		}
+	}
+
+	for _, l := range lines {
+		switch {
+		case l.usage == "":
+			fmt.Fprintf(buf, "%s%s\n", indent, l.kv)
+		case len(l.kv) > maxAlignedWidth:
+			// long values (e.g. lists of enodes) get the comment on their own line
+			fmt.Fprintf(buf, "%s# %s\n%s%s\n", indent, l.usage, indent, l.kv)
+		default:
+			fmt.Fprintf(buf, "%s%-*s  # %s\n", indent, width, l.kv, l.usage)
+		}
+	}
+
+	for _, field := range tables {
+		tablePath := append(append([]string{}, path...), field.name)
+
+		if len(lines) != 0 || buf.Len() != 0 {
+			buf.WriteString("\n")
+		}
+
+		fmt.Fprintf(buf, "%s[%s]\n", strings.Repeat("  ", len(path)), toml.Key(tablePath).String())
+
+		nested, ok := values[field.name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s must be a table", strings.Join(tablePath, "."))
+		}
+
+		typ := t.Field(field.index).Type
+		if typ.Kind() == reflect.Map {
+			if err := writeTomlMap(buf, nested, strings.Repeat("  ", len(tablePath))); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := writeTomlConfig(buf, nested, typ.Elem(), tablePath, usages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeTomlMap writes the entries of a map field sorted by key
+func writeTomlMap(buf *bytes.Buffer, values map[string]interface{}, indent string) error {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		kv, err := formatTomlKeyValue(key, values[key])
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(buf, "%s%s\n", indent, kv)
+	}
+
+	return nil
+}
+
+// formatTomlKeyValue formats a single key/value pair, quoting the key if needed
+func formatTomlKeyValue(key string, val interface{}) (string, error) {
+	var buf bytes.Buffer
+
+	if err := toml.NewEncoder(&buf).Encode(map[string]interface{}{key: val}); err != nil {
+		return "", fmt.Errorf("failed to encode %s: %v", key, err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// configUsages returns the usage of the flag of every config key which can be
+// set from the command line. Flags are matched with the config field they set.
+func configUsages() map[string]string {
+	cmd := &Command{}
+	flags := cmd.Flags(nil)
+
+	keys := map[uintptr]string{}
+
+	walkConfig(reflect.ValueOf(cmd.cliConfig).Elem(), nil, func(path []string, field reflect.Value) {
+		key := strings.Join(path, ".")
+
+		keys[field.Addr().Pointer()] = key
+
+		// big integer flags point to the value instead of the field
+		if field.Kind() == reflect.Ptr && !field.IsNil() {
+			keys[field.Pointer()] = key
+		}
+	})
+
+	usages := map[string]string{}
+
+	for _, name := range flags.GetAllFlags() {
+		flag := flags.GetFlag(name)
+
+		val := reflect.ValueOf(flag.Value)
+		if val.Kind() != reflect.Ptr || val.Elem().Kind() != reflect.Struct {
+			continue
+		}
+
+		ptr := val.Elem().FieldByName("Value")
+		if !ptr.IsValid() || ptr.Kind() != reflect.Ptr || ptr.IsNil() {
+			continue
+		}
+
+		if key, ok := keys[ptr.Pointer()]; ok {
+			usages[key] = flag.Usage
+		}
+	}
+
+	return usages
+}
@@ -7,7 +7,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
 	"github.com/ethereum/go-ethereum/params"
 )
 
@@ -144,3 +146,137 @@ func TestMakePasswordListFromFile(t *testing.T) {
 		assert.Equal(t, []string{"test1", "test2"}, result)
 	})
 }
+
+func TestConfigCustomChain(t *testing.T) {
+	t.Parallel()
+
+	chain, err := chains.NewCustomChain(&chains.CustomChainConfig{
+		ChainID:    4242,
+		Validators: []chains.GenesisValidator{{Address: common.HexToAddress("0xa1"), Power: 10}},
+		Period:     2,
+		Sprint:     16,
+		GasLimit:   30_000_000,
+	})
+	assert.NoError(t, err)
+
+	config := DefaultConfig()
+	config.DataDir = t.TempDir()
+	config.Chain = "devnet"
+
+	// the chain has to be created first
+	assert.Error(t, config.loadChain())
+
+	assert.NoError(t, chains.WriteCustomChain(chains.CustomChainPath(config.DataDir, "devnet"), chain))
+	assert.NoError(t, config.loadChain())
+	assert.Equal(t, chain.Hash, config.chain.Genesis.ToBlock().Hash())
+
+	ethConfig, err := config.buildEth(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(4242), ethConfig.NetworkId)
+}
+
+func TestConfigVMTrace(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	assert.NoError(t, config.loadChain())
+
+	ethConfig, err := config.buildEth(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "", ethConfig.VMTrace)
+
+	config.VMTrace.Name = "supply"
+	config.VMTrace.JSONConfig = `{"maxSize":10}`
+	config.VMTrace.Path = "/tmp/supply"
+
+	ethConfig, err = config.buildEth(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "supply", ethConfig.VMTrace)
+	assert.JSONEq(t, `{"maxSize":10,"path":"/tmp/supply"}`, ethConfig.VMTraceJsonConfig)
+
+	// the json config is passed as it is without a path
+	config.VMTrace.Path = ""
+
+	ethConfig, err = config.buildEth(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"maxSize":10}`, ethConfig.VMTraceJsonConfig)
+
+	// the path can't be set twice
+	config.VMTrace.JSONConfig = `{"path":"/tmp/other"}`
+	config.VMTrace.Path = "/tmp/supply"
+
+	_, err = config.buildEth(nil, nil)
+	assert.Error(t, err)
+
+	config.VMTrace.JSONConfig = `{`
+
+	_, err = config.buildEth(nil, nil)
+	assert.Error(t, err)
+}
+
+func TestConfigSentry(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Validator", func(t *testing.T) {
+		config := DefaultConfig()
+		assert.NoError(t, config.loadChain())
+
+		// the sentry nodes are required
+		config.Sentry.Mode = "validator"
+
+		_, err := config.buildNode()
+		assert.Error(t, err)
+
+		config.Sentry.Sentries = []string{dummyEnodeAddr}
+
+		cfg, err := config.buildNode()
+		assert.NoError(t, err)
+		assert.True(t, cfg.P2P.TrustedOnly)
+		assert.True(t, cfg.P2P.NoDiscovery)
+		assert.Len(t, cfg.P2P.StaticNodes, 1)
+		assert.Len(t, cfg.P2P.TrustedNodes, 1)
+	})
+	t.Run("Sentry", func(t *testing.T) {
+		config := DefaultConfig()
+		assert.NoError(t, config.loadChain())
+
+		config.Sentry.Mode = "sentry"
+		config.Sentry.PrivatePeers = []string{dummyEnodeAddr}
+
+		cfg, err := config.buildNode()
+		assert.NoError(t, err)
+		assert.False(t, cfg.P2P.TrustedOnly)
+		assert.False(t, cfg.P2P.NoDiscovery)
+		assert.Len(t, cfg.P2P.PrivateNodes, 1)
+		assert.Len(t, cfg.P2P.StaticNodes, 1)
+		assert.Len(t, cfg.P2P.TrustedNodes, 1)
+
+		ethConfig, err := config.buildEth(nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "sentry", ethConfig.NodeRole)
+	})
+	t.Run("Unknown", func(t *testing.T) {
+		config := DefaultConfig()
+		config.Sentry.Mode = "relay"
+
+		_, err := config.buildNode()
+		assert.Error(t, err)
+	})
+}
+
+func TestConfigServe(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig()
+	assert.NoError(t, config.loadChain())
+
+	config.P2P.Serve.PeerRequests = 10
+	config.P2P.Serve.TrustedBytes = 1024
+	config.P2P.Serve.Bytes = 4096
+
+	ethConfig, err := config.buildEth(nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(10), ethConfig.Serve.Peer.Requests)
+	assert.Equal(t, uint64(1024), ethConfig.Serve.Trusted.Bytes)
+	assert.Equal(t, uint64(4096), ethConfig.Serve.Global.Bytes)
+}
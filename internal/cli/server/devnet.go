@@ -0,0 +1,478 @@
+package server
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/bor/heimdallstub"
+	"github.com/ethereum/go-ethereum/consensus/bor/valset"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/downloader"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/internal/cli/server/chains"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miner"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+const (
+	// devnetChainID is the chain id of the bor devnet, the same as the one of
+	// the developer mode
+	devnetChainID = 1337
+
+	// devnetVotingPower is the voting power of every devnet validator
+	devnetVotingPower = 10_000
+
+	// devnetConnectTimeout is the time the validators are given to connect
+	// to each other before producing blocks
+	devnetConnectTimeout = 10 * time.Second
+)
+
+// devnetFaucetBalance is the balance of the first validator, the developer account
+var devnetFaucetBalance = new(big.Int).Mul(big.NewInt(1_000_000_000), big.NewInt(params.Ether))
+
+// devnetScript scripts the spans, state sync events and validator outages of
+// a bor devnet
+type devnetScript struct {
+	// Spans are the producers of the spans, the spans not listed are produced
+	// by all the validators
+	Spans []devnetSpan `json:"spans"`
+
+	// StateSyncs are the state sync events sent to the devnet, in order
+	StateSyncs []devnetStateSync `json:"stateSyncs"`
+
+	// Offline are the windows in which validators stop producing blocks
+	Offline []devnetOutage `json:"offline"`
+}
+
+type devnetSpan struct {
+	// ID is the id of the span, span 0 is the span of the genesis
+	ID uint64 `json:"id"`
+
+	// Producers are the indexes of the validators producing the span
+	Producers []int `json:"producers"`
+}
+
+type devnetStateSync struct {
+	// Delay is the number of seconds after the start of the devnet the event is sent
+	Delay uint64 `json:"delay"`
+
+	// Contract is the receiver of the event (onStateReceive)
+	Contract common.Address `json:"contract"`
+
+	// Data is the data of the event
+	Data hexutil.Bytes `json:"data"`
+}
+
+type devnetOutage struct {
+	// Validator is the index of the offline validator
+	Validator int `json:"validator"`
+
+	// From and To are the first block and the block after the last one the
+	// validator is offline for
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// readDevnetScript reads the devnet script in path
+func readDevnetScript(path string) (*devnetScript, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var script devnetScript
+	if err := json.Unmarshal(data, &script); err != nil {
+		return nil, fmt.Errorf("failed to parse devnet script %s: %v", path, err)
+	}
+
+	return &script, nil
+}
+
+func (s *devnetScript) validate(validators int) error {
+	for _, span := range s.Spans {
+		if span.ID == 0 {
+			return errors.New("the producers of span 0 cannot be scripted")
+		}
+
+		if len(span.Producers) == 0 {
+			return fmt.Errorf("span %d has no producers", span.ID)
+		}
+
+		for _, producer := range span.Producers {
+			if producer < 0 || producer >= validators {
+				return fmt.Errorf("invalid producer %d of span %d", producer, span.ID)
+			}
+		}
+	}
+
+	for i, stateSync := range s.StateSyncs {
+		if stateSync.Contract == (common.Address{}) {
+			return fmt.Errorf("state sync %d has no contract", i)
+		}
+	}
+
+	for _, outage := range s.Offline {
+		if outage.Validator < 0 || outage.Validator >= validators {
+			return fmt.Errorf("invalid offline validator %d", outage.Validator)
+		}
+
+		if outage.From >= outage.To {
+			return fmt.Errorf("invalid offline window [%d, %d) of validator %d", outage.From, outage.To, outage.Validator)
+		}
+	}
+
+	return nil
+}
+
+// devnet is a bor chain produced by in-process validators. The first
+// validator is the developer account of the server, the other ones run in
+// their own in-memory nodes. All of them share a heimdall stub serving the
+// scripted spans and state sync events.
+type devnet struct {
+	keys   []*ecdsa.PrivateKey
+	chain  *chains.Chain
+	stub   *heimdallstub.HeimdallStubClient
+	script *devnetScript
+
+	// nodes and backends are the nodes of the validators, the first one is
+	// the server node
+	nodes    []*node.Node
+	backends []*eth.Ethereum
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// devnetKey returns the deterministic key of the i-th devnet validator
+func devnetKey(i int) (*ecdsa.PrivateKey, error) {
+	return crypto.ToECDSA(crypto.Keccak256([]byte(fmt.Sprintf("bor devnet validator %d", i))))
+}
+
+func newDevnet(config *DeveloperConfig) (*devnet, error) {
+	borConfig := config.Bor
+
+	if borConfig.Validators == 0 {
+		return nil, errors.New("the devnet needs at least one validator")
+	}
+
+	if borConfig.Sprint == 0 || borConfig.Span == 0 || borConfig.Span%borConfig.Sprint != 0 {
+		return nil, fmt.Errorf("span (%d) must be a multiple of the sprint (%d)", borConfig.Span, borConfig.Sprint)
+	}
+
+	script := &devnetScript{}
+
+	if borConfig.Script != "" {
+		var err error
+		if script, err = readDevnetScript(borConfig.Script); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := script.validate(int(borConfig.Validators)); err != nil {
+		return nil, err
+	}
+
+	period := config.Period
+	if period == 0 {
+		period = 2
+	}
+
+	var (
+		keys       []*ecdsa.PrivateKey
+		genesis    []chains.GenesisValidator
+		validators []*valset.Validator
+	)
+
+	for i := 0; i < int(borConfig.Validators); i++ {
+		key, err := devnetKey(i)
+		if err != nil {
+			return nil, err
+		}
+
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+
+		keys = append(keys, key)
+		genesis = append(genesis, chains.GenesisValidator{Address: addr, Power: devnetVotingPower})
+		validators = append(validators, valset.NewValidator(addr, devnetVotingPower))
+		validators[i].ID = uint64(i + 1)
+	}
+
+	start := time.Now()
+
+	chain, err := chains.NewCustomChain(&chains.CustomChainConfig{
+		ChainID:    devnetChainID,
+		Validators: genesis,
+		Alloc: map[common.Address]*big.Int{
+			genesis[0].Address: devnetFaucetBalance,
+		},
+		Period:           period,
+		ProducerDelay:    2 * period,
+		Sprint:           borConfig.Sprint,
+		BackupMultiplier: period,
+		BurntContract:    common.HexToAddress("0x000000000000000000000000000000000000dead"),
+		GasLimit:         config.GasLimit,
+		Timestamp:        uint64(start.Unix()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	producers := map[uint64][]int{}
+	for _, span := range script.Spans {
+		producers[span.ID] = span.Producers
+	}
+
+	stateSyncs := make([]heimdallstub.StateSync, 0, len(script.StateSyncs))
+	for _, stateSync := range script.StateSyncs {
+		stateSyncs = append(stateSyncs, heimdallstub.StateSync{
+			Contract: stateSync.Contract,
+			Data:     stateSync.Data,
+			Time:     start.Add(time.Duration(stateSync.Delay) * time.Second),
+		})
+	}
+
+	stub := heimdallstub.NewHeimdallStubClient(&heimdallstub.Config{
+		ChainID:    chain.Genesis.Config.ChainID.String(),
+		Validators: validators,
+		SpanLength: borConfig.Span,
+		Producers:  producers,
+		StateSyncs: stateSyncs,
+	})
+
+	return &devnet{
+		keys:   keys,
+		chain:  chain,
+		stub:   stub,
+		script: script,
+		quit:   make(chan struct{}),
+	}, nil
+}
+
+// importDeveloperKey imports the key of the first validator in ks
+func (d *devnet) importDeveloperKey(ks *keystore.KeyStore) (accounts.Account, error) {
+	account, err := ks.ImportECDSA(d.keys[0], "")
+	if errors.Is(err, keystore.ErrAccountAlreadyExists) {
+		return accounts.Account{Address: crypto.PubkeyToAddress(d.keys[0].PublicKey)}, nil
+	}
+
+	return account, err
+}
+
+// start starts the other validators of the devnet and the block production
+// once they are all connected. The server node must be running.
+func (d *devnet) start(stack *node.Node, backend *eth.Ethereum) error {
+	d.stub.SetBackend(backend.APIBackend)
+
+	d.nodes = append(d.nodes, stack)
+	d.backends = append(d.backends, backend)
+
+	for i := 1; i < len(d.keys); i++ {
+		if err := d.startValidator(i); err != nil {
+			return fmt.Errorf("failed to start devnet validator %d: %v", i, err)
+		}
+	}
+
+	// bor can't sync forks crossing a sprint, the blocks are only produced
+	// once every validator receives them as they are sealed
+	if !d.waitPeers() {
+		log.Warn("Devnet validators not connected, producing blocks anyway")
+	}
+
+	for i, backend := range d.backends {
+		if err := backend.StartMining(); err != nil {
+			return fmt.Errorf("failed to start devnet validator %d: %v", i, err)
+		}
+	}
+
+	log.Info("Started bor devnet", "validators", len(d.keys), "chainID", devnetChainID)
+
+	if len(d.script.Offline) > 0 {
+		ch := make(chan core.ChainHeadEvent, 16)
+		sub := backend.BlockChain().SubscribeChainHeadEvent(ch)
+
+		d.wg.Add(1)
+
+		go func() {
+			defer d.wg.Done()
+			defer sub.Unsubscribe()
+
+			d.outageLoop(ch, sub.Err())
+		}()
+	}
+
+	return nil
+}
+
+// startValidator starts the node of the i-th validator in memory and
+// connects it to the nodes of the validators started before
+func (d *devnet) startValidator(i int) error {
+	stack, err := node.New(&node.Config{
+		Name:    "bor",
+		Version: params.VersionWithMeta,
+		P2P: p2p.Config{
+			ListenAddr:  "127.0.0.1:0",
+			NoDiscovery: true,
+			MaxPeers:    len(d.keys),
+		},
+		UseLightweightKDF: true,
+	})
+	if err != nil {
+		return err
+	}
+
+	etherbase := crypto.PubkeyToAddress(d.keys[i].PublicKey)
+	genesis := d.chain.Genesis
+
+	backend, err := eth.New(stack, &ethconfig.Config{
+		Genesis:         genesis,
+		NetworkId:       d.chain.NetworkId,
+		SyncMode:        downloader.FullSync,
+		DatabaseCache:   64,
+		DatabaseHandles: 64,
+		TxPool:          legacypool.DefaultConfig,
+		GPO:             ethconfig.Defaults.GPO,
+		Miner: miner.Config{
+			Etherbase: etherbase,
+			GasCeil:   genesis.GasLimit,
+			GasPrice:  big.NewInt(1),
+			Recommit:  time.Second,
+		},
+		HeimdallClient: d.stub,
+	})
+	if err != nil {
+		stack.Close()
+		return err
+	}
+
+	ks := keystore.NewKeyStore(stack.KeyStoreDir(), keystore.LightScryptN, keystore.LightScryptP)
+
+	account, err := ks.ImportECDSA(d.keys[i], "")
+	if err != nil {
+		stack.Close()
+		return err
+	}
+
+	if err := ks.Unlock(account, ""); err != nil {
+		stack.Close()
+		return err
+	}
+
+	backend.AccountManager().AddBackend(ks)
+
+	if err := stack.Start(); err != nil {
+		stack.Close()
+		return err
+	}
+
+	for _, peer := range d.nodes {
+		stack.Server().AddPeer(peer.Server().Self())
+	}
+
+	d.nodes = append(d.nodes, stack)
+	d.backends = append(d.backends, backend)
+
+	log.Info("Started devnet validator", "index", i, "address", etherbase, "enode", stack.Server().Self().URLv4())
+
+	return nil
+}
+
+// waitPeers waits until the nodes of the validators are all connected
+func (d *devnet) waitPeers() bool {
+	timeout := time.After(devnetConnectTimeout)
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		connected := true
+
+		for _, stack := range d.nodes {
+			if stack.Server().PeerCount() < len(d.nodes)-1 {
+				connected = false
+			}
+		}
+
+		if connected {
+			return true
+		}
+
+		select {
+		case <-ticker.C:
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+// outageLoop stops and restarts the miners of the validators during their
+// scripted offline windows
+func (d *devnet) outageLoop(ch <-chan core.ChainHeadEvent, errCh <-chan error) {
+	offline := make([]bool, len(d.keys))
+
+	for {
+		select {
+		case ev := <-ch:
+			next := ev.Block.NumberU64() + 1
+
+			for i, backend := range d.backends {
+				var off bool
+
+				for _, outage := range d.script.Offline {
+					if outage.Validator == i && next >= outage.From && next < outage.To {
+						off = true
+					}
+				}
+
+				if off == offline[i] {
+					continue
+				}
+
+				offline[i] = off
+
+				if off {
+					log.Info("Devnet validator going offline", "index", i, "number", next)
+					backend.StopMining()
+				} else {
+					log.Info("Devnet validator back online", "index", i, "number", next)
+
+					if err := backend.StartMining(); err != nil {
+						log.Error("Failed to restart devnet validator", "index", i, "err", err)
+					}
+				}
+			}
+		case <-errCh:
+			return
+		case <-d.quit:
+			return
+		}
+	}
+}
+
+// close stops the validators started by the devnet
+func (d *devnet) close() {
+	close(d.quit)
+	d.wg.Wait()
+
+	// the first node is the server node, it is closed by the server
+	for i := len(d.nodes) - 1; i > 0; i-- {
+		if err := d.nodes[i].Close(); err != nil {
+			log.Error("Failed to close devnet validator", "index", i, "err", err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package server
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDevnetScript(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "script.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"spans": [{"id": 2, "producers": [1]}],
+		"stateSyncs": [{"delay": 10, "contract": "0x000000000000000000000000000000000000beef", "data": "0x0102"}],
+		"offline": [{"validator": 0, "from": 20, "to": 40}]
+	}`), 0600))
+
+	script, err := readDevnetScript(path)
+	require.NoError(t, err)
+	require.NoError(t, script.validate(2))
+
+	require.Equal(t, []devnetSpan{{ID: 2, Producers: []int{1}}}, script.Spans)
+	require.Equal(t, uint64(10), script.StateSyncs[0].Delay)
+	require.Equal(t, common.HexToAddress("0xbeef"), script.StateSyncs[0].Contract)
+	require.Equal(t, []byte{1, 2}, []byte(script.StateSyncs[0].Data))
+	require.Equal(t, []devnetOutage{{Validator: 0, From: 20, To: 40}}, script.Offline)
+
+	// validator 1 does not exist in a devnet of one validator
+	require.Error(t, script.validate(1))
+
+	invalid := []*devnetScript{
+		{Spans: []devnetSpan{{ID: 0, Producers: []int{0}}}},
+		{Spans: []devnetSpan{{ID: 1}}},
+		{StateSyncs: []devnetStateSync{{Delay: 1}}},
+		{Offline: []devnetOutage{{Validator: 0, From: 10, To: 10}}},
+	}
+
+	for _, script := range invalid {
+		require.Error(t, script.validate(2))
+	}
+}
+
+func TestNewDevnet(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig().Developer
+	config.Bor.Enabled = true
+	config.Bor.Validators = 3
+
+	d, err := newDevnet(config)
+	require.NoError(t, err)
+	require.Len(t, d.keys, 3)
+
+	genesis := d.chain.Genesis
+	require.Equal(t, uint64(devnetChainID), genesis.Config.ChainID.Uint64())
+	require.Equal(t, config.Bor.Sprint, genesis.Config.Bor.CalculateSprint(0))
+	require.True(t, genesis.Alloc[crypto.PubkeyToAddress(d.keys[0].PublicKey)].Balance.Cmp(devnetFaucetBalance) >= 0)
+
+	// the keys are deterministic
+	key, err := devnetKey(2)
+	require.NoError(t, err)
+	require.Equal(t, key, d.keys[2])
+
+	span, err := d.stub.Span(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, uint64(256), span.StartBlock)
+	require.Equal(t, uint64(256)+config.Bor.Span-1, span.EndBlock)
+	require.Len(t, span.SelectedProducers, 3)
+
+	events, err := d.stub.StateSyncEvents(context.Background(), 1, time.Now().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	require.Empty(t, events)
+
+	config.Bor.Span = config.Bor.Sprint + 1
+
+	_, err = newDevnet(config)
+	require.Error(t, err)
+}
@@ -15,7 +15,7 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 
 	f.StringFlag(&flagset.StringFlag{
 		Name:    "chain",
-		Usage:   "Name of the chain to sync ('amoy', 'mumbai', 'mainnet') or path to a genesis file",
+		Usage:   "Name of the chain to sync ('amoy', 'mumbai', 'mainnet', or a chain created with 'bor chain init') or path to a genesis file",
 		Value:   &c.cliConfig.Chain,
 		Default: c.cliConfig.Chain,
 	})
@@ -88,7 +88,7 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 	})
 	f.StringFlag(&flagset.StringFlag{
 		Name:    "syncmode",
-		Usage:   `Blockchain sync mode (only "full" sync supported)`,
+		Usage:   `Blockchain sync mode ("full" or "checkpoint", a snap sync anchored on the latest heimdall checkpoint)`,
 		Value:   &c.cliConfig.SyncMode,
 		Default: c.cliConfig.SyncMode,
 	})
@@ -118,10 +118,22 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 	})
 	f.BoolFlag(&flagset.BoolFlag{
 		Name:    "bor.logs",
-		Usage:   `Enables bor log retrieval`,
+		Usage:   `Enables bor log retrieval, merging the state sync logs into eth_getLogs and eth_getFilterLogs`,
 		Value:   &c.cliConfig.BorLogs,
 		Default: c.cliConfig.BorLogs,
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "bor.addressindex",
+		Usage:   `Enables the index of the transactions each address is involved in (bor_getAddressActivity)`,
+		Value:   &c.cliConfig.AddressIndex,
+		Default: c.cliConfig.AddressIndex,
+	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "bor.logindex",
+		Usage:   `Enables the log index, used instead of the bloom bits to filter logs`,
+		Value:   &c.cliConfig.LogIndex,
+		Default: c.cliConfig.LogIndex,
+	})
 
 	// logging related flags (log-level and verbosity is present above, it will be removed soon)
 	f.StringFlag(&flagset.StringFlag{
@@ -444,6 +456,20 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 		Default: c.cliConfig.Cache.FilterLogCacheSize,
 		Group:   "Cache",
 	})
+	f.IntFlag(&flagset.IntFlag{
+		Name:    "cache.trace",
+		Usage:   "Megabytes of disk allocated to caching transaction trace results (0 = disabled)",
+		Value:   &c.cliConfig.Cache.TraceCache,
+		Default: c.cliConfig.Cache.TraceCache,
+		Group:   "Cache",
+	})
+	f.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:    "cache.trace.prefill",
+		Usage:   "Comma separated list of tracers run on imported blocks to fill the trace cache",
+		Value:   &c.cliConfig.Cache.TraceCachePrefill,
+		Default: c.cliConfig.Cache.TraceCachePrefill,
+		Group:   "Cache",
+	})
 	f.Uint64Flag(&flagset.Uint64Flag{
 		Name:    "txlookuplimit",
 		Usage:   "Number of recent blocks to maintain transactions index for",
@@ -511,6 +537,13 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 		Default: c.cliConfig.JsonRPC.TxFeeCap,
 		Group:   "JsonRPC",
 	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "rpc.tracefilterrange",
+		Usage:   "Sets the maximum number of blocks a trace_filter request can span (0=no limit)",
+		Value:   &c.cliConfig.JsonRPC.TraceFilterRange,
+		Default: c.cliConfig.JsonRPC.TraceFilterRange,
+		Group:   "JsonRPC",
+	})
 	f.BoolFlag(&flagset.BoolFlag{
 		Name:    "rpc.allow-unprotected-txs",
 		Usage:   "Allow for unprotected (non EIP155 signed) transactions to be submitted via RPC",
@@ -806,6 +839,13 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 		Default: c.cliConfig.P2P.TxArrivalWait,
 		Group:   "P2P",
 	})
+	f.MapStringFlag(&flagset.MapStringFlag{
+		Name:    "validatornodes",
+		Usage:   "Comma separated validator address-to-enode mappings, keeping direct connections to the upcoming block producers (<address>=<enode>)",
+		Value:   &c.cliConfig.P2P.ValidatorNodes,
+		Default: c.cliConfig.P2P.ValidatorNodes,
+		Group:   "P2P",
+	})
 	f.SliceStringFlag(&flagset.SliceStringFlag{
 		Name:    "discovery.dns",
 		Usage:   "Comma separated list of enrtree:// URLs which will be queried for nodes to connect to",
@@ -813,6 +853,62 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 		Default: c.cliConfig.P2P.Discovery.DNS,
 		Group:   "P2P",
 	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "serve.peerrequests",
+		Usage:   "Number of header, body and receipt requests served per second to each peer (0 = unlimited)",
+		Value:   &c.cliConfig.P2P.Serve.PeerRequests,
+		Default: c.cliConfig.P2P.Serve.PeerRequests,
+		Group:   "P2P",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "serve.peerbytes",
+		Usage:   "Number of response bytes served per second to each peer (0 = unlimited)",
+		Value:   &c.cliConfig.P2P.Serve.PeerBytes,
+		Default: c.cliConfig.P2P.Serve.PeerBytes,
+		Group:   "P2P",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "serve.trustedrequests",
+		Usage:   "Number of header, body and receipt requests served per second to each trusted or static peer (0 = unlimited)",
+		Value:   &c.cliConfig.P2P.Serve.TrustedRequests,
+		Default: c.cliConfig.P2P.Serve.TrustedRequests,
+		Group:   "P2P",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "serve.trustedbytes",
+		Usage:   "Number of response bytes served per second to each trusted or static peer (0 = unlimited)",
+		Value:   &c.cliConfig.P2P.Serve.TrustedBytes,
+		Default: c.cliConfig.P2P.Serve.TrustedBytes,
+		Group:   "P2P",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "serve.requests",
+		Usage:   "Number of header, body and receipt requests served per second to all the peers except trusted and static ones (0 = unlimited)",
+		Value:   &c.cliConfig.P2P.Serve.Requests,
+		Default: c.cliConfig.P2P.Serve.Requests,
+		Group:   "P2P",
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "serve.bytes",
+		Usage:   "Number of response bytes served per second to all the peers except trusted and static ones (0 = unlimited)",
+		Value:   &c.cliConfig.P2P.Serve.Bytes,
+		Default: c.cliConfig.P2P.Serve.Bytes,
+		Group:   "P2P",
+	})
+	f.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:    "discovery.roles",
+		Usage:   "Comma separated list of node roles (full|archive|validator|sentry|rpc) the discovered nodes must advertise in their bor ENR entry to be dialed",
+		Value:   &c.cliConfig.P2P.Discovery.Roles,
+		Default: c.cliConfig.P2P.Discovery.Roles,
+		Group:   "P2P",
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "noderole",
+		Usage:   "Role of the node advertised in its bor ENR entry (full|archive|validator|sentry|rpc), derived from the gcmode and the mining setting if empty",
+		Value:   &c.cliConfig.P2P.NodeRole,
+		Default: c.cliConfig.P2P.NodeRole,
+		Group:   "P2P",
+	})
 
 	// metrics
 	f.BoolFlag(&flagset.BoolFlag{
@@ -986,12 +1082,48 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 		Value:   &c.cliConfig.ParallelEVM.SpeculativeProcesses,
 		Default: c.cliConfig.ParallelEVM.SpeculativeProcesses,
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "parallelevm.pipeline",
+		Usage:   "Execute each imported block while the state of its parent is committed",
+		Value:   &c.cliConfig.ParallelEVM.Pipeline,
+		Default: c.cliConfig.ParallelEVM.Pipeline,
+	})
 	f.Uint64Flag(&flagset.Uint64Flag{
 		Name:    "dev.gaslimit",
 		Usage:   "Initial block gas limit",
 		Value:   &c.cliConfig.Developer.GasLimit,
 		Default: c.cliConfig.Developer.GasLimit,
 	})
+	f.BoolFlag(&flagset.BoolFlag{
+		Name:    "dev.bor",
+		Usage:   "Run the developer mode as a bor devnet with in-process validators and heimdall, implies --dev",
+		Value:   &c.cliConfig.Developer.Bor.Enabled,
+		Default: c.cliConfig.Developer.Bor.Enabled,
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "dev.bor.validators",
+		Usage:   "Number of validators of the bor devnet, the first one is the developer account",
+		Value:   &c.cliConfig.Developer.Bor.Validators,
+		Default: c.cliConfig.Developer.Bor.Validators,
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "dev.bor.sprint",
+		Usage:   "Number of blocks produced by the same validator in the bor devnet",
+		Value:   &c.cliConfig.Developer.Bor.Sprint,
+		Default: c.cliConfig.Developer.Bor.Sprint,
+	})
+	f.Uint64Flag(&flagset.Uint64Flag{
+		Name:    "dev.bor.span",
+		Usage:   "Number of blocks of the spans of the bor devnet (multiple of the sprint)",
+		Value:   &c.cliConfig.Developer.Bor.Span,
+		Default: c.cliConfig.Developer.Bor.Span,
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "dev.bor.script",
+		Usage:   "Path of the json file scripting the span producers, state sync events and validator outages of the bor devnet",
+		Value:   &c.cliConfig.Developer.Bor.Script,
+		Default: c.cliConfig.Developer.Bor.Script,
+	})
 
 	// pprof
 	f.BoolFlag(&flagset.BoolFlag{
@@ -1031,5 +1163,48 @@ func (c *Command) Flags(config *Config) *flagset.Flagset {
 	// 	Default: c.cliConfig.Pprof.CPUProfile,
 	// })
 
+	// vmtrace
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "vmtrace.name",
+		Usage:   "Name of the live tracer run during block import (e.g. supply)",
+		Value:   &c.cliConfig.VMTrace.Name,
+		Default: c.cliConfig.VMTrace.Name,
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "vmtrace.jsonconfig",
+		Usage:   "Json configuration of the live tracer",
+		Value:   &c.cliConfig.VMTrace.JSONConfig,
+		Default: c.cliConfig.VMTrace.JSONConfig,
+	})
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "vmtrace.path",
+		Usage:   "Directory the live tracer writes its output to",
+		Value:   &c.cliConfig.VMTrace.Path,
+		Default: c.cliConfig.VMTrace.Path,
+	})
+
+	// sentry
+	f.StringFlag(&flagset.StringFlag{
+		Name:    "sentry.mode",
+		Usage:   "Role of the node in a sentry architecture: validator (only reachable through its sentries) or sentry (relaying for private validators)",
+		Value:   &c.cliConfig.Sentry.Mode,
+		Default: c.cliConfig.Sentry.Mode,
+		Group:   "P2P",
+	})
+	f.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:    "sentry.sentries",
+		Usage:   "Comma separated enode URLs of the sentry nodes of a validator node",
+		Value:   &c.cliConfig.Sentry.Sentries,
+		Default: c.cliConfig.Sentry.Sentries,
+		Group:   "P2P",
+	})
+	f.SliceStringFlag(&flagset.SliceStringFlag{
+		Name:    "sentry.privatepeers",
+		Usage:   "Comma separated enode URLs of the private validator nodes behind a sentry node, kept out of discovery and peer lists",
+		Value:   &c.cliConfig.Sentry.PrivatePeers,
+		Default: c.cliConfig.Sentry.PrivatePeers,
+		Group:   "P2P",
+	})
+
 	return f
 }
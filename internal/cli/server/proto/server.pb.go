@@ -46,7 +46,6 @@ var (
 func (x DebugPprofRequest_Type) Enum() *DebugPprofRequest_Type {
 	p := new(DebugPprofRequest_Type)
 	*p = x
-
 	return p
 }
 
@@ -81,7 +80,6 @@ type TraceRequest struct {
 
 func (x *TraceRequest) Reset() {
 	*x = TraceRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -97,16 +95,13 @@ func (*TraceRequest) ProtoMessage() {}
 
 func (x *TraceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[0]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -119,7 +114,6 @@ func (x *TraceRequest) GetNumber() int64 {
 	if x != nil {
 		return x.Number
 	}
-
 	return 0
 }
 
@@ -131,7 +125,6 @@ type TraceResponse struct {
 
 func (x *TraceResponse) Reset() {
 	*x = TraceResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -147,16 +140,13 @@ func (*TraceResponse) ProtoMessage() {}
 
 func (x *TraceResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[1]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -173,7 +163,6 @@ type ChainWatchRequest struct {
 
 func (x *ChainWatchRequest) Reset() {
 	*x = ChainWatchRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -189,16 +178,13 @@ func (*ChainWatchRequest) ProtoMessage() {}
 
 func (x *ChainWatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[2]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -219,7 +205,6 @@ type ChainWatchResponse struct {
 
 func (x *ChainWatchResponse) Reset() {
 	*x = ChainWatchResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -235,16 +220,13 @@ func (*ChainWatchResponse) ProtoMessage() {}
 
 func (x *ChainWatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[3]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -257,7 +239,6 @@ func (x *ChainWatchResponse) GetOldchain() []*BlockStub {
 	if x != nil {
 		return x.Oldchain
 	}
-
 	return nil
 }
 
@@ -265,7 +246,6 @@ func (x *ChainWatchResponse) GetNewchain() []*BlockStub {
 	if x != nil {
 		return x.Newchain
 	}
-
 	return nil
 }
 
@@ -273,7 +253,6 @@ func (x *ChainWatchResponse) GetType() string {
 	if x != nil {
 		return x.Type
 	}
-
 	return ""
 }
 
@@ -288,7 +267,6 @@ type BlockStub struct {
 
 func (x *BlockStub) Reset() {
 	*x = BlockStub{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -304,16 +282,13 @@ func (*BlockStub) ProtoMessage() {}
 
 func (x *BlockStub) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[4]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -326,7 +301,6 @@ func (x *BlockStub) GetHash() string {
 	if x != nil {
 		return x.Hash
 	}
-
 	return ""
 }
 
@@ -334,7 +308,6 @@ func (x *BlockStub) GetNumber() uint64 {
 	if x != nil {
 		return x.Number
 	}
-
 	return 0
 }
 
@@ -349,7 +322,6 @@ type PeersAddRequest struct {
 
 func (x *PeersAddRequest) Reset() {
 	*x = PeersAddRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,16 +337,13 @@ func (*PeersAddRequest) ProtoMessage() {}
 
 func (x *PeersAddRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[5]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -387,7 +356,6 @@ func (x *PeersAddRequest) GetEnode() string {
 	if x != nil {
 		return x.Enode
 	}
-
 	return ""
 }
 
@@ -395,7 +363,6 @@ func (x *PeersAddRequest) GetTrusted() bool {
 	if x != nil {
 		return x.Trusted
 	}
-
 	return false
 }
 
@@ -407,7 +374,6 @@ type PeersAddResponse struct {
 
 func (x *PeersAddResponse) Reset() {
 	*x = PeersAddResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -423,16 +389,13 @@ func (*PeersAddResponse) ProtoMessage() {}
 
 func (x *PeersAddResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[6]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -452,7 +415,6 @@ type PeersRemoveRequest struct {
 
 func (x *PeersRemoveRequest) Reset() {
 	*x = PeersRemoveRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -468,16 +430,13 @@ func (*PeersRemoveRequest) ProtoMessage() {}
 
 func (x *PeersRemoveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[7]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -490,7 +449,6 @@ func (x *PeersRemoveRequest) GetEnode() string {
 	if x != nil {
 		return x.Enode
 	}
-
 	return ""
 }
 
@@ -498,7 +456,6 @@ func (x *PeersRemoveRequest) GetTrusted() bool {
 	if x != nil {
 		return x.Trusted
 	}
-
 	return false
 }
 
@@ -510,7 +467,6 @@ type PeersRemoveResponse struct {
 
 func (x *PeersRemoveResponse) Reset() {
 	*x = PeersRemoveResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -526,16 +482,13 @@ func (*PeersRemoveResponse) ProtoMessage() {}
 
 func (x *PeersRemoveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[8]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -552,7 +505,6 @@ type PeersListRequest struct {
 
 func (x *PeersListRequest) Reset() {
 	*x = PeersListRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -568,16 +520,13 @@ func (*PeersListRequest) ProtoMessage() {}
 
 func (x *PeersListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[9]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -596,7 +545,6 @@ type PeersListResponse struct {
 
 func (x *PeersListResponse) Reset() {
 	*x = PeersListResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,16 +560,13 @@ func (*PeersListResponse) ProtoMessage() {}
 
 func (x *PeersListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[10]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -634,7 +579,6 @@ func (x *PeersListResponse) GetPeers() []*Peer {
 	if x != nil {
 		return x.Peers
 	}
-
 	return nil
 }
 
@@ -648,7 +592,6 @@ type PeersStatusRequest struct {
 
 func (x *PeersStatusRequest) Reset() {
 	*x = PeersStatusRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -664,16 +607,13 @@ func (*PeersStatusRequest) ProtoMessage() {}
 
 func (x *PeersStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[11]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -686,7 +626,6 @@ func (x *PeersStatusRequest) GetEnode() string {
 	if x != nil {
 		return x.Enode
 	}
-
 	return ""
 }
 
@@ -700,7 +639,6 @@ type PeersStatusResponse struct {
 
 func (x *PeersStatusResponse) Reset() {
 	*x = PeersStatusResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -716,16 +654,13 @@ func (*PeersStatusResponse) ProtoMessage() {}
 
 func (x *PeersStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[12]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -738,7 +673,6 @@ func (x *PeersStatusResponse) GetPeer() *Peer {
 	if x != nil {
 		return x.Peer
 	}
-
 	return nil
 }
 
@@ -747,18 +681,19 @@ type Peer struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Enode   string   `protobuf:"bytes,2,opt,name=enode,proto3" json:"enode,omitempty"`
-	Enr     string   `protobuf:"bytes,3,opt,name=enr,proto3" json:"enr,omitempty"`
-	Caps    []string `protobuf:"bytes,4,rep,name=caps,proto3" json:"caps,omitempty"`
-	Name    string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Trusted bool     `protobuf:"varint,6,opt,name=trusted,proto3" json:"trusted,omitempty"`
-	Static  bool     `protobuf:"varint,7,opt,name=static,proto3" json:"static,omitempty"`
+	Id          string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Enode       string   `protobuf:"bytes,2,opt,name=enode,proto3" json:"enode,omitempty"`
+	Enr         string   `protobuf:"bytes,3,opt,name=enr,proto3" json:"enr,omitempty"`
+	Caps        []string `protobuf:"bytes,4,rep,name=caps,proto3" json:"caps,omitempty"`
+	Name        string   `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Trusted     bool     `protobuf:"varint,6,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	Static      bool     `protobuf:"varint,7,opt,name=static,proto3" json:"static,omitempty"`
+	Score       float64  `protobuf:"fixed64,8,opt,name=score,proto3" json:"score,omitempty"`
+	BannedUntil int64    `protobuf:"varint,9,opt,name=bannedUntil,proto3" json:"bannedUntil,omitempty"`
 }
 
 func (x *Peer) Reset() {
 	*x = Peer{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -774,16 +709,13 @@ func (*Peer) ProtoMessage() {}
 
 func (x *Peer) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[13]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -796,7 +728,6 @@ func (x *Peer) GetId() string {
 	if x != nil {
 		return x.Id
 	}
-
 	return ""
 }
 
@@ -804,7 +735,6 @@ func (x *Peer) GetEnode() string {
 	if x != nil {
 		return x.Enode
 	}
-
 	return ""
 }
 
@@ -812,7 +742,6 @@ func (x *Peer) GetEnr() string {
 	if x != nil {
 		return x.Enr
 	}
-
 	return ""
 }
 
@@ -820,7 +749,6 @@ func (x *Peer) GetCaps() []string {
 	if x != nil {
 		return x.Caps
 	}
-
 	return nil
 }
 
@@ -828,7 +756,6 @@ func (x *Peer) GetName() string {
 	if x != nil {
 		return x.Name
 	}
-
 	return ""
 }
 
@@ -836,7 +763,6 @@ func (x *Peer) GetTrusted() bool {
 	if x != nil {
 		return x.Trusted
 	}
-
 	return false
 }
 
@@ -844,10 +770,23 @@ func (x *Peer) GetStatic() bool {
 	if x != nil {
 		return x.Static
 	}
-
 	return false
 }
 
+func (x *Peer) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *Peer) GetBannedUntil() int64 {
+	if x != nil {
+		return x.BannedUntil
+	}
+	return 0
+}
+
 type ChainSetHeadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -858,7 +797,6 @@ type ChainSetHeadRequest struct {
 
 func (x *ChainSetHeadRequest) Reset() {
 	*x = ChainSetHeadRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -874,16 +812,13 @@ func (*ChainSetHeadRequest) ProtoMessage() {}
 
 func (x *ChainSetHeadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[14]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -896,7 +831,6 @@ func (x *ChainSetHeadRequest) GetNumber() uint64 {
 	if x != nil {
 		return x.Number
 	}
-
 	return 0
 }
 
@@ -908,7 +842,6 @@ type ChainSetHeadResponse struct {
 
 func (x *ChainSetHeadResponse) Reset() {
 	*x = ChainSetHeadResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -924,16 +857,13 @@ func (*ChainSetHeadResponse) ProtoMessage() {}
 
 func (x *ChainSetHeadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[15]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -952,7 +882,6 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,16 +897,13 @@ func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[16]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -990,7 +916,6 @@ func (x *StatusRequest) GetWait() bool {
 	if x != nil {
 		return x.Wait
 	}
-
 	return false
 }
 
@@ -1009,7 +934,6 @@ type StatusResponse struct {
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1025,16 +949,13 @@ func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[17]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -1047,7 +968,6 @@ func (x *StatusResponse) GetCurrentBlock() *Header {
 	if x != nil {
 		return x.CurrentBlock
 	}
-
 	return nil
 }
 
@@ -1055,7 +975,6 @@ func (x *StatusResponse) GetCurrentHeader() *Header {
 	if x != nil {
 		return x.CurrentHeader
 	}
-
 	return nil
 }
 
@@ -1063,7 +982,6 @@ func (x *StatusResponse) GetNumPeers() int64 {
 	if x != nil {
 		return x.NumPeers
 	}
-
 	return 0
 }
 
@@ -1071,7 +989,6 @@ func (x *StatusResponse) GetSyncMode() string {
 	if x != nil {
 		return x.SyncMode
 	}
-
 	return ""
 }
 
@@ -1079,7 +996,6 @@ func (x *StatusResponse) GetSyncing() *StatusResponse_Syncing {
 	if x != nil {
 		return x.Syncing
 	}
-
 	return nil
 }
 
@@ -1087,7 +1003,6 @@ func (x *StatusResponse) GetForks() []*StatusResponse_Fork {
 	if x != nil {
 		return x.Forks
 	}
-
 	return nil
 }
 
@@ -1102,7 +1017,6 @@ type Header struct {
 
 func (x *Header) Reset() {
 	*x = Header{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1118,16 +1032,13 @@ func (*Header) ProtoMessage() {}
 
 func (x *Header) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[18]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -1140,7 +1051,6 @@ func (x *Header) GetHash() string {
 	if x != nil {
 		return x.Hash
 	}
-
 	return ""
 }
 
@@ -1148,7 +1058,6 @@ func (x *Header) GetNumber() uint64 {
 	if x != nil {
 		return x.Number
 	}
-
 	return 0
 }
 
@@ -1164,7 +1073,6 @@ type DebugPprofRequest struct {
 
 func (x *DebugPprofRequest) Reset() {
 	*x = DebugPprofRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1180,16 +1088,13 @@ func (*DebugPprofRequest) ProtoMessage() {}
 
 func (x *DebugPprofRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[19]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -1202,7 +1107,6 @@ func (x *DebugPprofRequest) GetType() DebugPprofRequest_Type {
 	if x != nil {
 		return x.Type
 	}
-
 	return DebugPprofRequest_LOOKUP
 }
 
@@ -1210,7 +1114,6 @@ func (x *DebugPprofRequest) GetProfile() string {
 	if x != nil {
 		return x.Profile
 	}
-
 	return ""
 }
 
@@ -1218,7 +1121,6 @@ func (x *DebugPprofRequest) GetSeconds() int64 {
 	if x != nil {
 		return x.Seconds
 	}
-
 	return 0
 }
 
@@ -1232,7 +1134,6 @@ type DebugBlockRequest struct {
 
 func (x *DebugBlockRequest) Reset() {
 	*x = DebugBlockRequest{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1248,16 +1149,13 @@ func (*DebugBlockRequest) ProtoMessage() {}
 
 func (x *DebugBlockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[20]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -1270,7 +1168,6 @@ func (x *DebugBlockRequest) GetNumber() int64 {
 	if x != nil {
 		return x.Number
 	}
-
 	return 0
 }
 
@@ -1280,7 +1177,6 @@ type DebugFileResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Event:
-	//
 	//	*DebugFileResponse_Open_
 	//	*DebugFileResponse_Input_
 	//	*DebugFileResponse_Eof
@@ -1289,7 +1185,6 @@ type DebugFileResponse struct {
 
 func (x *DebugFileResponse) Reset() {
 	*x = DebugFileResponse{}
-
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1305,16 +1200,13 @@ func (*DebugFileResponse) ProtoMessage() {}
 
 func (x *DebugFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[21]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
@@ -1327,7 +1219,6 @@ func (m *DebugFileResponse) GetEvent() isDebugFileResponse_Event {
 	if m != nil {
 		return m.Event
 	}
-
 	return nil
 }
 
@@ -1335,7 +1226,6 @@ func (x *DebugFileResponse) GetOpen() *DebugFileResponse_Open {
 	if x, ok := x.GetEvent().(*DebugFileResponse_Open_); ok {
 		return x.Open
 	}
-
 	return nil
 }
 
@@ -1343,7 +1233,6 @@ func (x *DebugFileResponse) GetInput() *DebugFileResponse_Input {
 	if x, ok := x.GetEvent().(*DebugFileResponse_Input_); ok {
 		return x.Input
 	}
-
 	return nil
 }
 
@@ -1351,7 +1240,6 @@ func (x *DebugFileResponse) GetEof() *emptypb.Empty {
 	if x, ok := x.GetEvent().(*DebugFileResponse_Eof); ok {
 		return x.Eof
 	}
-
 	return nil
 }
 
@@ -1377,19 +1265,19 @@ func (*DebugFileResponse_Input_) isDebugFileResponse_Event() {}
 
 func (*DebugFileResponse_Eof) isDebugFileResponse_Event() {}
 
-type StatusResponse_Fork struct {
+type DebugTraceTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Block    int64  `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
-	Disabled bool   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	Hash         string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Tracer       string `protobuf:"bytes,2,opt,name=tracer,proto3" json:"tracer,omitempty"`
+	TracerConfig string `protobuf:"bytes,3,opt,name=tracerConfig,proto3" json:"tracerConfig,omitempty"`
+	Timeout      string `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func (x *StatusResponse_Fork) Reset() {
-	*x = StatusResponse_Fork{}
-
+func (x *DebugTraceTransactionRequest) Reset() {
+	*x = DebugTraceTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1397,69 +1285,67 @@ func (x *StatusResponse_Fork) Reset() {
 	}
 }
 
-func (x *StatusResponse_Fork) String() string {
+func (x *DebugTraceTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_Fork) ProtoMessage() {}
+func (*DebugTraceTransactionRequest) ProtoMessage() {}
 
-func (x *StatusResponse_Fork) ProtoReflect() protoreflect.Message {
+func (x *DebugTraceTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[22]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_Fork.ProtoReflect.Descriptor instead.
-func (*StatusResponse_Fork) Descriptor() ([]byte, []int) {
-	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{17, 0}
+// Deprecated: Use DebugTraceTransactionRequest.ProtoReflect.Descriptor instead.
+func (*DebugTraceTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *StatusResponse_Fork) GetName() string {
+func (x *DebugTraceTransactionRequest) GetHash() string {
 	if x != nil {
-		return x.Name
+		return x.Hash
 	}
-
 	return ""
 }
 
-func (x *StatusResponse_Fork) GetBlock() int64 {
+func (x *DebugTraceTransactionRequest) GetTracer() string {
 	if x != nil {
-		return x.Block
+		return x.Tracer
 	}
-
-	return 0
+	return ""
 }
 
-func (x *StatusResponse_Fork) GetDisabled() bool {
+func (x *DebugTraceTransactionRequest) GetTracerConfig() string {
 	if x != nil {
-		return x.Disabled
+		return x.TracerConfig
 	}
+	return ""
+}
 
-	return false
+func (x *DebugTraceTransactionRequest) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
 }
 
-type StatusResponse_Syncing struct {
+type DebugVerbosityRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartingBlock int64 `protobuf:"varint,1,opt,name=startingBlock,proto3" json:"startingBlock,omitempty"`
-	HighestBlock  int64 `protobuf:"varint,2,opt,name=highestBlock,proto3" json:"highestBlock,omitempty"`
-	CurrentBlock  int64 `protobuf:"varint,3,opt,name=currentBlock,proto3" json:"currentBlock,omitempty"`
+	Level int32 `protobuf:"varint,1,opt,name=level,proto3" json:"level,omitempty"`
 }
 
-func (x *StatusResponse_Syncing) Reset() {
-	*x = StatusResponse_Syncing{}
-
+func (x *DebugVerbosityRequest) Reset() {
+	*x = DebugVerbosityRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1467,67 +1353,44 @@ func (x *StatusResponse_Syncing) Reset() {
 	}
 }
 
-func (x *StatusResponse_Syncing) String() string {
+func (x *DebugVerbosityRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StatusResponse_Syncing) ProtoMessage() {}
+func (*DebugVerbosityRequest) ProtoMessage() {}
 
-func (x *StatusResponse_Syncing) ProtoReflect() protoreflect.Message {
+func (x *DebugVerbosityRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[23]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StatusResponse_Syncing.ProtoReflect.Descriptor instead.
-func (*StatusResponse_Syncing) Descriptor() ([]byte, []int) {
-	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{17, 1}
-}
-
-func (x *StatusResponse_Syncing) GetStartingBlock() int64 {
-	if x != nil {
-		return x.StartingBlock
-	}
-
-	return 0
-}
-
-func (x *StatusResponse_Syncing) GetHighestBlock() int64 {
-	if x != nil {
-		return x.HighestBlock
-	}
-
-	return 0
+// Deprecated: Use DebugVerbosityRequest.ProtoReflect.Descriptor instead.
+func (*DebugVerbosityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *StatusResponse_Syncing) GetCurrentBlock() int64 {
+func (x *DebugVerbosityRequest) GetLevel() int32 {
 	if x != nil {
-		return x.CurrentBlock
+		return x.Level
 	}
-
 	return 0
 }
 
-type DebugFileResponse_Open struct {
+type DebugVerbosityResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *DebugFileResponse_Open) Reset() {
-	*x = DebugFileResponse_Open{}
-
+func (x *DebugVerbosityResponse) Reset() {
+	*x = DebugVerbosityResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1535,51 +1398,39 @@ func (x *DebugFileResponse_Open) Reset() {
 	}
 }
 
-func (x *DebugFileResponse_Open) String() string {
+func (x *DebugVerbosityResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugFileResponse_Open) ProtoMessage() {}
+func (*DebugVerbosityResponse) ProtoMessage() {}
 
-func (x *DebugFileResponse_Open) ProtoReflect() protoreflect.Message {
+func (x *DebugVerbosityResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[24]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugFileResponse_Open.ProtoReflect.Descriptor instead.
-func (*DebugFileResponse_Open) Descriptor() ([]byte, []int) {
-	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *DebugFileResponse_Open) GetHeaders() map[string]string {
-	if x != nil {
-		return x.Headers
-	}
-
-	return nil
+// Deprecated: Use DebugVerbosityResponse.ProtoReflect.Descriptor instead.
+func (*DebugVerbosityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{24}
 }
 
-type DebugFileResponse_Input struct {
+type DebugVmoduleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Pattern string `protobuf:"bytes,1,opt,name=pattern,proto3" json:"pattern,omitempty"`
 }
 
-func (x *DebugFileResponse_Input) Reset() {
-	*x = DebugFileResponse_Input{}
-
+func (x *DebugVmoduleRequest) Reset() {
+	*x = DebugVmoduleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_internal_cli_server_proto_server_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1587,41 +1438,1274 @@ func (x *DebugFileResponse_Input) Reset() {
 	}
 }
 
-func (x *DebugFileResponse_Input) String() string {
+func (x *DebugVmoduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DebugFileResponse_Input) ProtoMessage() {}
+func (*DebugVmoduleRequest) ProtoMessage() {}
 
-func (x *DebugFileResponse_Input) ProtoReflect() protoreflect.Message {
+func (x *DebugVmoduleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_cli_server_proto_server_proto_msgTypes[25]
-
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-
 		return ms
 	}
-
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DebugFileResponse_Input.ProtoReflect.Descriptor instead.
-func (*DebugFileResponse_Input) Descriptor() ([]byte, []int) {
-	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{21, 1}
+// Deprecated: Use DebugVmoduleRequest.ProtoReflect.Descriptor instead.
+func (*DebugVmoduleRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *DebugFileResponse_Input) GetData() []byte {
+func (x *DebugVmoduleRequest) GetPattern() string {
 	if x != nil {
-		return x.Data
+		return x.Pattern
 	}
+	return ""
+}
 
-	return nil
+type DebugVmoduleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var File_internal_cli_server_proto_server_proto protoreflect.FileDescriptor
+func (x *DebugVmoduleResponse) Reset() {
+	*x = DebugVmoduleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugVmoduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugVmoduleResponse) ProtoMessage() {}
+
+func (x *DebugVmoduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugVmoduleResponse.ProtoReflect.Descriptor instead.
+func (*DebugVmoduleResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{26}
+}
+
+type TxPoolStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *TxPoolStatusRequest) Reset() {
+	*x = TxPoolStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxPoolStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPoolStatusRequest) ProtoMessage() {}
+
+func (x *TxPoolStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPoolStatusRequest.ProtoReflect.Descriptor instead.
+func (*TxPoolStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{27}
+}
+
+type TxPoolStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending uint64 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	Queued  uint64 `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (x *TxPoolStatusResponse) Reset() {
+	*x = TxPoolStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxPoolStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPoolStatusResponse) ProtoMessage() {}
+
+func (x *TxPoolStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPoolStatusResponse.ProtoReflect.Descriptor instead.
+func (*TxPoolStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TxPoolStatusResponse) GetPending() uint64 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+func (x *TxPoolStatusResponse) GetQueued() uint64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+type TxPoolInspectRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *TxPoolInspectRequest) Reset() {
+	*x = TxPoolInspectRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxPoolInspectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPoolInspectRequest) ProtoMessage() {}
+
+func (x *TxPoolInspectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPoolInspectRequest.ProtoReflect.Descriptor instead.
+func (*TxPoolInspectRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TxPoolInspectRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type TxPoolInspectResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pending []*PoolTransaction `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	Queued  []*PoolTransaction `protobuf:"bytes,2,rep,name=queued,proto3" json:"queued,omitempty"`
+}
+
+func (x *TxPoolInspectResponse) Reset() {
+	*x = TxPoolInspectResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxPoolInspectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPoolInspectResponse) ProtoMessage() {}
+
+func (x *TxPoolInspectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPoolInspectResponse.ProtoReflect.Descriptor instead.
+func (*TxPoolInspectResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *TxPoolInspectResponse) GetPending() []*PoolTransaction {
+	if x != nil {
+		return x.Pending
+	}
+	return nil
+}
+
+func (x *TxPoolInspectResponse) GetQueued() []*PoolTransaction {
+	if x != nil {
+		return x.Queued
+	}
+	return nil
+}
+
+type PoolTransaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash      string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	From      string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To        string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Nonce     uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Value     string `protobuf:"bytes,5,opt,name=value,proto3" json:"value,omitempty"`
+	Gas       uint64 `protobuf:"varint,6,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasFeeCap string `protobuf:"bytes,7,opt,name=gasFeeCap,proto3" json:"gasFeeCap,omitempty"`
+	GasTipCap string `protobuf:"bytes,8,opt,name=gasTipCap,proto3" json:"gasTipCap,omitempty"`
+}
+
+func (x *PoolTransaction) Reset() {
+	*x = PoolTransaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PoolTransaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PoolTransaction) ProtoMessage() {}
+
+func (x *PoolTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PoolTransaction.ProtoReflect.Descriptor instead.
+func (*PoolTransaction) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PoolTransaction) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *PoolTransaction) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PoolTransaction) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *PoolTransaction) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *PoolTransaction) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *PoolTransaction) GetGas() uint64 {
+	if x != nil {
+		return x.Gas
+	}
+	return 0
+}
+
+func (x *PoolTransaction) GetGasFeeCap() string {
+	if x != nil {
+		return x.GasFeeCap
+	}
+	return ""
+}
+
+func (x *PoolTransaction) GetGasTipCap() string {
+	if x != nil {
+		return x.GasTipCap
+	}
+	return ""
+}
+
+type TxPoolDropRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes []string `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *TxPoolDropRequest) Reset() {
+	*x = TxPoolDropRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxPoolDropRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPoolDropRequest) ProtoMessage() {}
+
+func (x *TxPoolDropRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPoolDropRequest.ProtoReflect.Descriptor instead.
+func (*TxPoolDropRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *TxPoolDropRequest) GetHashes() []string {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+type TxPoolDropResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Dropped []string `protobuf:"bytes,1,rep,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *TxPoolDropResponse) Reset() {
+	*x = TxPoolDropResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxPoolDropResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxPoolDropResponse) ProtoMessage() {}
+
+func (x *TxPoolDropResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxPoolDropResponse.ProtoReflect.Descriptor instead.
+func (*TxPoolDropResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TxPoolDropResponse) GetDropped() []string {
+	if x != nil {
+		return x.Dropped
+	}
+	return nil
+}
+
+type MinerStartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MinerStartRequest) Reset() {
+	*x = MinerStartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerStartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerStartRequest) ProtoMessage() {}
+
+func (x *MinerStartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerStartRequest.ProtoReflect.Descriptor instead.
+func (*MinerStartRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{34}
+}
+
+type MinerStartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MinerStartResponse) Reset() {
+	*x = MinerStartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerStartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerStartResponse) ProtoMessage() {}
+
+func (x *MinerStartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerStartResponse.ProtoReflect.Descriptor instead.
+func (*MinerStartResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{35}
+}
+
+type MinerStopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MinerStopRequest) Reset() {
+	*x = MinerStopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerStopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerStopRequest) ProtoMessage() {}
+
+func (x *MinerStopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerStopRequest.ProtoReflect.Descriptor instead.
+func (*MinerStopRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{36}
+}
+
+type MinerStopResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MinerStopResponse) Reset() {
+	*x = MinerStopResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerStopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerStopResponse) ProtoMessage() {}
+
+func (x *MinerStopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerStopResponse.ProtoReflect.Descriptor instead.
+func (*MinerStopResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{37}
+}
+
+type MinerSetGasCeilRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GasCeil uint64 `protobuf:"varint,1,opt,name=gasCeil,proto3" json:"gasCeil,omitempty"`
+}
+
+func (x *MinerSetGasCeilRequest) Reset() {
+	*x = MinerSetGasCeilRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerSetGasCeilRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerSetGasCeilRequest) ProtoMessage() {}
+
+func (x *MinerSetGasCeilRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerSetGasCeilRequest.ProtoReflect.Descriptor instead.
+func (*MinerSetGasCeilRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *MinerSetGasCeilRequest) GetGasCeil() uint64 {
+	if x != nil {
+		return x.GasCeil
+	}
+	return 0
+}
+
+type MinerSetGasCeilResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MinerSetGasCeilResponse) Reset() {
+	*x = MinerSetGasCeilResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerSetGasCeilResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerSetGasCeilResponse) ProtoMessage() {}
+
+func (x *MinerSetGasCeilResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerSetGasCeilResponse.ProtoReflect.Descriptor instead.
+func (*MinerSetGasCeilResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{39}
+}
+
+type WhitelistStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WhitelistStatusRequest) Reset() {
+	*x = WhitelistStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhitelistStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistStatusRequest) ProtoMessage() {}
+
+func (x *WhitelistStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistStatusRequest.ProtoReflect.Descriptor instead.
+func (*WhitelistStatusRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{40}
+}
+
+type WhitelistStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkpoint *WhitelistStatusResponse_Entry `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Milestone  *WhitelistStatusResponse_Entry `protobuf:"bytes,2,opt,name=milestone,proto3" json:"milestone,omitempty"`
+}
+
+func (x *WhitelistStatusResponse) Reset() {
+	*x = WhitelistStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhitelistStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistStatusResponse) ProtoMessage() {}
+
+func (x *WhitelistStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistStatusResponse.ProtoReflect.Descriptor instead.
+func (*WhitelistStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *WhitelistStatusResponse) GetCheckpoint() *WhitelistStatusResponse_Entry {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+func (x *WhitelistStatusResponse) GetMilestone() *WhitelistStatusResponse_Entry {
+	if x != nil {
+		return x.Milestone
+	}
+	return nil
+}
+
+type WhitelistPurgeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Checkpoint bool `protobuf:"varint,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	Milestone  bool `protobuf:"varint,2,opt,name=milestone,proto3" json:"milestone,omitempty"`
+}
+
+func (x *WhitelistPurgeRequest) Reset() {
+	*x = WhitelistPurgeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhitelistPurgeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistPurgeRequest) ProtoMessage() {}
+
+func (x *WhitelistPurgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistPurgeRequest.ProtoReflect.Descriptor instead.
+func (*WhitelistPurgeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *WhitelistPurgeRequest) GetCheckpoint() bool {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return false
+}
+
+func (x *WhitelistPurgeRequest) GetMilestone() bool {
+	if x != nil {
+		return x.Milestone
+	}
+	return false
+}
+
+type WhitelistPurgeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *WhitelistPurgeResponse) Reset() {
+	*x = WhitelistPurgeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhitelistPurgeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistPurgeResponse) ProtoMessage() {}
+
+func (x *WhitelistPurgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistPurgeResponse.ProtoReflect.Descriptor instead.
+func (*WhitelistPurgeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{43}
+}
+
+type ConfigReloadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigReloadRequest) Reset() {
+	*x = ConfigReloadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigReloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigReloadRequest) ProtoMessage() {}
+
+func (x *ConfigReloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigReloadRequest.ProtoReflect.Descriptor instead.
+func (*ConfigReloadRequest) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{44}
+}
+
+type ConfigReloadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Applied         []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired []string `protobuf:"bytes,2,rep,name=restartRequired,proto3" json:"restartRequired,omitempty"`
+	Ignored         []string `protobuf:"bytes,3,rep,name=ignored,proto3" json:"ignored,omitempty"`
+}
+
+func (x *ConfigReloadResponse) Reset() {
+	*x = ConfigReloadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigReloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigReloadResponse) ProtoMessage() {}
+
+func (x *ConfigReloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigReloadResponse.ProtoReflect.Descriptor instead.
+func (*ConfigReloadResponse) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ConfigReloadResponse) GetApplied() []string {
+	if x != nil {
+		return x.Applied
+	}
+	return nil
+}
+
+func (x *ConfigReloadResponse) GetRestartRequired() []string {
+	if x != nil {
+		return x.RestartRequired
+	}
+	return nil
+}
+
+func (x *ConfigReloadResponse) GetIgnored() []string {
+	if x != nil {
+		return x.Ignored
+	}
+	return nil
+}
+
+type StatusResponse_Fork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Block    int64  `protobuf:"varint,2,opt,name=block,proto3" json:"block,omitempty"`
+	Disabled bool   `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *StatusResponse_Fork) Reset() {
+	*x = StatusResponse_Fork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Fork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Fork) ProtoMessage() {}
+
+func (x *StatusResponse_Fork) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Fork.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Fork) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *StatusResponse_Fork) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatusResponse_Fork) GetBlock() int64 {
+	if x != nil {
+		return x.Block
+	}
+	return 0
+}
+
+func (x *StatusResponse_Fork) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+type StatusResponse_Syncing struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartingBlock int64 `protobuf:"varint,1,opt,name=startingBlock,proto3" json:"startingBlock,omitempty"`
+	HighestBlock  int64 `protobuf:"varint,2,opt,name=highestBlock,proto3" json:"highestBlock,omitempty"`
+	CurrentBlock  int64 `protobuf:"varint,3,opt,name=currentBlock,proto3" json:"currentBlock,omitempty"`
+}
+
+func (x *StatusResponse_Syncing) Reset() {
+	*x = StatusResponse_Syncing{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatusResponse_Syncing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusResponse_Syncing) ProtoMessage() {}
+
+func (x *StatusResponse_Syncing) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusResponse_Syncing.ProtoReflect.Descriptor instead.
+func (*StatusResponse_Syncing) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *StatusResponse_Syncing) GetStartingBlock() int64 {
+	if x != nil {
+		return x.StartingBlock
+	}
+	return 0
+}
+
+func (x *StatusResponse_Syncing) GetHighestBlock() int64 {
+	if x != nil {
+		return x.HighestBlock
+	}
+	return 0
+}
+
+func (x *StatusResponse_Syncing) GetCurrentBlock() int64 {
+	if x != nil {
+		return x.CurrentBlock
+	}
+	return 0
+}
+
+type DebugFileResponse_Open struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Headers map[string]string `protobuf:"bytes,1,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *DebugFileResponse_Open) Reset() {
+	*x = DebugFileResponse_Open{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugFileResponse_Open) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugFileResponse_Open) ProtoMessage() {}
+
+func (x *DebugFileResponse_Open) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugFileResponse_Open.ProtoReflect.Descriptor instead.
+func (*DebugFileResponse_Open) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{21, 0}
+}
+
+func (x *DebugFileResponse_Open) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type DebugFileResponse_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *DebugFileResponse_Input) Reset() {
+	*x = DebugFileResponse_Input{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DebugFileResponse_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DebugFileResponse_Input) ProtoMessage() {}
+
+func (x *DebugFileResponse_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DebugFileResponse_Input.ProtoReflect.Descriptor instead.
+func (*DebugFileResponse_Input) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{21, 1}
+}
+
+func (x *DebugFileResponse_Input) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type WhitelistStatusResponse_Entry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists bool   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	Number uint64 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	Hash   string `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *WhitelistStatusResponse_Entry) Reset() {
+	*x = WhitelistStatusResponse_Entry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_internal_cli_server_proto_server_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhitelistStatusResponse_Entry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistStatusResponse_Entry) ProtoMessage() {}
+
+func (x *WhitelistStatusResponse_Entry) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_cli_server_proto_server_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhitelistStatusResponse_Entry.ProtoReflect.Descriptor instead.
+func (*WhitelistStatusResponse_Entry) Descriptor() ([]byte, []int) {
+	return file_internal_cli_server_proto_server_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *WhitelistStatusResponse_Entry) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *WhitelistStatusResponse_Entry) GetNumber() uint64 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *WhitelistStatusResponse_Entry) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+var File_internal_cli_server_proto_server_proto protoreflect.FileDescriptor
 
 var file_internal_cli_server_proto_server_proto_rawDesc = []byte{
 	0x0a, 0x26, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x73,
@@ -1667,7 +2751,7 @@ var file_internal_cli_server_proto_server_proto_rawDesc = []byte{
 	0x05, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x22, 0x36, 0x0a, 0x13, 0x50, 0x65, 0x65, 0x72, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
 	0x04, 0x70, 0x65, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0x98,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x04, 0x70, 0x65, 0x65, 0x72, 0x22, 0xd0,
 	0x01, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6e, 0x6f, 0x64, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
@@ -1677,123 +2761,279 @@ var file_internal_cli_server_proto_server_proto_rawDesc = []byte{
 	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74,
 	0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
 	0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x68, 0x61,
-	0x69, 0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x23, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x04, 0x57, 0x61, 0x69, 0x74, 0x22, 0xe2, 0x03, 0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x0d, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63,
-	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x53, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6e,
-	0x67, 0x12, 0x30, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x52, 0x05, 0x66, 0x6f,
-	0x72, 0x6b, 0x73, 0x1a, 0x4c, 0x0a, 0x04, 0x46, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
-	0x64, 0x1a, 0x77, 0x0a, 0x07, 0x53, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x34, 0x0a, 0x06, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x22, 0xa2, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62,
-	0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x26, 0x0a,
-	0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x10,
-	0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52,
-	0x41, 0x43, 0x45, 0x10, 0x02, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75,
-	0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62,
-	0x65, 0x72, 0x22, 0xdd, 0x02, 0x0a, 0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x08, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69,
+	0x6c, 0x22, 0x2d, 0x0a, 0x13, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x16, 0x0a, 0x14, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x0a, 0x0d, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x57, 0x61, 0x69,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x57, 0x61, 0x69, 0x74, 0x22, 0xe2, 0x03,
+	0x0a, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x33, 0x0a, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0d, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x75, 0x6d, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6e, 0x75, 0x6d, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x79, 0x6e, 0x63, 0x4d, 0x6f, 0x64, 0x65,
+	0x12, 0x37, 0x0a, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67,
+	0x52, 0x07, 0x73, 0x79, 0x6e, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x66, 0x6f, 0x72,
+	0x6b, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x46, 0x6f, 0x72, 0x6b, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x1a, 0x4c, 0x0a, 0x04, 0x46,
+	0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x1a, 0x77, 0x0a, 0x07, 0x53, 0x79, 0x6e,
+	0x63, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x69,
+	0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x68, 0x69, 0x67, 0x68, 0x65, 0x73, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x22, 0x34, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xa2, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x26, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0a, 0x0a,
+	0x06, 0x4c, 0x4f, 0x4f, 0x4b, 0x55, 0x50, 0x10, 0x00, 0x12, 0x07, 0x0a, 0x03, 0x43, 0x50, 0x55,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10, 0x02, 0x22, 0x2b, 0x0a,
+	0x11, 0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xdd, 0x02, 0x0a, 0x11, 0x44,
 	0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x36, 0x0a,
-	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05,
-	0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x03, 0x65, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6f,
-	0x66, 0x1a, 0x88, 0x01, 0x0a, 0x04, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x44, 0x0a, 0x07, 0x68, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
-	0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x1b, 0x0a, 0x05,
-	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
-	0x6e, 0x74, 0x32, 0xdb, 0x04, 0x0a, 0x03, 0x42, 0x6f, 0x72, 0x12, 0x3b, 0x0a, 0x08, 0x50, 0x65,
-	0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x73,
-	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50,
-	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a,
-	0x09, 0x50, 0x65, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72,
-	0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a,
-	0x0b, 0x50, 0x65, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x50, 0x65, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x48,
-	0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69,
-	0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x74,
-	0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x06,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x61, 0x74, 0x63,
-	0x68, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x57,
-	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75,
-	0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
-	0x65, 0x62, 0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0a,
-	0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71,
+	0x12, 0x33, 0x0a, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x48, 0x00, 0x52,
+	0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62,
+	0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x48, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x2a, 0x0a,
+	0x03, 0x65, 0x6f, 0x66, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x48, 0x00, 0x52, 0x03, 0x65, 0x6f, 0x66, 0x1a, 0x88, 0x01, 0x0a, 0x04, 0x4f, 0x70,
+	0x65, 0x6e, 0x12, 0x44, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75,
+	0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x1b, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x88, 0x01, 0x0a, 0x1c, 0x44,
+	0x65, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x74, 0x72, 0x61, 0x63, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x65,
+	0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74,
+	0x72, 0x61, 0x63, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x2d, 0x0a, 0x15, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72,
+	0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0x0a, 0x13, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x22,
+	0x16, 0x0a, 0x14, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x54, 0x78, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48,
+	0x0a, 0x14, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x12, 0x16, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0x30, 0x0a, 0x14, 0x54, 0x78, 0x50, 0x6f,
+	0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x79, 0x0a, 0x15, 0x54, 0x78,
+	0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x07, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6f,
+	0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x70, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x06, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x22, 0xc3, 0x01, 0x0a, 0x0f, 0x50, 0x6f, 0x6f, 0x6c, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f,
+	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x67, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x43, 0x61, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x73, 0x46, 0x65, 0x65, 0x43, 0x61, 0x70, 0x12, 0x1c, 0x0a,
+	0x09, 0x67, 0x61, 0x73, 0x54, 0x69, 0x70, 0x43, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x61, 0x73, 0x54, 0x69, 0x70, 0x43, 0x61, 0x70, 0x22, 0x2b, 0x0a, 0x11, 0x54,
+	0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x54, 0x78, 0x50, 0x6f,
+	0x6f, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x14, 0x0a,
+	0x12, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x12, 0x0a, 0x10, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x4d, 0x69, 0x6e, 0x65, 0x72,
+	0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x16,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x61, 0x73, 0x43, 0x65, 0x69, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x73, 0x43, 0x65, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x67, 0x61, 0x73, 0x43, 0x65, 0x69, 0x6c,
+	0x22, 0x19, 0x0a, 0x17, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x61, 0x73, 0x43,
+	0x65, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x0a, 0x16, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xf0, 0x01, 0x0a, 0x17, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x42, 0x0a, 0x09, 0x6d, 0x69, 0x6c, 0x65, 0x73,
+	0x74, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x09, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x1a, 0x4b, 0x0a, 0x05, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75,
+	0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x55, 0x0a, 0x15, 0x57, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6d, 0x69, 0x6c, 0x65, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x22,
+	0x18, 0x0a, 0x16, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x72, 0x67,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x74, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x69, 0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69,
+	0x67, 0x6e, 0x6f, 0x72, 0x65, 0x64, 0x32, 0xe4, 0x0b, 0x0a, 0x03, 0x42, 0x6f, 0x72, 0x12, 0x3b,
+	0x0a, 0x08, 0x50, 0x65, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x12, 0x16, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x41, 0x64, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73,
+	0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x09, 0x50, 0x65, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x65, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x53, 0x65, 0x74, 0x48, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x35, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x42, 0x0a, 0x0a,
+	0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x50, 0x70, 0x72, 0x6f, 0x66, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62,
 	0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01,
-	0x42, 0x1c, 0x5a, 0x1a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6c,
-	0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x42, 0x0a, 0x0a, 0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x18,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x58, 0x0a, 0x15, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61,
+	0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x54, 0x72, 0x61, 0x63, 0x65,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x12, 0x4d,
+	0x0a, 0x0e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79,
+	0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65,
+	0x72, 0x62, 0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x65, 0x72, 0x62,
+	0x6f, 0x73, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a,
+	0x0c, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x56, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x4a, 0x0a, 0x0d, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x74,
+	0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
+	0x6e, 0x73, 0x70, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x73, 0x70,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a, 0x0a, 0x54,
+	0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x78, 0x50, 0x6f,
+	0x6f, 0x6c, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x0a, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x17,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x61, 0x73,
+	0x43, 0x65, 0x69, 0x6c, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x65, 0x74, 0x47, 0x61, 0x73, 0x43, 0x65, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x65, 0x74, 0x47, 0x61, 0x73, 0x43, 0x65, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x0f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x0e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x72, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x1c, 0x5a,
+	0x1a, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6c, 0x69, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1805,42 +3045,66 @@ func file_internal_cli_server_proto_server_proto_rawDescGZIP() []byte {
 	file_internal_cli_server_proto_server_proto_rawDescOnce.Do(func() {
 		file_internal_cli_server_proto_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_internal_cli_server_proto_server_proto_rawDescData)
 	})
-
 	return file_internal_cli_server_proto_server_proto_rawDescData
 }
 
 var file_internal_cli_server_proto_server_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_internal_cli_server_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_internal_cli_server_proto_server_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_internal_cli_server_proto_server_proto_goTypes = []interface{}{
-	(DebugPprofRequest_Type)(0),     // 0: proto.DebugPprofRequest.Type
-	(*TraceRequest)(nil),            // 1: proto.TraceRequest
-	(*TraceResponse)(nil),           // 2: proto.TraceResponse
-	(*ChainWatchRequest)(nil),       // 3: proto.ChainWatchRequest
-	(*ChainWatchResponse)(nil),      // 4: proto.ChainWatchResponse
-	(*BlockStub)(nil),               // 5: proto.BlockStub
-	(*PeersAddRequest)(nil),         // 6: proto.PeersAddRequest
-	(*PeersAddResponse)(nil),        // 7: proto.PeersAddResponse
-	(*PeersRemoveRequest)(nil),      // 8: proto.PeersRemoveRequest
-	(*PeersRemoveResponse)(nil),     // 9: proto.PeersRemoveResponse
-	(*PeersListRequest)(nil),        // 10: proto.PeersListRequest
-	(*PeersListResponse)(nil),       // 11: proto.PeersListResponse
-	(*PeersStatusRequest)(nil),      // 12: proto.PeersStatusRequest
-	(*PeersStatusResponse)(nil),     // 13: proto.PeersStatusResponse
-	(*Peer)(nil),                    // 14: proto.Peer
-	(*ChainSetHeadRequest)(nil),     // 15: proto.ChainSetHeadRequest
-	(*ChainSetHeadResponse)(nil),    // 16: proto.ChainSetHeadResponse
-	(*StatusRequest)(nil),           // 17: proto.StatusRequest
-	(*StatusResponse)(nil),          // 18: proto.StatusResponse
-	(*Header)(nil),                  // 19: proto.Header
-	(*DebugPprofRequest)(nil),       // 20: proto.DebugPprofRequest
-	(*DebugBlockRequest)(nil),       // 21: proto.DebugBlockRequest
-	(*DebugFileResponse)(nil),       // 22: proto.DebugFileResponse
-	(*StatusResponse_Fork)(nil),     // 23: proto.StatusResponse.Fork
-	(*StatusResponse_Syncing)(nil),  // 24: proto.StatusResponse.Syncing
-	(*DebugFileResponse_Open)(nil),  // 25: proto.DebugFileResponse.Open
-	(*DebugFileResponse_Input)(nil), // 26: proto.DebugFileResponse.Input
-	nil,                             // 27: proto.DebugFileResponse.Open.HeadersEntry
-	(*emptypb.Empty)(nil),           // 28: google.protobuf.Empty
+	(DebugPprofRequest_Type)(0),           // 0: proto.DebugPprofRequest.Type
+	(*TraceRequest)(nil),                  // 1: proto.TraceRequest
+	(*TraceResponse)(nil),                 // 2: proto.TraceResponse
+	(*ChainWatchRequest)(nil),             // 3: proto.ChainWatchRequest
+	(*ChainWatchResponse)(nil),            // 4: proto.ChainWatchResponse
+	(*BlockStub)(nil),                     // 5: proto.BlockStub
+	(*PeersAddRequest)(nil),               // 6: proto.PeersAddRequest
+	(*PeersAddResponse)(nil),              // 7: proto.PeersAddResponse
+	(*PeersRemoveRequest)(nil),            // 8: proto.PeersRemoveRequest
+	(*PeersRemoveResponse)(nil),           // 9: proto.PeersRemoveResponse
+	(*PeersListRequest)(nil),              // 10: proto.PeersListRequest
+	(*PeersListResponse)(nil),             // 11: proto.PeersListResponse
+	(*PeersStatusRequest)(nil),            // 12: proto.PeersStatusRequest
+	(*PeersStatusResponse)(nil),           // 13: proto.PeersStatusResponse
+	(*Peer)(nil),                          // 14: proto.Peer
+	(*ChainSetHeadRequest)(nil),           // 15: proto.ChainSetHeadRequest
+	(*ChainSetHeadResponse)(nil),          // 16: proto.ChainSetHeadResponse
+	(*StatusRequest)(nil),                 // 17: proto.StatusRequest
+	(*StatusResponse)(nil),                // 18: proto.StatusResponse
+	(*Header)(nil),                        // 19: proto.Header
+	(*DebugPprofRequest)(nil),             // 20: proto.DebugPprofRequest
+	(*DebugBlockRequest)(nil),             // 21: proto.DebugBlockRequest
+	(*DebugFileResponse)(nil),             // 22: proto.DebugFileResponse
+	(*DebugTraceTransactionRequest)(nil),  // 23: proto.DebugTraceTransactionRequest
+	(*DebugVerbosityRequest)(nil),         // 24: proto.DebugVerbosityRequest
+	(*DebugVerbosityResponse)(nil),        // 25: proto.DebugVerbosityResponse
+	(*DebugVmoduleRequest)(nil),           // 26: proto.DebugVmoduleRequest
+	(*DebugVmoduleResponse)(nil),          // 27: proto.DebugVmoduleResponse
+	(*TxPoolStatusRequest)(nil),           // 28: proto.TxPoolStatusRequest
+	(*TxPoolStatusResponse)(nil),          // 29: proto.TxPoolStatusResponse
+	(*TxPoolInspectRequest)(nil),          // 30: proto.TxPoolInspectRequest
+	(*TxPoolInspectResponse)(nil),         // 31: proto.TxPoolInspectResponse
+	(*PoolTransaction)(nil),               // 32: proto.PoolTransaction
+	(*TxPoolDropRequest)(nil),             // 33: proto.TxPoolDropRequest
+	(*TxPoolDropResponse)(nil),            // 34: proto.TxPoolDropResponse
+	(*MinerStartRequest)(nil),             // 35: proto.MinerStartRequest
+	(*MinerStartResponse)(nil),            // 36: proto.MinerStartResponse
+	(*MinerStopRequest)(nil),              // 37: proto.MinerStopRequest
+	(*MinerStopResponse)(nil),             // 38: proto.MinerStopResponse
+	(*MinerSetGasCeilRequest)(nil),        // 39: proto.MinerSetGasCeilRequest
+	(*MinerSetGasCeilResponse)(nil),       // 40: proto.MinerSetGasCeilResponse
+	(*WhitelistStatusRequest)(nil),        // 41: proto.WhitelistStatusRequest
+	(*WhitelistStatusResponse)(nil),       // 42: proto.WhitelistStatusResponse
+	(*WhitelistPurgeRequest)(nil),         // 43: proto.WhitelistPurgeRequest
+	(*WhitelistPurgeResponse)(nil),        // 44: proto.WhitelistPurgeResponse
+	(*ConfigReloadRequest)(nil),           // 45: proto.ConfigReloadRequest
+	(*ConfigReloadResponse)(nil),          // 46: proto.ConfigReloadResponse
+	(*StatusResponse_Fork)(nil),           // 47: proto.StatusResponse.Fork
+	(*StatusResponse_Syncing)(nil),        // 48: proto.StatusResponse.Syncing
+	(*DebugFileResponse_Open)(nil),        // 49: proto.DebugFileResponse.Open
+	(*DebugFileResponse_Input)(nil),       // 50: proto.DebugFileResponse.Input
+	nil,                                   // 51: proto.DebugFileResponse.Open.HeadersEntry
+	(*WhitelistStatusResponse_Entry)(nil), // 52: proto.WhitelistStatusResponse.Entry
+	(*emptypb.Empty)(nil),                 // 53: google.protobuf.Empty
 }
 var file_internal_cli_server_proto_server_proto_depIdxs = []int32{
 	5,  // 0: proto.ChainWatchResponse.oldchain:type_name -> proto.BlockStub
@@ -1849,36 +3113,64 @@ var file_internal_cli_server_proto_server_proto_depIdxs = []int32{
 	14, // 3: proto.PeersStatusResponse.peer:type_name -> proto.Peer
 	19, // 4: proto.StatusResponse.currentBlock:type_name -> proto.Header
 	19, // 5: proto.StatusResponse.currentHeader:type_name -> proto.Header
-	24, // 6: proto.StatusResponse.syncing:type_name -> proto.StatusResponse.Syncing
-	23, // 7: proto.StatusResponse.forks:type_name -> proto.StatusResponse.Fork
+	48, // 6: proto.StatusResponse.syncing:type_name -> proto.StatusResponse.Syncing
+	47, // 7: proto.StatusResponse.forks:type_name -> proto.StatusResponse.Fork
 	0,  // 8: proto.DebugPprofRequest.type:type_name -> proto.DebugPprofRequest.Type
-	25, // 9: proto.DebugFileResponse.open:type_name -> proto.DebugFileResponse.Open
-	26, // 10: proto.DebugFileResponse.input:type_name -> proto.DebugFileResponse.Input
-	28, // 11: proto.DebugFileResponse.eof:type_name -> google.protobuf.Empty
-	27, // 12: proto.DebugFileResponse.Open.headers:type_name -> proto.DebugFileResponse.Open.HeadersEntry
-	6,  // 13: proto.Bor.PeersAdd:input_type -> proto.PeersAddRequest
-	8,  // 14: proto.Bor.PeersRemove:input_type -> proto.PeersRemoveRequest
-	10, // 15: proto.Bor.PeersList:input_type -> proto.PeersListRequest
-	12, // 16: proto.Bor.PeersStatus:input_type -> proto.PeersStatusRequest
-	15, // 17: proto.Bor.ChainSetHead:input_type -> proto.ChainSetHeadRequest
-	17, // 18: proto.Bor.Status:input_type -> proto.StatusRequest
-	3,  // 19: proto.Bor.ChainWatch:input_type -> proto.ChainWatchRequest
-	20, // 20: proto.Bor.DebugPprof:input_type -> proto.DebugPprofRequest
-	21, // 21: proto.Bor.DebugBlock:input_type -> proto.DebugBlockRequest
-	7,  // 22: proto.Bor.PeersAdd:output_type -> proto.PeersAddResponse
-	9,  // 23: proto.Bor.PeersRemove:output_type -> proto.PeersRemoveResponse
-	11, // 24: proto.Bor.PeersList:output_type -> proto.PeersListResponse
-	13, // 25: proto.Bor.PeersStatus:output_type -> proto.PeersStatusResponse
-	16, // 26: proto.Bor.ChainSetHead:output_type -> proto.ChainSetHeadResponse
-	18, // 27: proto.Bor.Status:output_type -> proto.StatusResponse
-	4,  // 28: proto.Bor.ChainWatch:output_type -> proto.ChainWatchResponse
-	22, // 29: proto.Bor.DebugPprof:output_type -> proto.DebugFileResponse
-	22, // 30: proto.Bor.DebugBlock:output_type -> proto.DebugFileResponse
-	22, // [22:31] is the sub-list for method output_type
-	13, // [13:22] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+	49, // 9: proto.DebugFileResponse.open:type_name -> proto.DebugFileResponse.Open
+	50, // 10: proto.DebugFileResponse.input:type_name -> proto.DebugFileResponse.Input
+	53, // 11: proto.DebugFileResponse.eof:type_name -> google.protobuf.Empty
+	32, // 12: proto.TxPoolInspectResponse.pending:type_name -> proto.PoolTransaction
+	32, // 13: proto.TxPoolInspectResponse.queued:type_name -> proto.PoolTransaction
+	52, // 14: proto.WhitelistStatusResponse.checkpoint:type_name -> proto.WhitelistStatusResponse.Entry
+	52, // 15: proto.WhitelistStatusResponse.milestone:type_name -> proto.WhitelistStatusResponse.Entry
+	51, // 16: proto.DebugFileResponse.Open.headers:type_name -> proto.DebugFileResponse.Open.HeadersEntry
+	6,  // 17: proto.Bor.PeersAdd:input_type -> proto.PeersAddRequest
+	8,  // 18: proto.Bor.PeersRemove:input_type -> proto.PeersRemoveRequest
+	10, // 19: proto.Bor.PeersList:input_type -> proto.PeersListRequest
+	12, // 20: proto.Bor.PeersStatus:input_type -> proto.PeersStatusRequest
+	15, // 21: proto.Bor.ChainSetHead:input_type -> proto.ChainSetHeadRequest
+	17, // 22: proto.Bor.Status:input_type -> proto.StatusRequest
+	3,  // 23: proto.Bor.ChainWatch:input_type -> proto.ChainWatchRequest
+	20, // 24: proto.Bor.DebugPprof:input_type -> proto.DebugPprofRequest
+	21, // 25: proto.Bor.DebugBlock:input_type -> proto.DebugBlockRequest
+	23, // 26: proto.Bor.DebugTraceTransaction:input_type -> proto.DebugTraceTransactionRequest
+	24, // 27: proto.Bor.DebugVerbosity:input_type -> proto.DebugVerbosityRequest
+	26, // 28: proto.Bor.DebugVmodule:input_type -> proto.DebugVmoduleRequest
+	28, // 29: proto.Bor.TxPoolStatus:input_type -> proto.TxPoolStatusRequest
+	30, // 30: proto.Bor.TxPoolInspect:input_type -> proto.TxPoolInspectRequest
+	33, // 31: proto.Bor.TxPoolDrop:input_type -> proto.TxPoolDropRequest
+	35, // 32: proto.Bor.MinerStart:input_type -> proto.MinerStartRequest
+	37, // 33: proto.Bor.MinerStop:input_type -> proto.MinerStopRequest
+	39, // 34: proto.Bor.MinerSetGasCeil:input_type -> proto.MinerSetGasCeilRequest
+	41, // 35: proto.Bor.WhitelistStatus:input_type -> proto.WhitelistStatusRequest
+	43, // 36: proto.Bor.WhitelistPurge:input_type -> proto.WhitelistPurgeRequest
+	45, // 37: proto.Bor.ConfigReload:input_type -> proto.ConfigReloadRequest
+	7,  // 38: proto.Bor.PeersAdd:output_type -> proto.PeersAddResponse
+	9,  // 39: proto.Bor.PeersRemove:output_type -> proto.PeersRemoveResponse
+	11, // 40: proto.Bor.PeersList:output_type -> proto.PeersListResponse
+	13, // 41: proto.Bor.PeersStatus:output_type -> proto.PeersStatusResponse
+	16, // 42: proto.Bor.ChainSetHead:output_type -> proto.ChainSetHeadResponse
+	18, // 43: proto.Bor.Status:output_type -> proto.StatusResponse
+	4,  // 44: proto.Bor.ChainWatch:output_type -> proto.ChainWatchResponse
+	22, // 45: proto.Bor.DebugPprof:output_type -> proto.DebugFileResponse
+	22, // 46: proto.Bor.DebugBlock:output_type -> proto.DebugFileResponse
+	22, // 47: proto.Bor.DebugTraceTransaction:output_type -> proto.DebugFileResponse
+	25, // 48: proto.Bor.DebugVerbosity:output_type -> proto.DebugVerbosityResponse
+	27, // 49: proto.Bor.DebugVmodule:output_type -> proto.DebugVmoduleResponse
+	29, // 50: proto.Bor.TxPoolStatus:output_type -> proto.TxPoolStatusResponse
+	31, // 51: proto.Bor.TxPoolInspect:output_type -> proto.TxPoolInspectResponse
+	34, // 52: proto.Bor.TxPoolDrop:output_type -> proto.TxPoolDropResponse
+	36, // 53: proto.Bor.MinerStart:output_type -> proto.MinerStartResponse
+	38, // 54: proto.Bor.MinerStop:output_type -> proto.MinerStopResponse
+	40, // 55: proto.Bor.MinerSetGasCeil:output_type -> proto.MinerSetGasCeilResponse
+	42, // 56: proto.Bor.WhitelistStatus:output_type -> proto.WhitelistStatusResponse
+	44, // 57: proto.Bor.WhitelistPurge:output_type -> proto.WhitelistPurgeResponse
+	46, // 58: proto.Bor.ConfigReload:output_type -> proto.ConfigReloadResponse
+	38, // [38:59] is the sub-list for method output_type
+	17, // [17:38] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_internal_cli_server_proto_server_proto_init() }
@@ -1886,7 +3178,6 @@ func file_internal_cli_server_proto_server_proto_init() {
 	if File_internal_cli_server_proto_server_proto != nil {
 		return
 	}
-
 	if !protoimpl.UnsafeEnabled {
 		file_internal_cli_server_proto_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TraceRequest); i {
@@ -1900,8 +3191,104 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TraceResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TraceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainWatchRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainWatchResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockStub); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersAddRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersAddResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersRemoveRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersRemoveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersListRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1912,8 +3299,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainWatchRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersListResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1924,8 +3311,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainWatchResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1936,8 +3323,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockStub); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeersStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1948,8 +3335,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersAddRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Peer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1960,8 +3347,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersAddResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainSetHeadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1972,8 +3359,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersRemoveRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChainSetHeadResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1984,8 +3371,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersRemoveResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1996,8 +3383,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersListRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2008,8 +3395,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersListResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Header); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2020,8 +3407,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersStatusRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugPprofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2032,8 +3419,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PeersStatusResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2044,8 +3431,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Peer); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2056,8 +3443,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainSetHeadRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugTraceTransactionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2068,8 +3455,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ChainSetHeadResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugVerbosityRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2080,8 +3467,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugVerbosityResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2092,8 +3479,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StatusResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugVmoduleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2104,8 +3491,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Header); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DebugVmoduleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2116,8 +3503,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugPprofRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2128,8 +3515,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugBlockRequest); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2140,8 +3527,8 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DebugFileResponse); i {
+		file_internal_cli_server_proto_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolInspectRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2152,7 +3539,199 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_cli_server_proto_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolInspectResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PoolTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolDropRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxPoolDropResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerStartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerStartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerStopRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerStopResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerSetGasCeilRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerSetGasCeilResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistPurgeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistPurgeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigReloadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigReloadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_internal_cli_server_proto_server_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusResponse_Fork); i {
 			case 0:
 				return &v.state
@@ -2164,7 +3743,7 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_cli_server_proto_server_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusResponse_Syncing); i {
 			case 0:
 				return &v.state
@@ -2176,7 +3755,7 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_cli_server_proto_server_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DebugFileResponse_Open); i {
 			case 0:
 				return &v.state
@@ -2188,7 +3767,7 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
-		file_internal_cli_server_proto_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_internal_cli_server_proto_server_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DebugFileResponse_Input); i {
 			case 0:
 				return &v.state
@@ -2200,22 +3779,31 @@ func file_internal_cli_server_proto_server_proto_init() {
 				return nil
 			}
 		}
+		file_internal_cli_server_proto_server_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistStatusResponse_Entry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-
 	file_internal_cli_server_proto_server_proto_msgTypes[21].OneofWrappers = []interface{}{
 		(*DebugFileResponse_Open_)(nil),
 		(*DebugFileResponse_Input_)(nil),
 		(*DebugFileResponse_Eof)(nil),
 	}
-
 	type x struct{}
-
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_internal_cli_server_proto_server_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   27,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,6 +31,18 @@ type BorClient interface {
 	ChainWatch(ctx context.Context, in *ChainWatchRequest, opts ...grpc.CallOption) (Bor_ChainWatchClient, error)
 	DebugPprof(ctx context.Context, in *DebugPprofRequest, opts ...grpc.CallOption) (Bor_DebugPprofClient, error)
 	DebugBlock(ctx context.Context, in *DebugBlockRequest, opts ...grpc.CallOption) (Bor_DebugBlockClient, error)
+	DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (Bor_DebugTraceTransactionClient, error)
+	DebugVerbosity(ctx context.Context, in *DebugVerbosityRequest, opts ...grpc.CallOption) (*DebugVerbosityResponse, error)
+	DebugVmodule(ctx context.Context, in *DebugVmoduleRequest, opts ...grpc.CallOption) (*DebugVmoduleResponse, error)
+	TxPoolStatus(ctx context.Context, in *TxPoolStatusRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error)
+	TxPoolInspect(ctx context.Context, in *TxPoolInspectRequest, opts ...grpc.CallOption) (*TxPoolInspectResponse, error)
+	TxPoolDrop(ctx context.Context, in *TxPoolDropRequest, opts ...grpc.CallOption) (*TxPoolDropResponse, error)
+	MinerStart(ctx context.Context, in *MinerStartRequest, opts ...grpc.CallOption) (*MinerStartResponse, error)
+	MinerStop(ctx context.Context, in *MinerStopRequest, opts ...grpc.CallOption) (*MinerStopResponse, error)
+	MinerSetGasCeil(ctx context.Context, in *MinerSetGasCeilRequest, opts ...grpc.CallOption) (*MinerSetGasCeilResponse, error)
+	WhitelistStatus(ctx context.Context, in *WhitelistStatusRequest, opts ...grpc.CallOption) (*WhitelistStatusResponse, error)
+	WhitelistPurge(ctx context.Context, in *WhitelistPurgeRequest, opts ...grpc.CallOption) (*WhitelistPurgeResponse, error)
+	ConfigReload(ctx context.Context, in *ConfigReloadRequest, opts ...grpc.CallOption) (*ConfigReloadResponse, error)
 }
 
 type borClient struct {
@@ -43,67 +55,55 @@ func NewBorClient(cc grpc.ClientConnInterface) BorClient {
 
 func (c *borClient) PeersAdd(ctx context.Context, in *PeersAddRequest, opts ...grpc.CallOption) (*PeersAddResponse, error) {
 	out := new(PeersAddResponse)
-
 	err := c.cc.Invoke(ctx, "/proto.Bor/PeersAdd", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
 func (c *borClient) PeersRemove(ctx context.Context, in *PeersRemoveRequest, opts ...grpc.CallOption) (*PeersRemoveResponse, error) {
 	out := new(PeersRemoveResponse)
-
 	err := c.cc.Invoke(ctx, "/proto.Bor/PeersRemove", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
 func (c *borClient) PeersList(ctx context.Context, in *PeersListRequest, opts ...grpc.CallOption) (*PeersListResponse, error) {
 	out := new(PeersListResponse)
-
 	err := c.cc.Invoke(ctx, "/proto.Bor/PeersList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
 func (c *borClient) PeersStatus(ctx context.Context, in *PeersStatusRequest, opts ...grpc.CallOption) (*PeersStatusResponse, error) {
 	out := new(PeersStatusResponse)
-
 	err := c.cc.Invoke(ctx, "/proto.Bor/PeersStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
 func (c *borClient) ChainSetHead(ctx context.Context, in *ChainSetHeadRequest, opts ...grpc.CallOption) (*ChainSetHeadResponse, error) {
 	out := new(ChainSetHeadResponse)
-
 	err := c.cc.Invoke(ctx, "/proto.Bor/ChainSetHead", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
 func (c *borClient) Status(ctx context.Context, in *StatusRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
 	out := new(StatusResponse)
-
 	err := c.cc.Invoke(ctx, "/proto.Bor/Status", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-
 	return out, nil
 }
 
@@ -112,16 +112,13 @@ func (c *borClient) ChainWatch(ctx context.Context, in *ChainWatchRequest, opts
 	if err != nil {
 		return nil, err
 	}
-
 	x := &borChainWatchClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-
 	return x, nil
 }
 
@@ -139,7 +136,6 @@ func (x *borChainWatchClient) Recv() (*ChainWatchResponse, error) {
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-
 	return m, nil
 }
 
@@ -148,16 +144,13 @@ func (c *borClient) DebugPprof(ctx context.Context, in *DebugPprofRequest, opts
 	if err != nil {
 		return nil, err
 	}
-
 	x := &borDebugPprofClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-
 	return x, nil
 }
 
@@ -175,7 +168,6 @@ func (x *borDebugPprofClient) Recv() (*DebugFileResponse, error) {
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
-
 	return m, nil
 }
 
@@ -184,16 +176,13 @@ func (c *borClient) DebugBlock(ctx context.Context, in *DebugBlockRequest, opts
 	if err != nil {
 		return nil, err
 	}
-
 	x := &borDebugBlockClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-
 	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-
 	return x, nil
 }
 
@@ -211,10 +200,140 @@ func (x *borDebugBlockClient) Recv() (*DebugFileResponse, error) {
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
+	return m, nil
+}
 
+func (c *borClient) DebugTraceTransaction(ctx context.Context, in *DebugTraceTransactionRequest, opts ...grpc.CallOption) (Bor_DebugTraceTransactionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Bor_ServiceDesc.Streams[3], "/proto.Bor/DebugTraceTransaction", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &borDebugTraceTransactionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bor_DebugTraceTransactionClient interface {
+	Recv() (*DebugFileResponse, error)
+	grpc.ClientStream
+}
+
+type borDebugTraceTransactionClient struct {
+	grpc.ClientStream
+}
+
+func (x *borDebugTraceTransactionClient) Recv() (*DebugFileResponse, error) {
+	m := new(DebugFileResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
 	return m, nil
 }
 
+func (c *borClient) DebugVerbosity(ctx context.Context, in *DebugVerbosityRequest, opts ...grpc.CallOption) (*DebugVerbosityResponse, error) {
+	out := new(DebugVerbosityResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/DebugVerbosity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) DebugVmodule(ctx context.Context, in *DebugVmoduleRequest, opts ...grpc.CallOption) (*DebugVmoduleResponse, error) {
+	out := new(DebugVmoduleResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/DebugVmodule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) TxPoolStatus(ctx context.Context, in *TxPoolStatusRequest, opts ...grpc.CallOption) (*TxPoolStatusResponse, error) {
+	out := new(TxPoolStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/TxPoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) TxPoolInspect(ctx context.Context, in *TxPoolInspectRequest, opts ...grpc.CallOption) (*TxPoolInspectResponse, error) {
+	out := new(TxPoolInspectResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/TxPoolInspect", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) TxPoolDrop(ctx context.Context, in *TxPoolDropRequest, opts ...grpc.CallOption) (*TxPoolDropResponse, error) {
+	out := new(TxPoolDropResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/TxPoolDrop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) MinerStart(ctx context.Context, in *MinerStartRequest, opts ...grpc.CallOption) (*MinerStartResponse, error) {
+	out := new(MinerStartResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/MinerStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) MinerStop(ctx context.Context, in *MinerStopRequest, opts ...grpc.CallOption) (*MinerStopResponse, error) {
+	out := new(MinerStopResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/MinerStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) MinerSetGasCeil(ctx context.Context, in *MinerSetGasCeilRequest, opts ...grpc.CallOption) (*MinerSetGasCeilResponse, error) {
+	out := new(MinerSetGasCeilResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/MinerSetGasCeil", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) WhitelistStatus(ctx context.Context, in *WhitelistStatusRequest, opts ...grpc.CallOption) (*WhitelistStatusResponse, error) {
+	out := new(WhitelistStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/WhitelistStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) WhitelistPurge(ctx context.Context, in *WhitelistPurgeRequest, opts ...grpc.CallOption) (*WhitelistPurgeResponse, error) {
+	out := new(WhitelistPurgeResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/WhitelistPurge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *borClient) ConfigReload(ctx context.Context, in *ConfigReloadRequest, opts ...grpc.CallOption) (*ConfigReloadResponse, error) {
+	out := new(ConfigReloadResponse)
+	err := c.cc.Invoke(ctx, "/proto.Bor/ConfigReload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BorServer is the server API for Bor service.
 // All implementations must embed UnimplementedBorServer
 // for forward compatibility
@@ -228,6 +347,18 @@ type BorServer interface {
 	ChainWatch(*ChainWatchRequest, Bor_ChainWatchServer) error
 	DebugPprof(*DebugPprofRequest, Bor_DebugPprofServer) error
 	DebugBlock(*DebugBlockRequest, Bor_DebugBlockServer) error
+	DebugTraceTransaction(*DebugTraceTransactionRequest, Bor_DebugTraceTransactionServer) error
+	DebugVerbosity(context.Context, *DebugVerbosityRequest) (*DebugVerbosityResponse, error)
+	DebugVmodule(context.Context, *DebugVmoduleRequest) (*DebugVmoduleResponse, error)
+	TxPoolStatus(context.Context, *TxPoolStatusRequest) (*TxPoolStatusResponse, error)
+	TxPoolInspect(context.Context, *TxPoolInspectRequest) (*TxPoolInspectResponse, error)
+	TxPoolDrop(context.Context, *TxPoolDropRequest) (*TxPoolDropResponse, error)
+	MinerStart(context.Context, *MinerStartRequest) (*MinerStartResponse, error)
+	MinerStop(context.Context, *MinerStopRequest) (*MinerStopResponse, error)
+	MinerSetGasCeil(context.Context, *MinerSetGasCeilRequest) (*MinerSetGasCeilResponse, error)
+	WhitelistStatus(context.Context, *WhitelistStatusRequest) (*WhitelistStatusResponse, error)
+	WhitelistPurge(context.Context, *WhitelistPurgeRequest) (*WhitelistPurgeResponse, error)
+	ConfigReload(context.Context, *ConfigReloadRequest) (*ConfigReloadResponse, error)
 	mustEmbedUnimplementedBorServer()
 }
 
@@ -262,6 +393,42 @@ func (UnimplementedBorServer) DebugPprof(*DebugPprofRequest, Bor_DebugPprofServe
 func (UnimplementedBorServer) DebugBlock(*DebugBlockRequest, Bor_DebugBlockServer) error {
 	return status.Errorf(codes.Unimplemented, "method DebugBlock not implemented")
 }
+func (UnimplementedBorServer) DebugTraceTransaction(*DebugTraceTransactionRequest, Bor_DebugTraceTransactionServer) error {
+	return status.Errorf(codes.Unimplemented, "method DebugTraceTransaction not implemented")
+}
+func (UnimplementedBorServer) DebugVerbosity(context.Context, *DebugVerbosityRequest) (*DebugVerbosityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugVerbosity not implemented")
+}
+func (UnimplementedBorServer) DebugVmodule(context.Context, *DebugVmoduleRequest) (*DebugVmoduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugVmodule not implemented")
+}
+func (UnimplementedBorServer) TxPoolStatus(context.Context, *TxPoolStatusRequest) (*TxPoolStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxPoolStatus not implemented")
+}
+func (UnimplementedBorServer) TxPoolInspect(context.Context, *TxPoolInspectRequest) (*TxPoolInspectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxPoolInspect not implemented")
+}
+func (UnimplementedBorServer) TxPoolDrop(context.Context, *TxPoolDropRequest) (*TxPoolDropResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxPoolDrop not implemented")
+}
+func (UnimplementedBorServer) MinerStart(context.Context, *MinerStartRequest) (*MinerStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinerStart not implemented")
+}
+func (UnimplementedBorServer) MinerStop(context.Context, *MinerStopRequest) (*MinerStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinerStop not implemented")
+}
+func (UnimplementedBorServer) MinerSetGasCeil(context.Context, *MinerSetGasCeilRequest) (*MinerSetGasCeilResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinerSetGasCeil not implemented")
+}
+func (UnimplementedBorServer) WhitelistStatus(context.Context, *WhitelistStatusRequest) (*WhitelistStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistStatus not implemented")
+}
+func (UnimplementedBorServer) WhitelistPurge(context.Context, *WhitelistPurgeRequest) (*WhitelistPurgeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistPurge not implemented")
+}
+func (UnimplementedBorServer) ConfigReload(context.Context, *ConfigReloadRequest) (*ConfigReloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfigReload not implemented")
+}
 func (UnimplementedBorServer) mustEmbedUnimplementedBorServer() {}
 
 // UnsafeBorServer may be embedded to opt out of forward compatibility for this service.
@@ -280,11 +447,9 @@ func _Bor_PeersAdd_Handler(srv interface{}, ctx context.Context, dec func(interf
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-
 	if interceptor == nil {
 		return srv.(BorServer).PeersAdd(ctx, in)
 	}
-
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/proto.Bor/PeersAdd",
@@ -292,7 +457,6 @@ func _Bor_PeersAdd_Handler(srv interface{}, ctx context.Context, dec func(interf
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BorServer).PeersAdd(ctx, req.(*PeersAddRequest))
 	}
-
 	return interceptor(ctx, in, info, handler)
 }
 
@@ -301,11 +465,9 @@ func _Bor_PeersRemove_Handler(srv interface{}, ctx context.Context, dec func(int
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-
 	if interceptor == nil {
 		return srv.(BorServer).PeersRemove(ctx, in)
 	}
-
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/proto.Bor/PeersRemove",
@@ -313,7 +475,6 @@ func _Bor_PeersRemove_Handler(srv interface{}, ctx context.Context, dec func(int
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BorServer).PeersRemove(ctx, req.(*PeersRemoveRequest))
 	}
-
 	return interceptor(ctx, in, info, handler)
 }
 
@@ -322,11 +483,9 @@ func _Bor_PeersList_Handler(srv interface{}, ctx context.Context, dec func(inter
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-
 	if interceptor == nil {
 		return srv.(BorServer).PeersList(ctx, in)
 	}
-
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/proto.Bor/PeersList",
@@ -334,7 +493,6 @@ func _Bor_PeersList_Handler(srv interface{}, ctx context.Context, dec func(inter
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BorServer).PeersList(ctx, req.(*PeersListRequest))
 	}
-
 	return interceptor(ctx, in, info, handler)
 }
 
@@ -343,11 +501,9 @@ func _Bor_PeersStatus_Handler(srv interface{}, ctx context.Context, dec func(int
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-
 	if interceptor == nil {
 		return srv.(BorServer).PeersStatus(ctx, in)
 	}
-
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/proto.Bor/PeersStatus",
@@ -355,7 +511,6 @@ func _Bor_PeersStatus_Handler(srv interface{}, ctx context.Context, dec func(int
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BorServer).PeersStatus(ctx, req.(*PeersStatusRequest))
 	}
-
 	return interceptor(ctx, in, info, handler)
 }
 
@@ -364,11 +519,9 @@ func _Bor_ChainSetHead_Handler(srv interface{}, ctx context.Context, dec func(in
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-
 	if interceptor == nil {
 		return srv.(BorServer).ChainSetHead(ctx, in)
 	}
-
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/proto.Bor/ChainSetHead",
@@ -376,7 +529,6 @@ func _Bor_ChainSetHead_Handler(srv interface{}, ctx context.Context, dec func(in
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BorServer).ChainSetHead(ctx, req.(*ChainSetHeadRequest))
 	}
-
 	return interceptor(ctx, in, info, handler)
 }
 
@@ -385,11 +537,9 @@ func _Bor_Status_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	if err := dec(in); err != nil {
 		return nil, err
 	}
-
 	if interceptor == nil {
 		return srv.(BorServer).Status(ctx, in)
 	}
-
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
 		FullMethod: "/proto.Bor/Status",
@@ -397,7 +547,6 @@ func _Bor_Status_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(BorServer).Status(ctx, req.(*StatusRequest))
 	}
-
 	return interceptor(ctx, in, info, handler)
 }
 
@@ -406,7 +555,6 @@ func _Bor_ChainWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-
 	return srv.(BorServer).ChainWatch(m, &borChainWatchServer{stream})
 }
 
@@ -428,7 +576,6 @@ func _Bor_DebugPprof_Handler(srv interface{}, stream grpc.ServerStream) error {
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-
 	return srv.(BorServer).DebugPprof(m, &borDebugPprofServer{stream})
 }
 
@@ -450,7 +597,6 @@ func _Bor_DebugBlock_Handler(srv interface{}, stream grpc.ServerStream) error {
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-
 	return srv.(BorServer).DebugBlock(m, &borDebugBlockServer{stream})
 }
 
@@ -467,6 +613,225 @@ func (x *borDebugBlockServer) Send(m *DebugFileResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Bor_DebugTraceTransaction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DebugTraceTransactionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BorServer).DebugTraceTransaction(m, &borDebugTraceTransactionServer{stream})
+}
+
+type Bor_DebugTraceTransactionServer interface {
+	Send(*DebugFileResponse) error
+	grpc.ServerStream
+}
+
+type borDebugTraceTransactionServer struct {
+	grpc.ServerStream
+}
+
+func (x *borDebugTraceTransactionServer) Send(m *DebugFileResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Bor_DebugVerbosity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugVerbosityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).DebugVerbosity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/DebugVerbosity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).DebugVerbosity(ctx, req.(*DebugVerbosityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_DebugVmodule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugVmoduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).DebugVmodule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/DebugVmodule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).DebugVmodule(ctx, req.(*DebugVmoduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_TxPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxPoolStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).TxPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/TxPoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).TxPoolStatus(ctx, req.(*TxPoolStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_TxPoolInspect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxPoolInspectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).TxPoolInspect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/TxPoolInspect",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).TxPoolInspect(ctx, req.(*TxPoolInspectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_TxPoolDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxPoolDropRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).TxPoolDrop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/TxPoolDrop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).TxPoolDrop(ctx, req.(*TxPoolDropRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_MinerStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerStartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).MinerStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/MinerStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).MinerStart(ctx, req.(*MinerStartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_MinerStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).MinerStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/MinerStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).MinerStop(ctx, req.(*MinerStopRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_MinerSetGasCeil_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinerSetGasCeilRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).MinerSetGasCeil(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/MinerSetGasCeil",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).MinerSetGasCeil(ctx, req.(*MinerSetGasCeilRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_WhitelistStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhitelistStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).WhitelistStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/WhitelistStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).WhitelistStatus(ctx, req.(*WhitelistStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_WhitelistPurge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhitelistPurgeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).WhitelistPurge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/WhitelistPurge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).WhitelistPurge(ctx, req.(*WhitelistPurgeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bor_ConfigReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigReloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BorServer).ConfigReload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Bor/ConfigReload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BorServer).ConfigReload(ctx, req.(*ConfigReloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Bor_ServiceDesc is the grpc.ServiceDesc for Bor service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -498,6 +863,50 @@ var Bor_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Status",
 			Handler:    _Bor_Status_Handler,
 		},
+		{
+			MethodName: "DebugVerbosity",
+			Handler:    _Bor_DebugVerbosity_Handler,
+		},
+		{
+			MethodName: "DebugVmodule",
+			Handler:    _Bor_DebugVmodule_Handler,
+		},
+		{
+			MethodName: "TxPoolStatus",
+			Handler:    _Bor_TxPoolStatus_Handler,
+		},
+		{
+			MethodName: "TxPoolInspect",
+			Handler:    _Bor_TxPoolInspect_Handler,
+		},
+		{
+			MethodName: "TxPoolDrop",
+			Handler:    _Bor_TxPoolDrop_Handler,
+		},
+		{
+			MethodName: "MinerStart",
+			Handler:    _Bor_MinerStart_Handler,
+		},
+		{
+			MethodName: "MinerStop",
+			Handler:    _Bor_MinerStop_Handler,
+		},
+		{
+			MethodName: "MinerSetGasCeil",
+			Handler:    _Bor_MinerSetGasCeil_Handler,
+		},
+		{
+			MethodName: "WhitelistStatus",
+			Handler:    _Bor_WhitelistStatus_Handler,
+		},
+		{
+			MethodName: "WhitelistPurge",
+			Handler:    _Bor_WhitelistPurge_Handler,
+		},
+		{
+			MethodName: "ConfigReload",
+			Handler:    _Bor_ConfigReload_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -515,6 +924,11 @@ var Bor_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Bor_DebugBlock_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "DebugTraceTransaction",
+			Handler:       _Bor_DebugTraceTransaction_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "internal/cli/server/proto/server.proto",
 }
@@ -0,0 +1,553 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/eth/gasprice"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var errReloadUnsupported = errors.New("config reload is not available, the server was not started from the command line")
+
+// configReloader applies a group of config fields to the running node.
+type configReloader struct {
+	// keys are the config paths (as used in the hcl/toml files) handled by apply
+	keys []string
+
+	// validate checks the values of cfg before any of the changes is applied,
+	// nil if they can't be rejected
+	validate func(s *Server, cfg *Config) error
+
+	// apply pushes the values of cfg to the running node and records them in
+	// the active config of the server
+	apply func(s *Server, cfg *Config) error
+}
+
+// configReloaders lists the subsets of the config which can be changed without
+// restarting the node. Any other change is reported as requiring a restart.
+var configReloaders = []configReloader{
+	{
+		keys:     []string{"verbosity", "log-level", "log.vmodule"},
+		validate: (*Server).validateLogging,
+		apply:    (*Server).reloadLogging,
+	},
+	{
+		keys: []string{
+			"txpool.pricebump", "txpool.accountslots", "txpool.globalslots",
+			"txpool.accountqueue", "txpool.globalqueue", "txpool.lifetime",
+		},
+		apply: (*Server).reloadTxPool,
+	},
+	{
+		keys: []string{
+			"gpo.blocks", "gpo.percentile", "gpo.maxheaderhistory", "gpo.maxblockhistory",
+			"gpo.maxprice",
+		},
+		apply: (*Server).reloadGpo,
+	},
+	{
+		keys:  []string{"rpc.batchlimit", "rpc.returndatalimit"},
+		apply: (*Server).reloadRPCLimits,
+	},
+	{
+		keys:     []string{"miner.gaslimit", "miner.extradata", "miner.recommit"},
+		validate: (*Server).validateMiner,
+		apply:    (*Server).reloadMiner,
+	},
+	{
+		keys:     []string{"p2p.discovery.static-nodes", "p2p.discovery.trusted-nodes"},
+		validate: (*Server).validatePeers,
+		apply:    (*Server).reloadPeers,
+	},
+}
+
+// configIgnored lists the config fields which are pinned when the node starts,
+// so that changing them has no effect even after a restart, with the reason.
+var configIgnored = map[string]string{
+	"txpool.pricelimit": "the pools enforce the PIP-35 minimum gas tip",
+	"gpo.ignoreprice":   "the gas price oracle enforces the PIP-35 minimum gas tip",
+}
+
+// WithConfigLoader sets the function used to re-read the configuration of the
+// server when a reload is requested.
+func WithConfigLoader(loader func() (*Config, error)) serverOption {
+	return func(srv *Server, _ *Config) error {
+		srv.configLoader = loader
+		return nil
+	}
+}
+
+// ConfigReload re-reads the configuration and applies the live mutable fields.
+func (s *Server) ConfigReload(ctx context.Context, req *proto.ConfigReloadRequest) (*proto.ConfigReloadResponse, error) {
+	applied, restart, ignored, err := s.Reload()
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ConfigReloadResponse{
+		Applied:         applied,
+		RestartRequired: restart,
+		Ignored:         ignored,
+	}, nil
+}
+
+// Reload re-reads the configuration with the loader of the server and applies
+// it. It returns the changed fields which were applied, the changed fields which
+// only take effect after a restart and the changed fields which are ignored.
+func (s *Server) Reload() ([]string, []string, []string, error) {
+	if s.configLoader == nil {
+		return nil, nil, nil, errReloadUnsupported
+	}
+
+	cfg, err := s.configLoader()
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to load config: %v", err)
+	}
+
+	return s.applyConfig(cfg)
+}
+
+// applyConfig applies the live mutable fields of cfg which differ from the
+// config the server was started with, or last reloaded. The config of the
+// server is adjusted as the node is set up (e.g. in developer mode), so it's
+// not the one compared with.
+func (s *Server) applyConfig(cfg *Config) ([]string, []string, []string, error) {
+	s.reloadLock.Lock()
+	defer s.reloadLock.Unlock()
+
+	next := flattenConfig(cfg)
+
+	changed := diffFlatConfig(s.loadedConfig, next)
+	if len(changed) == 0 {
+		return nil, nil, nil, nil
+	}
+
+	// pending are the reloaders of the changes, along with the changed keys
+	type pending struct {
+		reloader configReloader
+		keys     []string
+	}
+
+	var (
+		applied, ignored []string
+		reloads          []pending
+	)
+
+	for key, reason := range configIgnored {
+		if _, ok := changed[key]; ok {
+			log.Warn("Ignoring configuration change", "field", key, "reason", reason)
+
+			ignored = append(ignored, key)
+			delete(changed, key)
+		}
+	}
+
+	for _, reloader := range configReloaders {
+		var keys []string
+
+		for _, key := range reloader.keys {
+			if _, ok := changed[key]; ok {
+				keys = append(keys, key)
+				delete(changed, key)
+			}
+		}
+
+		if len(keys) != 0 {
+			reloads = append(reloads, pending{reloader: reloader, keys: keys})
+		}
+	}
+
+	// Nothing is applied unless all the changes are valid
+	for _, reload := range reloads {
+		if reload.reloader.validate == nil {
+			continue
+		}
+
+		if err := reload.reloader.validate(s, cfg); err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	for _, reload := range reloads {
+		if err := reload.reloader.apply(s, cfg); err != nil {
+			sort.Strings(applied)
+
+			return nil, nil, nil, fmt.Errorf("failed to apply %v, only %v were applied: %v", reload.keys, applied, err)
+		}
+
+		// The applied fields are no longer changes
+		for _, key := range reload.keys {
+			s.loadedConfig[key] = copyConfigValue(next[key])
+		}
+
+		applied = append(applied, reload.keys...)
+	}
+
+	restart := make([]string, 0, len(changed))
+	for key := range changed {
+		restart = append(restart, key)
+	}
+
+	sort.Strings(applied)
+	sort.Strings(restart)
+	sort.Strings(ignored)
+
+	log.Info("Configuration reloaded", "applied", applied, "restart", restart, "ignored", ignored)
+
+	return applied, restart, ignored, nil
+}
+
+func (s *Server) validateLogging(cfg *Config) error {
+	if glogger == nil {
+		return fmt.Errorf("logger is not initialised")
+	}
+
+	if err := log.NewGlogHandler(log.DiscardHandler()).Vmodule(cfg.Logging.Vmodule); err != nil {
+		return fmt.Errorf("invalid vmodule: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Server) reloadLogging(cfg *Config) error {
+	if err := glogger.Vmodule(cfg.Logging.Vmodule); err != nil {
+		return fmt.Errorf("invalid vmodule: %v", err)
+	}
+
+	glogger.Verbosity(log.FromLegacyLevel(cfg.Verbosity))
+
+	s.config.Verbosity = cfg.Verbosity
+	s.config.LogLevel = cfg.LogLevel
+	s.config.Logging.Vmodule = cfg.Logging.Vmodule
+
+	return nil
+}
+
+func (s *Server) reloadTxPool(cfg *Config) error {
+	s.backend.LegacyPool().SetLimits(legacypool.Config{
+		PriceLimit:   s.config.TxPool.PriceLimit,
+		PriceBump:    cfg.TxPool.PriceBump,
+		AccountSlots: cfg.TxPool.AccountSlots,
+		GlobalSlots:  cfg.TxPool.GlobalSlots,
+		AccountQueue: cfg.TxPool.AccountQueue,
+		GlobalQueue:  cfg.TxPool.GlobalQueue,
+		Lifetime:     cfg.TxPool.LifeTime,
+		Rejournal:    s.config.TxPool.Rejournal,
+	})
+
+	s.config.TxPool.PriceBump = cfg.TxPool.PriceBump
+	s.config.TxPool.AccountSlots = cfg.TxPool.AccountSlots
+	s.config.TxPool.GlobalSlots = cfg.TxPool.GlobalSlots
+	s.config.TxPool.AccountQueue = cfg.TxPool.AccountQueue
+	s.config.TxPool.GlobalQueue = cfg.TxPool.GlobalQueue
+	s.config.TxPool.LifeTime = cfg.TxPool.LifeTime
+
+	return nil
+}
+
+func (s *Server) reloadGpo(cfg *Config) error {
+	s.backend.APIBackend.SetGasPriceOracleConfig(gasprice.Config{
+		Blocks:           int(cfg.Gpo.Blocks),
+		Percentile:       int(cfg.Gpo.Percentile),
+		MaxHeaderHistory: uint64(cfg.Gpo.MaxHeaderHistory),
+		MaxBlockHistory:  uint64(cfg.Gpo.MaxBlockHistory),
+		MaxPrice:         cfg.Gpo.MaxPrice,
+		IgnorePrice:      s.config.Gpo.IgnorePrice,
+	})
+
+	s.config.Gpo.Blocks = cfg.Gpo.Blocks
+	s.config.Gpo.Percentile = cfg.Gpo.Percentile
+	s.config.Gpo.MaxHeaderHistory = cfg.Gpo.MaxHeaderHistory
+	s.config.Gpo.MaxBlockHistory = cfg.Gpo.MaxBlockHistory
+	s.config.Gpo.MaxPrice = cfg.Gpo.MaxPrice
+
+	return nil
+}
+
+func (s *Server) reloadRPCLimits(cfg *Config) error {
+	s.node.SetRPCBatchLimit(cfg.RPCBatchLimit)
+	s.backend.APIBackend.SetRPCRpcReturnDataLimit(cfg.RPCReturnDataLimit)
+
+	s.config.RPCBatchLimit = cfg.RPCBatchLimit
+	s.config.RPCReturnDataLimit = cfg.RPCReturnDataLimit
+
+	return nil
+}
+
+func (s *Server) validateMiner(cfg *Config) error {
+	if uint64(len(cfg.Sealer.ExtraData)) > params.MaximumExtraDataSize {
+		return fmt.Errorf("extra exceeds max length. %d > %v", len(cfg.Sealer.ExtraData), params.MaximumExtraDataSize)
+	}
+
+	return nil
+}
+
+func (s *Server) reloadMiner(cfg *Config) error {
+	miner := s.backend.Miner()
+
+	if err := miner.SetExtra([]byte(cfg.Sealer.ExtraData)); err != nil {
+		return err
+	}
+
+	miner.SetGasCeil(cfg.Sealer.GasCeil)
+	miner.SetRecommitInterval(cfg.Sealer.Recommit)
+
+	s.config.Sealer.ExtraData = cfg.Sealer.ExtraData
+	s.config.Sealer.GasCeil = cfg.Sealer.GasCeil
+	s.config.Sealer.Recommit = cfg.Sealer.Recommit
+
+	return nil
+}
+
+func (s *Server) validatePeers(cfg *Config) error {
+	if _, err := parseBootnodes(cfg.P2P.Discovery.StaticNodes); err != nil {
+		return fmt.Errorf("invalid static node: %v", err)
+	}
+
+	if _, err := parseBootnodes(cfg.P2P.Discovery.TrustedNodes); err != nil {
+		return fmt.Errorf("invalid trusted node: %v", err)
+	}
+
+	return nil
+}
+
+func (s *Server) reloadPeers(cfg *Config) error {
+	oldStatic, err := parseBootnodes(s.config.P2P.Discovery.StaticNodes)
+	if err != nil {
+		return err
+	}
+
+	newStatic, err := parseBootnodes(cfg.P2P.Discovery.StaticNodes)
+	if err != nil {
+		return fmt.Errorf("invalid static node: %v", err)
+	}
+
+	oldTrusted, err := parseBootnodes(s.config.P2P.Discovery.TrustedNodes)
+	if err != nil {
+		return err
+	}
+
+	newTrusted, err := parseBootnodes(cfg.P2P.Discovery.TrustedNodes)
+	if err != nil {
+		return fmt.Errorf("invalid trusted node: %v", err)
+	}
+
+	srv := s.node.Server()
+
+	added, removed := diffNodes(oldStatic, newStatic)
+	for _, node := range removed {
+		srv.RemovePeer(node)
+	}
+
+	for _, node := range added {
+		srv.AddPeer(node)
+	}
+
+	added, removed = diffNodes(oldTrusted, newTrusted)
+	for _, node := range removed {
+		srv.RemoveTrustedPeer(node)
+	}
+
+	for _, node := range added {
+		srv.AddTrustedPeer(node)
+	}
+
+	s.config.P2P.Discovery.StaticNodes = cfg.P2P.Discovery.StaticNodes
+	s.config.P2P.Discovery.TrustedNodes = cfg.P2P.Discovery.TrustedNodes
+
+	return nil
+}
+
+// diffNodes returns the nodes which are only in next (added) and only in prev (removed).
+func diffNodes(prev, next []*enode.Node) ([]*enode.Node, []*enode.Node) {
+	prevSet := make(map[enode.ID]struct{}, len(prev))
+	for _, node := range prev {
+		prevSet[node.ID()] = struct{}{}
+	}
+
+	nextSet := make(map[enode.ID]struct{}, len(next))
+	for _, node := range next {
+		nextSet[node.ID()] = struct{}{}
+	}
+
+	var added, removed []*enode.Node
+
+	for _, node := range next {
+		if _, ok := prevSet[node.ID()]; !ok {
+			added = append(added, node)
+		}
+	}
+
+	for _, node := range prev {
+		if _, ok := nextSet[node.ID()]; !ok {
+			removed = append(removed, node)
+		}
+	}
+
+	return added, removed
+}
+
+// diffConfig returns the set of config paths whose values differ between a and b.
+func diffConfig(a, b *Config) map[string]struct{} {
+	return diffFlatConfig(flattenConfig(a), flattenConfig(b))
+}
+
+// diffFlatConfig returns the set of config paths whose values differ between
+// the flattened configs prev and next.
+func diffFlatConfig(prev, next map[string]interface{}) map[string]struct{} {
+	changed := make(map[string]struct{})
+
+	for key, val := range prev {
+		if !reflect.DeepEqual(val, next[key]) {
+			changed[key] = struct{}{}
+		}
+	}
+
+	for key := range next {
+		if _, ok := prev[key]; !ok {
+			changed[key] = struct{}{}
+		}
+	}
+
+	return changed
+}
+
+// flattenConfig walks the config and returns its values keyed by their path in
+// the hcl file (e.g. "txpool.accountslots").
+func flattenConfig(c *Config) map[string]interface{} {
+	res := make(map[string]interface{})
+
+	walkConfig(reflect.ValueOf(c).Elem(), nil, func(path []string, field reflect.Value) {
+		res[strings.Join(path, ".")] = field.Interface()
+	})
+
+	return res
+}
+
+// snapshotConfig returns the flattened config c, with copies of its values so
+// that it's not affected by later changes to c.
+func snapshotConfig(c *Config) map[string]interface{} {
+	res := flattenConfig(c)
+	for key, val := range res {
+		res[key] = copyConfigValue(val)
+	}
+
+	return res
+}
+
+// copyConfigValue returns a copy of the config value v, not sharing the big
+// integers, slices and maps it refers to.
+func copyConfigValue(v interface{}) interface{} {
+	switch val := v.(type) {
+	case *big.Int:
+		if val == nil {
+			return val
+		}
+
+		return new(big.Int).Set(val)
+	case nil:
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+
+	switch rv.Kind() {
+	case reflect.Slice:
+		if rv.IsNil() {
+			return v
+		}
+
+		cpy := reflect.MakeSlice(rv.Type(), rv.Len(), rv.Len())
+		reflect.Copy(cpy, rv)
+
+		return cpy.Interface()
+	case reflect.Map:
+		if rv.IsNil() {
+			return v
+		}
+
+		cpy := reflect.MakeMapWithSize(rv.Type(), rv.Len())
+
+		iter := rv.MapRange()
+		for iter.Next() {
+			cpy.SetMapIndex(iter.Key(), iter.Value())
+		}
+
+		return cpy.Interface()
+	}
+
+	return v
+}
+
+// walkConfig calls fn for every leaf field of the config block v with its path
+// in the hcl/toml file.
+func walkConfig(v reflect.Value, path []string, fn func(path []string, field reflect.Value)) {
+	for _, field := range configFields(v.Type()) {
+		fieldPath := append(append([]string{}, path...), field.name)
+		val := v.Field(field.index)
+
+		if isConfigBlock(val.Type()) {
+			if !val.IsNil() {
+				walkConfig(val.Elem(), fieldPath, fn)
+			}
+
+			continue
+		}
+
+		fn(fieldPath, val)
+	}
+}
+
+// configField is a field of a config block along with its name in the hcl/toml file
+type configField struct {
+	name  string
+	index int
+}
+
+// configFields returns the fields of the config block type t. Fields which are
+// parsed from a raw string sibling (e.g. durations and big integers) are
+// returned with the name of the raw field.
+func configFields(t reflect.Type) []configField {
+	var fields []configField
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || strings.HasSuffix(field.Name, "Raw") {
+			continue
+		}
+
+		name := hclName(field)
+		if name == "-" {
+			// the value is parsed from its raw sibling, use its name instead
+			raw, ok := t.FieldByName(field.Name + "Raw")
+			if !ok {
+				continue
+			}
+
+			name = hclName(raw)
+		}
+
+		fields = append(fields, configField{name: name, index: i})
+	}
+
+	return fields
+}
+
+// isConfigBlock reports whether a config field of type t is a nested block
+func isConfigBlock(t reflect.Type) bool {
+	return t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && t.Elem() != reflect.TypeOf(big.Int{})
+}
+
+func hclName(field reflect.StructField) string {
+	return strings.Split(field.Tag.Get("hcl"), ",")[0]
+}
@@ -0,0 +1,134 @@
+package server
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func TestReloadKeysExist(t *testing.T) {
+	t.Parallel()
+
+	// every key handled by a reloader must map to a field of the config
+	keys := flattenConfig(DefaultConfig())
+
+	for _, reloader := range configReloaders {
+		for _, key := range reloader.keys {
+			_, ok := keys[key]
+			require.True(t, ok, key)
+		}
+	}
+
+	for key := range configIgnored {
+		_, ok := keys[key]
+		require.True(t, ok, key)
+	}
+}
+
+func TestDiffConfig(t *testing.T) {
+	t.Parallel()
+
+	a, b := DefaultConfig(), DefaultConfig()
+	require.Empty(t, diffConfig(a, b))
+
+	b.TxPool.AccountSlots++
+	b.TxPool.LifeTime = time.Minute
+	b.Gpo.MaxPrice = big.NewInt(1)
+	b.P2P.Discovery.StaticNodes = []string{"enode://a@127.0.0.1:30303"}
+	b.DataDir = "/tmp/other"
+
+	require.Equal(t, map[string]struct{}{
+		"txpool.accountslots":        {},
+		"txpool.lifetime":            {},
+		"gpo.maxprice":               {},
+		"p2p.discovery.static-nodes": {},
+		"datadir":                    {},
+	}, diffConfig(a, b))
+}
+
+func TestServerReload(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func() *Config {
+		config := DefaultConfig()
+		config.Developer.Enabled = true
+		config.Developer.Period = 2
+
+		return config
+	}
+
+	srv, err := CreateMockServer(newConfig())
+	require.NoError(t, err)
+
+	defer CloseMockServer(srv)
+
+	// the server was not given a loader
+	_, _, _, err = srv.Reload()
+	require.ErrorIs(t, err, errReloadUnsupported)
+
+	priceLimit := 2 * srv.config.TxPool.PriceLimit
+
+	srv.configLoader = func() (*Config, error) {
+		config := newConfig()
+		config.DataDir = srv.config.DataDir
+		config.GRPC.Addr = srv.config.GRPC.Addr
+		config.JsonRPC.Http.Port = srv.config.JsonRPC.Http.Port
+
+		config.TxPool.AccountSlots = 32
+		config.TxPool.PriceLimit = priceLimit
+		config.Gpo.IgnorePrice = big.NewInt(1)
+		config.Sealer.GasCeil = 20_000_000
+		config.RPCBatchLimit = 10
+		config.Cache.Cache = 2048
+
+		return config, nil
+	}
+
+	applied, restart, ignored, err := srv.Reload()
+	require.NoError(t, err)
+	require.Equal(t, []string{"miner.gaslimit", "rpc.batchlimit", "txpool.accountslots"}, applied)
+	// the fields adjusted by the server in developer mode are not changes
+	require.Equal(t, []string{"cache.cache"}, restart)
+	// the price limit and the ignore price are pinned to the PIP-35 minimum, even
+	// after a restart
+	require.NotContains(t, restart, "txpool.pricelimit")
+	require.NotContains(t, restart, "gpo.ignoreprice")
+	require.Equal(t, []string{"gpo.ignoreprice", "txpool.pricelimit"}, ignored)
+
+	require.Equal(t, uint64(32), srv.config.TxPool.AccountSlots)
+	// the pools keep enforcing the PIP-35 minimum gas tip
+	require.Equal(t, new(big.Int).SetUint64(params.BorDefaultTxPoolPriceLimit), srv.backend.LegacyPool().GasTip())
+	require.Equal(t, uint64(20_000_000), srv.config.Sealer.GasCeil)
+	require.Equal(t, DefaultConfig().Gpo.IgnorePrice, srv.config.Gpo.IgnorePrice)
+
+	// the active config is not touched for fields requiring a restart
+	require.Equal(t, DefaultConfig().Cache.Cache, srv.config.Cache.Cache)
+
+	// nothing to apply anymore
+	applied, restart, _, err = srv.Reload()
+	require.NoError(t, err)
+	require.Empty(t, applied)
+	require.Equal(t, []string{"cache.cache"}, restart)
+	require.Equal(t, new(big.Int).SetUint64(params.BorDefaultTxPoolPriceLimit), srv.backend.LegacyPool().GasTip())
+
+	// no change is applied if one of them is invalid
+	srv.configLoader = func() (*Config, error) {
+		config := newConfig()
+		config.DataDir = srv.config.DataDir
+		config.GRPC.Addr = srv.config.GRPC.Addr
+		config.JsonRPC.Http.Port = srv.config.JsonRPC.Http.Port
+
+		config.Sealer.GasCeil = 30_000_000
+		config.P2P.Discovery.StaticNodes = []string{"enode://invalid"}
+
+		return config, nil
+	}
+
+	_, _, _, err = srv.Reload()
+	require.ErrorContains(t, err, "invalid static node")
+	require.Equal(t, uint64(20_000_000), srv.config.Sealer.GasCeil)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts"
@@ -43,6 +44,7 @@ import (
 
 	// Force-load the tracer engines to trigger registration
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/live"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
 
 	protobor "github.com/maticnetwork/polyproto/bor"
@@ -58,8 +60,20 @@ type Server struct {
 	tracer     *sdktrace.TracerProvider
 	config     *Config
 
+	// devnet runs the other validators of the bor devnet
+	devnet *devnet
+
 	// tracerAPI to trace block executions
 	tracerAPI *tracers.API
+
+	// configLoader re-reads the config on reload requests
+	configLoader func() (*Config, error)
+	reloadLock   sync.Mutex
+
+	// loadedConfig is the flattened config as it was loaded, before the server
+	// adjusts it, with the reloaded fields applied. The reloads are compared
+	// with it.
+	loadedConfig map[string]interface{}
 }
 
 type serverOption func(srv *Server, config *Config) error
@@ -121,7 +135,8 @@ func NewServer(config *Config, opts ...serverOption) (*Server, error) {
 	runtime.SetMutexProfileFraction(5)
 
 	srv := &Server{
-		config: config,
+		config:       config,
+		loadedConfig: snapshotConfig(config),
 	}
 
 	// start the logger
@@ -136,6 +151,11 @@ func NewServer(config *Config, opts ...serverOption) (*Server, error) {
 		}
 	}
 
+	// the bor devnet runs in developer mode
+	if config.Developer.Bor.Enabled {
+		config.Developer.Enabled = true
+	}
+
 	// load the chain genesis
 	if err = config.loadChain(); err != nil {
 		return nil, err
@@ -274,8 +294,9 @@ func NewServer(config *Config, opts ...serverOption) (*Server, error) {
 		}
 	}
 
-	// sealing (if enabled) or in dev mode
-	if config.Sealer.Enabled || config.Developer.Enabled {
+	// sealing (if enabled) or in dev mode, the bor devnet starts sealing
+	// once its validators are connected
+	if (config.Sealer.Enabled || config.Developer.Enabled) && config.devnet == nil {
 		if err := srv.backend.StartMining(); err != nil {
 			return nil, err
 		}
@@ -293,10 +314,24 @@ func NewServer(config *Config, opts ...serverOption) (*Server, error) {
 		return nil, err
 	}
 
+	// start the other validators of the bor devnet
+	if config.devnet != nil {
+		srv.devnet = config.devnet
+
+		if err := srv.devnet.start(stack, srv.backend); err != nil {
+			srv.Stop()
+			return nil, err
+		}
+	}
+
 	return srv, nil
 }
 
 func (s *Server) Stop() {
+	if s.devnet != nil {
+		s.devnet.close()
+	}
+
 	if s.node != nil {
 		s.node.Close()
 	}
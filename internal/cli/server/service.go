@@ -7,17 +7,23 @@ import (
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 	"strings"
 	"time"
 
 	grpc_net_conn "github.com/JekaMas/go-grpc-net-conn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/eth/tracers/logger"
 	"github.com/ethereum/go-ethereum/internal/cli/server/pprof"
 	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 )
@@ -133,7 +139,7 @@ func (s *Server) PeersList(ctx context.Context, req *proto.PeersListRequest) (*p
 
 	peers := s.node.Server().PeersInfo()
 	for _, p := range peers {
-		resp.Peers = append(resp.Peers, peerInfoToPeer(p))
+		resp.Peers = append(resp.Peers, s.peerInfoToPeer(p))
 	}
 
 	return resp, nil
@@ -154,14 +160,14 @@ func (s *Server) PeersStatus(ctx context.Context, req *proto.PeersStatusRequest)
 
 	resp := &proto.PeersStatusResponse{}
 	if peerInfo != nil {
-		resp.Peer = peerInfoToPeer(peerInfo)
+		resp.Peer = s.peerInfoToPeer(peerInfo)
 	}
 
 	return resp, nil
 }
 
-func peerInfoToPeer(info *p2p.PeerInfo) *proto.Peer {
-	return &proto.Peer{
+func (s *Server) peerInfoToPeer(info *p2p.PeerInfo) *proto.Peer {
+	peer := &proto.Peer{
 		Id:      info.ID,
 		Enode:   info.Enode,
 		Enr:     info.ENR,
@@ -170,6 +176,21 @@ func peerInfoToPeer(info *p2p.PeerInfo) *proto.Peer {
 		Trusted: info.Network.Trusted,
 		Static:  info.Network.Static,
 	}
+
+	if s.backend == nil {
+		return peer
+	}
+
+	if id, err := enode.ParseID(info.ID); err == nil {
+		if score := s.backend.Reputation().Score(id); score != nil {
+			peer.Score = score.Score
+			if !score.BannedUntil.IsZero() {
+				peer.BannedUntil = score.BannedUntil.Unix()
+			}
+		}
+	}
+
+	return peer
 }
 
 func (s *Server) ChainSetHead(ctx context.Context, req *proto.ChainSetHeadRequest) (*proto.ChainSetHeadResponse, error) {
@@ -256,6 +277,204 @@ func (s *Server) DebugBlock(req *proto.DebugBlockRequest, stream proto.Bor_Debug
 	return nil
 }
 
+func (s *Server) DebugTraceTransaction(req *proto.DebugTraceTransactionRequest, stream proto.Bor_DebugTraceTransactionServer) error {
+	hash, err := parseHash(req.Hash)
+	if err != nil {
+		return err
+	}
+
+	config := &tracers.TraceConfig{}
+	if req.Tracer != "" {
+		config.Tracer = &req.Tracer
+	}
+
+	if req.TracerConfig != "" {
+		config.TracerConfig = json.RawMessage(req.TracerConfig)
+	}
+
+	if req.Timeout != "" {
+		config.Timeout = &req.Timeout
+	}
+
+	res, err := s.tracerAPI.TraceTransaction(stream.Context(), hash, config)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	return sendStreamDebugFile(stream, map[string]string{}, data)
+}
+
+func (s *Server) DebugVerbosity(ctx context.Context, req *proto.DebugVerbosityRequest) (*proto.DebugVerbosityResponse, error) {
+	if glogger == nil {
+		return nil, fmt.Errorf("logger is not initialised")
+	}
+
+	glogger.Verbosity(log.FromLegacyLevel(int(req.Level)))
+
+	return &proto.DebugVerbosityResponse{}, nil
+}
+
+func (s *Server) DebugVmodule(ctx context.Context, req *proto.DebugVmoduleRequest) (*proto.DebugVmoduleResponse, error) {
+	if glogger == nil {
+		return nil, fmt.Errorf("logger is not initialised")
+	}
+
+	if err := glogger.Vmodule(req.Pattern); err != nil {
+		return nil, err
+	}
+
+	return &proto.DebugVmoduleResponse{}, nil
+}
+
+func (s *Server) TxPoolStatus(ctx context.Context, req *proto.TxPoolStatusRequest) (*proto.TxPoolStatusResponse, error) {
+	pending, queued := s.backend.TxPool().Stats()
+
+	return &proto.TxPoolStatusResponse{
+		Pending: uint64(pending),
+		Queued:  uint64(queued),
+	}, nil
+}
+
+func (s *Server) TxPoolInspect(ctx context.Context, req *proto.TxPoolInspectRequest) (*proto.TxPoolInspectResponse, error) {
+	pool := s.backend.TxPool()
+	resp := &proto.TxPoolInspectResponse{}
+
+	if req.Address != "" {
+		if !common.IsHexAddress(req.Address) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address: %s", req.Address)
+		}
+
+		addr := common.HexToAddress(req.Address)
+		pending, queued := pool.ContentFrom(addr)
+
+		resp.Pending = poolTxsToProto(map[common.Address][]*types.Transaction{addr: pending})
+		resp.Queued = poolTxsToProto(map[common.Address][]*types.Transaction{addr: queued})
+
+		return resp, nil
+	}
+
+	pending, queued := pool.Content()
+
+	resp.Pending = poolTxsToProto(pending)
+	resp.Queued = poolTxsToProto(queued)
+
+	return resp, nil
+}
+
+// poolTxsToProto flattens the per-account transaction lists of the pool into
+// a list ordered by sender address and nonce.
+func poolTxsToProto(content map[common.Address][]*types.Transaction) []*proto.PoolTransaction {
+	addrs := make([]common.Address, 0, len(content))
+	for addr := range content {
+		addrs = append(addrs, addr)
+	}
+
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].Cmp(addrs[j]) < 0
+	})
+
+	var res []*proto.PoolTransaction
+
+	for _, addr := range addrs {
+		for _, tx := range content[addr] {
+			var to string
+			if tx.To() != nil {
+				to = tx.To().String()
+			}
+
+			res = append(res, &proto.PoolTransaction{
+				Hash:      tx.Hash().String(),
+				From:      addr.String(),
+				To:        to,
+				Nonce:     tx.Nonce(),
+				Value:     tx.Value().String(),
+				Gas:       tx.Gas(),
+				GasFeeCap: tx.GasFeeCap().String(),
+				GasTipCap: tx.GasTipCap().String(),
+			})
+		}
+	}
+
+	return res
+}
+
+func (s *Server) TxPoolDrop(ctx context.Context, req *proto.TxPoolDropRequest) (*proto.TxPoolDropResponse, error) {
+	// Nothing is dropped unless all the hashes are valid
+	hashes := make([]common.Hash, len(req.Hashes))
+
+	for i, hash := range req.Hashes {
+		var err error
+		if hashes[i], err = parseHash(hash); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &proto.TxPoolDropResponse{}
+
+	for i, hash := range hashes {
+		if s.backend.TxPool().Remove(hash) {
+			resp.Dropped = append(resp.Dropped, req.Hashes[i])
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Server) MinerStart(ctx context.Context, req *proto.MinerStartRequest) (*proto.MinerStartResponse, error) {
+	if err := s.backend.StartMining(); err != nil {
+		return nil, err
+	}
+
+	return &proto.MinerStartResponse{}, nil
+}
+
+func (s *Server) MinerStop(ctx context.Context, req *proto.MinerStopRequest) (*proto.MinerStopResponse, error) {
+	s.backend.StopMining()
+	return &proto.MinerStopResponse{}, nil
+}
+
+func (s *Server) MinerSetGasCeil(ctx context.Context, req *proto.MinerSetGasCeilRequest) (*proto.MinerSetGasCeilResponse, error) {
+	s.backend.Miner().SetGasCeil(req.GasCeil)
+	return &proto.MinerSetGasCeilResponse{}, nil
+}
+
+func (s *Server) WhitelistStatus(ctx context.Context, req *proto.WhitelistStatusRequest) (*proto.WhitelistStatusResponse, error) {
+	apiBackend := s.backend.APIBackend
+
+	checkpointExists, checkpointNumber, checkpointHash := apiBackend.GetWhitelistedCheckpoint()
+	milestoneExists, milestoneNumber, milestoneHash := apiBackend.GetWhitelistedMilestone()
+
+	return &proto.WhitelistStatusResponse{
+		Checkpoint: &proto.WhitelistStatusResponse_Entry{
+			Exists: checkpointExists,
+			Number: checkpointNumber,
+			Hash:   checkpointHash.String(),
+		},
+		Milestone: &proto.WhitelistStatusResponse_Entry{
+			Exists: milestoneExists,
+			Number: milestoneNumber,
+			Hash:   milestoneHash.String(),
+		},
+	}, nil
+}
+
+func (s *Server) WhitelistPurge(ctx context.Context, req *proto.WhitelistPurgeRequest) (*proto.WhitelistPurgeResponse, error) {
+	if req.Checkpoint {
+		s.backend.APIBackend.PurgeWhitelistedCheckpoint()
+	}
+
+	if req.Milestone {
+		s.backend.APIBackend.PurgeWhitelistedMilestone()
+	}
+
+	return &proto.WhitelistPurgeResponse{}, nil
+}
+
 var bigIntT = reflect.TypeOf(new(big.Int)).Kind()
 
 // gatherForks gathers all the fork numbers via reflection
@@ -345,3 +564,14 @@ func (s *Server) ChainWatch(req *proto.ChainWatchRequest, reply proto.Bor_ChainW
 		}
 	}
 }
+
+// parseHash parses a 0x prefixed transaction hash, rejecting the malformed ones
+// as invalid arguments instead of padding or truncating them.
+func parseHash(hash string) (common.Hash, error) {
+	b, err := hexutil.Decode(hash)
+	if err != nil || len(b) != common.HashLength {
+		return common.Hash{}, status.Errorf(codes.InvalidArgument, "invalid transaction hash: %s", hash)
+	}
+
+	return common.BytesToHash(b), nil
+}
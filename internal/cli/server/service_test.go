@@ -5,7 +5,10 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
 )
 
@@ -44,3 +47,15 @@ func TestGatherBlocks(t *testing.T) {
 	res := gatherForks(val, val2)
 	assert.Equal(t, res, expect)
 }
+
+func TestDebugTraceTransactionInvalidHash(t *testing.T) {
+	t.Parallel()
+
+	s := &Server{}
+
+	hash := common.Hash{0x01}.Hex()
+	for _, invalid := range []string{hash[2:], hash[:40], hash + "00", "0xzz"} {
+		err := s.DebugTraceTransaction(&proto.DebugTraceTransactionRequest{Hash: invalid}, nil)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), invalid)
+	}
+}
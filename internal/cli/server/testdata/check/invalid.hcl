@@ -0,0 +1,6 @@
+chain = "mainnet"
+
+txpool {
+  lifetime = "1h"
+  slots = 10
+}
@@ -0,0 +1,13 @@
+chain = "mainnet"
+log-level = "debug"
+
+p2p {
+  port = 30303
+  bootnodes = ["enode://d860a01f9722d78051619d1e2351aba3f43f943f6f00718d1b9baa4101932a1f5011f16bb2b1bb35db20d6fe28fa0bf09636d26a87d31de9ec6203eeedb1f666@18.138.108.67:30303"]
+  maxpeers = 40
+}
+
+txpool {
+  lifetime = "1h"
+  unknown = 1
+}
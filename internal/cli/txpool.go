@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// TxPoolCommand is the command to group the txpool commands
+type TxPoolCommand struct {
+	UI cli.Ui
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *TxPoolCommand) MarkDown() string {
+	items := []string{
+		"# TxPool",
+		"The ```txpool``` command groups actions to inspect and manage the transaction pool:",
+		"- [```txpool status```](./txpool_status.md): Prints the number of pending and queued transactions.",
+		"- [```txpool inspect```](./txpool_inspect.md): Lists the transactions in the pool.",
+		"- [```txpool drop```](./txpool_drop.md): Evicts transactions from the pool.",
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *TxPoolCommand) Help() string {
+	return `Usage: bor txpool <subcommand>
+
+  This command groups actions to inspect and manage the transaction pool.
+
+  Display the number of pending and queued transactions:
+
+    $ bor txpool status
+
+  List the transactions in the pool:
+
+    $ bor txpool inspect [--from <address>]
+
+  Evict transactions from the pool:
+
+    $ bor txpool drop <hash> [<hash>...]`
+}
+
+// Synopsis implements the cli.Command interface
+func (c *TxPoolCommand) Synopsis() string {
+	return "Interact with the transaction pool"
+}
+
+// Run implements the cli.Command interface
+func (c *TxPoolCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// TxPoolDropCommand is the command to evict transactions from the pool
+type TxPoolDropCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *TxPoolDropCommand) MarkDown() string {
+	items := []string{
+		"# TxPool drop",
+		"The ```txpool drop <hash> [<hash>...]``` command evicts the given transactions from the pool. " +
+			"Pending transactions of the same sender with higher nonces are moved back to the queue.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *TxPoolDropCommand) Help() string {
+	return `Usage: bor txpool drop <hash> [<hash>...]
+
+  Evicts the given transactions from the pool.
+
+  ` + c.Flags().Help()
+}
+
+func (c *TxPoolDropCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("txpool drop")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *TxPoolDropCommand) Synopsis() string {
+	return "Evict transactions from the pool"
+}
+
+// Run implements the cli.Command interface
+func (c *TxPoolDropCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	args = flags.Args()
+	if len(args) == 0 {
+		c.UI.Error("No transaction hash provided")
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	resp, err := borClt.TxPoolDrop(context.Background(), &proto.TxPoolDropRequest{Hashes: args})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(fmt.Sprintf("Dropped %d of %d transactions", len(resp.Dropped), len(args)))
+
+	for _, hash := range resp.Dropped {
+		c.UI.Output(hash)
+	}
+
+	return 0
+}
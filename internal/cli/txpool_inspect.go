@@ -0,0 +1,111 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// TxPoolInspectCommand is the command to list the transactions in the pool
+type TxPoolInspectCommand struct {
+	*Meta2
+
+	from string
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *TxPoolInspectCommand) MarkDown() string {
+	items := []string{
+		"# TxPool inspect",
+		"The ```txpool inspect``` command lists the pending and queued transactions in the pool.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *TxPoolInspectCommand) Help() string {
+	return `Usage: bor txpool inspect [--from <address>]
+
+  Lists the pending and queued transactions in the pool.
+
+  ` + c.Flags().Help()
+}
+
+func (c *TxPoolInspectCommand) Flags() *flagset.Flagset {
+	flags := c.NewFlagSet("txpool inspect")
+
+	flags.StringFlag(&flagset.StringFlag{
+		Name:  "from",
+		Usage: "Only list the transactions sent by this address",
+		Value: &c.from,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *TxPoolInspectCommand) Synopsis() string {
+	return "List the transactions in the pool"
+}
+
+// Run implements the cli.Command interface
+func (c *TxPoolInspectCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	resp, err := borClt.TxPoolInspect(context.Background(), &proto.TxPoolInspectRequest{Address: c.from})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Pending:")
+	c.UI.Output(formatPoolTransactions(resp.Pending))
+	c.UI.Output("")
+	c.UI.Output("Queued:")
+	c.UI.Output(formatPoolTransactions(resp.Queued))
+
+	return 0
+}
+
+func formatPoolTransactions(txs []*proto.PoolTransaction) string {
+	if len(txs) == 0 {
+		return "No transactions found"
+	}
+
+	rows := make([]string, len(txs)+1)
+	rows[0] = "Hash|From|Nonce|To|Value|Gas|FeeCap|TipCap"
+
+	for i, tx := range txs {
+		to := tx.To
+		if to == "" {
+			to = "<contract creation>"
+		}
+
+		rows[i+1] = fmt.Sprintf("%s|%s|%d|%s|%s|%d|%s|%s",
+			tx.Hash,
+			tx.From,
+			tx.Nonce,
+			to,
+			tx.Value,
+			tx.Gas,
+			tx.GasFeeCap,
+			tx.GasTipCap)
+	}
+
+	return formatList(rows)
+}
@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// TxPoolStatusCommand is the command to print the transaction pool stats
+type TxPoolStatusCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *TxPoolStatusCommand) MarkDown() string {
+	items := []string{
+		"# TxPool status",
+		"The ```txpool status``` command prints the number of pending and queued transactions in the pool.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *TxPoolStatusCommand) Help() string {
+	return `Usage: bor txpool status
+
+  Prints the number of pending and queued transactions in the pool.
+
+  ` + c.Flags().Help()
+}
+
+func (c *TxPoolStatusCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("txpool status")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *TxPoolStatusCommand) Synopsis() string {
+	return "Print the transaction pool stats"
+}
+
+// Run implements the cli.Command interface
+func (c *TxPoolStatusCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	resp, err := borClt.TxPoolStatus(context.Background(), &proto.TxPoolStatusRequest{})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(formatKV([]string{
+		fmt.Sprintf("Pending|%d", resp.Pending),
+		fmt.Sprintf("Queued|%d", resp.Queued),
+	}))
+
+	return 0
+}
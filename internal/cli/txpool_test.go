@@ -0,0 +1,75 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/internal/cli/server"
+)
+
+func TestTxPoolCommands(t *testing.T) {
+	t.Parallel()
+
+	// Start a blockchain in developer
+	config := server.DefaultConfig()
+
+	// enable developer mode
+	config.Developer.Enabled = true
+	config.Developer.Period = 2
+
+	// start the mock server
+	srv, err := server.CreateMockServer(config)
+	require.NoError(t, err)
+
+	defer server.CloseMockServer(srv)
+
+	// get the grpc port
+	addr := "127.0.0.1:" + srv.GetGrpcAddr()
+
+	ui := cli.NewMockUi()
+	status := &TxPoolStatusCommand{
+		Meta2: &Meta2{
+			UI:   ui,
+			addr: addr,
+		},
+	}
+	require.Equal(t, 0, status.Run([]string{"--address", addr}))
+	require.Contains(t, ui.OutputWriter.String(), "Pending")
+
+	ui = cli.NewMockUi()
+	drop := &TxPoolDropCommand{
+		Meta2: &Meta2{
+			UI:   ui,
+			addr: addr,
+		},
+	}
+	require.Equal(t, 0, drop.Run([]string{"--address", addr, common.Hash{0x01}.Hex()}))
+	require.Contains(t, ui.OutputWriter.String(), "Dropped 0 of 1 transactions")
+
+	// truncated hashes are rejected rather than padded to another hash
+	ui = cli.NewMockUi()
+	drop.UI = ui
+	require.Equal(t, 1, drop.Run([]string{"--address", addr, common.Hash{0x01}.Hex()[:40]}))
+	require.Contains(t, ui.ErrorWriter.String(), "invalid transaction hash")
+}
+
+func TestParseVerbosity(t *testing.T) {
+	t.Parallel()
+
+	level, err := parseVerbosity("debug")
+	require.NoError(t, err)
+	require.Equal(t, 4, level)
+
+	level, err = parseVerbosity("2")
+	require.NoError(t, err)
+	require.Equal(t, 2, level)
+
+	_, err = parseVerbosity("verbose")
+	require.Error(t, err)
+
+	_, err = parseVerbosity("9")
+	require.Error(t, err)
+}
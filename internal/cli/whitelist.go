@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/mitchellh/cli"
+)
+
+// WhitelistCommand is the command to group the whitelist commands
+type WhitelistCommand struct {
+	UI cli.Ui
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *WhitelistCommand) MarkDown() string {
+	items := []string{
+		"# Whitelist",
+		"The ```whitelist``` command groups actions to interact with the whitelisted checkpoint and milestone:",
+		"- [```whitelist status```](./whitelist_status.md): Displays the whitelisted checkpoint and milestone.",
+		"- [```whitelist purge```](./whitelist_purge.md): Purges the whitelisted checkpoint and/or milestone.",
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *WhitelistCommand) Help() string {
+	return `Usage: bor whitelist <subcommand>
+
+  This command groups actions to interact with the whitelisted checkpoint and milestone.
+
+  Display the whitelisted checkpoint and milestone:
+
+    $ bor whitelist status
+
+  Purge the whitelisted milestone:
+
+    $ bor whitelist purge --milestone`
+}
+
+// Synopsis implements the cli.Command interface
+func (c *WhitelistCommand) Synopsis() string {
+	return "Interact with the whitelisted checkpoint and milestone"
+}
+
+// Run implements the cli.Command interface
+func (c *WhitelistCommand) Run(args []string) int {
+	return cli.RunResultHelp
+}
@@ -0,0 +1,91 @@
+package cli
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// WhitelistPurgeCommand is the command to purge the whitelist entries
+type WhitelistPurgeCommand struct {
+	*Meta2
+
+	checkpoint bool
+	milestone  bool
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *WhitelistPurgeCommand) MarkDown() string {
+	items := []string{
+		"# Whitelist purge",
+		"The ```whitelist purge``` command purges the whitelisted checkpoint and/or milestone. Both are purged if no flag is given.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *WhitelistPurgeCommand) Help() string {
+	return `Usage: bor whitelist purge [--checkpoint] [--milestone]
+
+  Purges the whitelisted checkpoint and/or milestone.
+
+  ` + c.Flags().Help()
+}
+
+func (c *WhitelistPurgeCommand) Flags() *flagset.Flagset {
+	flags := c.NewFlagSet("whitelist purge")
+
+	flags.BoolFlag(&flagset.BoolFlag{
+		Name:  "checkpoint",
+		Usage: "Purge the whitelisted checkpoint",
+		Value: &c.checkpoint,
+	})
+	flags.BoolFlag(&flagset.BoolFlag{
+		Name:  "milestone",
+		Usage: "Purge the whitelisted milestone",
+		Value: &c.milestone,
+	})
+
+	return flags
+}
+
+// Synopsis implements the cli.Command interface
+func (c *WhitelistPurgeCommand) Synopsis() string {
+	return "Purge the whitelisted checkpoint and milestone"
+}
+
+// Run implements the cli.Command interface
+func (c *WhitelistPurgeCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	req := &proto.WhitelistPurgeRequest{
+		Checkpoint: c.checkpoint,
+		Milestone:  c.milestone,
+	}
+	if !req.Checkpoint && !req.Milestone {
+		req.Checkpoint, req.Milestone = true, true
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	if _, err := borClt.WhitelistPurge(context.Background(), req); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output("Done!")
+
+	return 0
+}
@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/internal/cli/flagset"
+	"github.com/ethereum/go-ethereum/internal/cli/server/proto"
+)
+
+// WhitelistStatusCommand is the command to display the whitelist entries
+type WhitelistStatusCommand struct {
+	*Meta2
+}
+
+// MarkDown implements cli.MarkDown interface
+func (c *WhitelistStatusCommand) MarkDown() string {
+	items := []string{
+		"# Whitelist status",
+		"The ```whitelist status``` command displays the checkpoint and milestone currently whitelisted by the client.",
+		c.Flags().MarkDown(),
+	}
+
+	return strings.Join(items, "\n\n")
+}
+
+// Help implements the cli.Command interface
+func (c *WhitelistStatusCommand) Help() string {
+	return `Usage: bor whitelist status
+
+  Displays the checkpoint and milestone currently whitelisted by the client.
+
+  ` + c.Flags().Help()
+}
+
+func (c *WhitelistStatusCommand) Flags() *flagset.Flagset {
+	return c.NewFlagSet("whitelist status")
+}
+
+// Synopsis implements the cli.Command interface
+func (c *WhitelistStatusCommand) Synopsis() string {
+	return "Display the whitelisted checkpoint and milestone"
+}
+
+// Run implements the cli.Command interface
+func (c *WhitelistStatusCommand) Run(args []string) int {
+	flags := c.Flags()
+	if err := flags.Parse(args); err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	borClt, err := c.BorConn()
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	resp, err := borClt.WhitelistStatus(context.Background(), &proto.WhitelistStatusRequest{})
+	if err != nil {
+		c.UI.Error(err.Error())
+		return 1
+	}
+
+	c.UI.Output(formatWhitelistStatus(resp))
+
+	return 0
+}
+
+func formatWhitelistStatus(resp *proto.WhitelistStatusResponse) string {
+	entry := func(e *proto.WhitelistStatusResponse_Entry) string {
+		if e == nil || !e.Exists {
+			return emptyPlaceHolder
+		}
+
+		return fmt.Sprintf("(%d, %s)", e.Number, e.Hash)
+	}
+
+	return formatKV([]string{
+		fmt.Sprintf("Checkpoint (number, hash)|%s", entry(resp.Checkpoint)),
+		fmt.Sprintf("Milestone (number, hash)|%s", entry(resp.Milestone)),
+	})
+}
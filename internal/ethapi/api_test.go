@@ -53,7 +53,9 @@ import (
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/internal/blocktest"
 	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
 )
 
 func testTransactionMarshal(t *testing.T, tests []txData, config *params.ChainConfig) {
@@ -618,7 +620,8 @@ func (b testBackend) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscripti
 func (b testBackend) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription {
 	panic("implement me")
 }
-func (b testBackend) BloomStatus() (uint64, uint64) { panic("implement me") }
+func (b testBackend) BloomStatus() (uint64, uint64)    { panic("implement me") }
+func (b testBackend) LogIndexStatus() (uint64, uint64) { panic("implement me") }
 func (b testBackend) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {
 	panic("implement me")
 }
@@ -637,6 +640,10 @@ func (b testBackend) GetRootHash(ctx context.Context, starBlockNr uint64, endBlo
 	panic("implement me")
 }
 
+func (b testBackend) GetRootHashProof(ctx context.Context, starBlockNr uint64, endBlockNr uint64, number uint64) (common.Hash, []common.Hash, error) {
+	return common.Hash{}, nil, nil
+}
+
 func (b testBackend) GetVoteOnHash(ctx context.Context, starBlockNr uint64, endBlockNr uint64, hash string, milestoneId string) (bool, error) {
 	panic("implement me")
 }
@@ -696,6 +703,10 @@ func (b testBackend) GetBorBlockReceipt(ctx context.Context, hash common.Hash) (
 	return receipt, nil
 }
 
+func (b testBackend) GetAddressActivity(ctx context.Context, address common.Address, from uint64, txIndex uint64, to uint64, limit int) ([]*rawdb.AddressActivityEntry, error) {
+	return core.ReadAddressActivity(b.db, b.chain.Config(), address, from, txIndex, to, 0, limit), nil
+}
+
 func TestEstimateGas(t *testing.T) {
 	t.Parallel()
 	// Initialize test accounts
@@ -2085,6 +2096,36 @@ func testRPCResponseWithFile(t *testing.T, testid int, result interface{}, rpc s
 	require.JSONEqf(t, string(want), string(data), "test %d: json not match, want: %s, have: %s", testid, string(want), string(data))
 }
 
+func TestRPCGetReceiptProof(t *testing.T) {
+	t.Parallel()
+
+	var (
+		backend, txHashes = setupReceiptBackend(t, 6)
+		api               = NewBorAPI(backend)
+	)
+
+	for i, txHash := range txHashes {
+		res, err := api.GetReceiptProof(context.Background(), txHash, 1, 6)
+		require.NoError(t, err, "tx %d", i)
+		require.Equal(t, uint64(i+1), res.Header.Number.Uint64())
+		require.Equal(t, hexutil.Uint64(i), res.BlockIndex)
+
+		// The receipt proof leads to the receipts root of the header
+		proofDb := rawdb.NewMemoryDatabase()
+		for _, node := range res.ReceiptProof {
+			enc := hexutil.MustDecode(node)
+			require.NoError(t, proofDb.Put(crypto.Keccak256(enc), enc))
+		}
+
+		receipt, err := trie.VerifyProof(res.Header.ReceiptHash, rlp.AppendUint64(nil, uint64(res.TransactionIndex)), proofDb)
+		require.NoError(t, err, "tx %d", i)
+		require.Equal(t, []byte(res.Receipt), receipt, "tx %d", i)
+	}
+
+	_, err := api.GetReceiptProof(context.Background(), txHashes[5], 1, 5)
+	require.Error(t, err)
+}
+
 func TestRPCGetTransactionReceiptsByBlock(t *testing.T) {
 	t.Parallel()
 
@@ -2,11 +2,18 @@ package ethapi
 
 import (
 	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/ethereum/go-ethereum/triedb"
 )
 
 // GetRootHash returns root hash for given start and end block
@@ -109,3 +116,187 @@ func (api *BorAPI) SendRawTransactionConditional(ctx context.Context, input hexu
 func (api *BorAPI) GetVoteOnHash(ctx context.Context, starBlockNr uint64, endBlockNr uint64, hash string, milestoneId string) (bool, error) {
 	return api.b.GetVoteOnHash(ctx, starBlockNr, endBlockNr, hash, milestoneId)
 }
+
+// RPCReceiptProof is a proof of the inclusion of a transaction receipt in the
+// checkpoint root hash of a block range. The receipt proof leads from the receipt
+// to the receipts root of the header, and the block proof holds the sibling hashes
+// leading from the checkpoint leaf of the header to the root hash.
+type RPCReceiptProof struct {
+	RootHash         common.Hash    `json:"rootHash"`
+	Header           *types.Header  `json:"header"`
+	BlockIndex       hexutil.Uint64 `json:"blockIndex"`
+	BlockProof       []common.Hash  `json:"blockProof"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	Receipt          hexutil.Bytes  `json:"receipt"`
+	ReceiptProof     []string       `json:"receiptProof"`
+}
+
+// GetReceiptProof returns the proof of the inclusion of the receipt of a
+// transaction in the checkpoint root hash of the start to end block headers.
+// The bor transactions are not part of the receipts trie, they can't be proven.
+func (api *BorAPI) GetReceiptProof(ctx context.Context, txHash common.Hash, startBlockNr uint64, endBlockNr uint64) (*RPCReceiptProof, error) {
+	found, _, blockHash, blockNumber, index, err := api.b.GetTransaction(ctx, txHash)
+	if err != nil {
+		return nil, NewTxIndexingError() // transaction is not fully indexed
+	}
+
+	if !found {
+		return nil, errors.New("transaction not found")
+	}
+
+	if blockNumber < startBlockNr || blockNumber > endBlockNr {
+		return nil, errors.New("transaction out of checkpoint range")
+	}
+
+	header, err := api.b.HeaderByHash(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if header == nil {
+		return nil, errors.New("block not found")
+	}
+
+	receipts, err := api.b.GetReceipts(ctx, blockHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if uint64(len(receipts)) <= index {
+		return nil, errors.New("receipt not found")
+	}
+
+	// Rebuild the receipts trie of the block to prove the receipt
+	tr := trie.NewEmpty(triedb.NewDatabase(rawdb.NewMemoryDatabase(), nil))
+	if root := types.DeriveSha(receipts, tr); root != header.ReceiptHash {
+		return nil, fmt.Errorf("receipts root mismatch: have %x, want %x", root, header.ReceiptHash)
+	}
+
+	var receiptProof proofList
+	if err := tr.Prove(rlp.AppendUint64(nil, index), &receiptProof); err != nil {
+		return nil, err
+	}
+
+	receipt, err := receipts[index].MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	rootHash, blockProof, err := api.b.GetRootHashProof(ctx, startBlockNr, endBlockNr, blockNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RPCReceiptProof{
+		RootHash:         rootHash,
+		Header:           header,
+		BlockIndex:       hexutil.Uint64(blockNumber - startBlockNr),
+		BlockProof:       blockProof,
+		TransactionIndex: hexutil.Uint64(index),
+		Receipt:          receipt,
+		ReceiptProof:     receiptProof,
+	}, nil
+}
+
+// addressActivityPageSize is the number of entries of a page of address activity
+const addressActivityPageSize = 100
+
+// RPCAddressActivity is the involvement of an address in a transaction
+type RPCAddressActivity struct {
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	BlockHash        common.Hash    `json:"blockHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	Kinds            []string       `json:"kinds"`
+}
+
+// AddressActivityPage is a page of the activity of an address, along with the
+// cursor of the next page, which is nil on the last page
+type AddressActivityPage struct {
+	Activity []*RPCAddressActivity `json:"activity"`
+	Cursor   *hexutil.Bytes        `json:"cursor"`
+}
+
+// GetAddressActivity returns the transactions the address is involved in between
+// fromBlock and toBlock, as sender, recipient, participant of an internal call
+// or recipient of a state sync. The transactions are returned in pages, the
+// next page is requested with the cursor of the previous one.
+func (api *BorAPI) GetAddressActivity(ctx context.Context, address common.Address, fromBlock rpc.BlockNumber, toBlock rpc.BlockNumber, cursor *hexutil.Bytes) (*AddressActivityPage, error) {
+	fromHeader, err := api.b.HeaderByNumber(ctx, fromBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	toHeader, err := api.b.HeaderByNumber(ctx, toBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	if fromHeader == nil || toHeader == nil {
+		return nil, errors.New("block not found")
+	}
+
+	from, to := fromHeader.Number.Uint64(), toHeader.Number.Uint64()
+	if from > to {
+		return nil, errors.New("invalid block range")
+	}
+
+	// The cursor is the position of the first entry of the page
+	var txIndex uint64
+
+	if cursor != nil {
+		if len(*cursor) != 16 {
+			return nil, errors.New("invalid cursor")
+		}
+
+		number := binary.BigEndian.Uint64((*cursor)[:8])
+		if number < from || number > to {
+			return nil, errors.New("cursor out of block range")
+		}
+
+		from, txIndex = number, binary.BigEndian.Uint64((*cursor)[8:])
+	}
+
+	entries, err := api.b.GetAddressActivity(ctx, address, from, txIndex, to, addressActivityPageSize+1)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &AddressActivityPage{Activity: make([]*RPCAddressActivity, 0, len(entries))}
+
+	if len(entries) > addressActivityPageSize {
+		next := entries[addressActivityPageSize]
+		enc := hexutil.Bytes(binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(nil, next.Number), next.TxIndex))
+
+		page.Cursor, entries = &enc, entries[:addressActivityPageSize]
+	}
+
+	bodies := make(map[common.Hash]*types.Body)
+
+	for _, entry := range entries {
+		body, ok := bodies[entry.Hash]
+		if !ok {
+			if body, err = api.b.GetBody(ctx, entry.Hash, rpc.BlockNumber(entry.Number)); err != nil {
+				return nil, err
+			}
+
+			bodies[entry.Hash] = body
+		}
+
+		// The state syncs of a block are part of its bor transaction
+		txHash := types.GetDerivedBorTxHash(types.BorReceiptKey(entry.Number, entry.Hash))
+		if entry.TxIndex < uint64(len(body.Transactions)) {
+			txHash = body.Transactions[entry.TxIndex].Hash()
+		}
+
+		page.Activity = append(page.Activity, &RPCAddressActivity{
+			BlockNumber:      hexutil.Uint64(entry.Number),
+			BlockHash:        entry.Hash,
+			TransactionIndex: hexutil.Uint64(entry.TxIndex),
+			TransactionHash:  txHash,
+			Kinds:            entry.Kind.Names(),
+		})
+	}
+
+	return page, nil
+}
@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/bloombits"
+	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -410,6 +411,7 @@ func (b *backendMock) TxPoolContentFrom(addr common.Address) ([]*types.Transacti
 }
 func (b *backendMock) SubscribeNewTxsEvent(chan<- core.NewTxsEvent) event.Subscription      { return nil }
 func (b *backendMock) BloomStatus() (uint64, uint64)                                        { return 0, 0 }
+func (b *backendMock) LogIndexStatus() (uint64, uint64)                                     { return 0, 0 }
 func (b *backendMock) ServiceFilter(ctx context.Context, session *bloombits.MatcherSession) {}
 func (b *backendMock) SubscribeLogsEvent(ch chan<- []*types.Log) event.Subscription         { return nil }
 func (b *backendMock) SubscribePendingLogsEvent(ch chan<- []*types.Log) event.Subscription {
@@ -433,6 +435,10 @@ func (b *backendMock) GetRootHash(ctx context.Context, starBlockNr uint64, endBl
 	return "", nil
 }
 
+func (b *backendMock) GetRootHashProof(ctx context.Context, starBlockNr uint64, endBlockNr uint64, number uint64) (common.Hash, []common.Hash, error) {
+	return common.Hash{}, nil, nil
+}
+
 func (b *backendMock) GetVoteOnHash(ctx context.Context, starBlockNr uint64, endBlockNr uint64, hash string, milestoneId string) (bool, error) {
 	return false, nil
 }
@@ -455,6 +461,10 @@ func (b *backendMock) GetBorBlockTransactionWithBlockHash(ctx context.Context, t
 	return nil, common.Hash{}, 0, 0, nil
 }
 
+func (b *backendMock) GetAddressActivity(ctx context.Context, address common.Address, from uint64, txIndex uint64, to uint64, limit int) ([]*rawdb.AddressActivityEntry, error) {
+	return nil, nil
+}
+
 func (b *backendMock) SubscribeChain2HeadEvent(ch chan<- core.Chain2HeadEvent) event.Subscription {
 	return nil
 }
@@ -60,6 +60,21 @@ web3._extend({
 			call: 'bor_getRootHash',
 			params: 2,
 		}),
+		new web3._extend.Method({
+			name: 'getRootHashProof',
+			call: 'bor_getRootHashProof',
+			params: 3,
+		}),
+		new web3._extend.Method({
+			name: 'getMilestoneProof',
+			call: 'bor_getMilestoneProof',
+			params: 2,
+		}),
+		new web3._extend.Method({
+			name: 'getReceiptProof',
+			call: 'bor_getReceiptProof',
+			params: 3,
+		}),
 		new web3._extend.Method({
 			name: 'getVoteOnHash',
 			call: 'bor_getVoteOnHash',
@@ -53,6 +53,11 @@ func init() {
 	var configFile string
 
 	for i, arg := range os.Args {
+		// skip the positional args (e.g. the `bor config` command)
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
 		flag := strings.TrimLeft(arg, "-")
 
 		// check for existence of `config` flag
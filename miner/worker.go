@@ -114,6 +114,8 @@ type environment struct {
 
 	depsMVFullWriteList [][]blockstm.WriteDescriptor
 	mvReadMapList       []map[blockstm.Key]blockstm.ReadDescriptor
+
+	hooks *core.BlockHookRecorder // hooks of the live tracer, replayed when the block is written
 }
 
 // copy creates a deep copy of environment.
@@ -127,7 +129,9 @@ func (env *environment) copy() *environment {
 		receipts:            copyReceipts(env.receipts),
 		depsMVFullWriteList: env.depsMVFullWriteList,
 		mvReadMapList:       env.mvReadMapList,
+		hooks:               env.hooks.Copy(),
 	}
+	cpy.state.SetLogger(cpy.hooks.Hooks())
 
 	if env.gasPool != nil {
 		gasPool := *env.gasPool
@@ -159,6 +163,7 @@ type task struct {
 	ctx       context.Context
 	receipts  []*types.Receipt
 	state     *state.StateDB
+	hooks     *core.BlockHookRecorder
 	block     *types.Block
 	createdAt time.Time
 }
@@ -809,7 +814,7 @@ func (w *worker) resultLoop() {
 				}
 				// Commit block and state to database.
 				tracing.Exec(ctx, "", "resultLoop.WriteBlockAndSetHead", func(ctx context.Context, span trace.Span) {
-					_, err = w.chain.WriteBlockAndSetHead(ctx, block, receipts, logs, task.state, true)
+					_, err = w.chain.WriteBlockAndSetHead(ctx, block, receipts, logs, task.state, task.hooks, true)
 				})
 
 				tracing.SetAttributes(
@@ -864,7 +869,10 @@ func (w *worker) makeEnv(parent *types.Header, header *types.Header, coinbase co
 		state:    state,
 		coinbase: coinbase,
 		header:   header,
+		hooks:    w.chain.NewBlockHookRecorder(),
 	}
+	state.SetLogger(env.hooks.Hooks())
+
 	// Keep track of transactions which return errors so they can be removed
 	env.tcount = 0
 
@@ -893,17 +901,24 @@ func (w *worker) updateSnapshot(env *environment) {
 
 func (w *worker) commitTransaction(env *environment, tx *types.Transaction, interruptCtx context.Context) ([]*types.Log, error) {
 	var (
-		snap = env.state.Snapshot()
-		gp   = env.gasPool.Gas()
+		snap  = env.state.Snapshot()
+		gp    = env.gasPool.Gas()
+		hooks = env.hooks.Len()
 	)
 
 	// nolint : staticcheck
 	interruptCtx = vm.SetCurrentTxOnContext(interruptCtx, tx.Hash())
 
-	receipt, err := core.ApplyTransaction(w.chainConfig, w.chain, &env.coinbase, env.gasPool, env.state, env.header, tx, &env.header.GasUsed, *w.chain.GetVMConfig(), interruptCtx)
+	// The hooks of the live tracer are recorded, and replayed once the block
+	// is sealed and written
+	vmConfig := *w.chain.GetVMConfig()
+	vmConfig.Tracer = env.hooks.Hooks()
+
+	receipt, err := core.ApplyTransaction(w.chainConfig, w.chain, &env.coinbase, env.gasPool, env.state, env.header, tx, &env.header.GasUsed, vmConfig, interruptCtx)
 	if err != nil {
 		env.state.RevertToSnapshot(snap)
 		env.gasPool.SetGas(gp)
+		env.hooks.Truncate(hooks)
 
 		return nil, err
 	}
@@ -1763,7 +1778,7 @@ func (w *worker) commit(ctx context.Context, env *environment, interval func(),
 		}
 
 		select {
-		case w.taskCh <- &task{ctx: ctx, receipts: env.receipts, state: env.state, block: block, createdAt: time.Now()}:
+		case w.taskCh <- &task{ctx: ctx, receipts: env.receipts, state: env.state, hooks: env.hooks, block: block, createdAt: time.Now()}:
 			fees := totalFees(block, env.receipts)
 			feesInEther := new(big.Float).Quo(new(big.Float).SetInt(fees), big.NewFloat(params.Ether))
 			log.Info("Commit new sealing work", "number", block.Number(), "sealhash", w.engine.SealHash(block.Header()),
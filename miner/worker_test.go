@@ -17,8 +17,11 @@
 package miner
 
 import (
+	"fmt"
 	"math/big"
 	"os"
+	"reflect"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -33,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/tracing"
 	"github.com/ethereum/go-ethereum/core/txpool"
 	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -225,6 +229,10 @@ type testWorkerBackend struct {
 }
 
 func newTestWorkerBackend(t TensingObject, chainConfig *params.ChainConfig, engine consensus.Engine, db ethdb.Database) *testWorkerBackend {
+	return newTestWorkerBackendWithVMConfig(t, chainConfig, engine, db, vm.Config{})
+}
+
+func newTestWorkerBackendWithVMConfig(t TensingObject, chainConfig *params.ChainConfig, engine consensus.Engine, db ethdb.Database, vmConfig vm.Config) *testWorkerBackend {
 	var gspec = &core.Genesis{
 		Config: chainConfig,
 		Alloc:  types.GenesisAlloc{testBankAddress: {Balance: testBankFunds}},
@@ -247,7 +255,7 @@ func newTestWorkerBackend(t TensingObject, chainConfig *params.ChainConfig, engi
 		t.Fatalf("unexpected consensus engine type: %T", engine)
 	}
 	// genesis := gspec.MustCommit(db)
-	chain, err := core.NewBlockChain(db, &core.CacheConfig{TrieDirtyDisabled: true}, gspec, nil, engine, vm.Config{}, nil, nil, nil)
+	chain, err := core.NewBlockChain(db, &core.CacheConfig{TrieDirtyDisabled: true}, gspec, nil, engine, vmConfig, nil, nil, nil)
 	if err != nil {
 		t.Fatalf("core.NewBlockChain failed: %v", err)
 	}
@@ -378,6 +386,122 @@ func TestGenerateAndImportBlock(t *testing.T) {
 	}
 }
 
+// testBlockTracer records the transactions and balance changes a live tracer
+// sees in each block.
+type testBlockTracer struct {
+	lock   sync.Mutex
+	block  common.Hash
+	blocks map[common.Hash][]string
+}
+
+func newTestBlockTracer() *testBlockTracer {
+	return &testBlockTracer{blocks: make(map[common.Hash][]string)}
+}
+
+func (t *testBlockTracer) hooks() *tracing.Hooks {
+	return &tracing.Hooks{
+		OnBlockStart: func(ev tracing.BlockEvent) {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+
+			t.block = ev.Block.Hash()
+			t.blocks[t.block] = nil
+		},
+		OnTxStart: func(vm *tracing.VMContext, tx *types.Transaction, from common.Address) {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+
+			t.blocks[t.block] = append(t.blocks[t.block], fmt.Sprintf("tx %x", tx.Hash()))
+		},
+		OnBalanceChange: func(addr common.Address, prev, new *big.Int, reason tracing.BalanceChangeReason) {
+			t.lock.Lock()
+			defer t.lock.Unlock()
+
+			t.blocks[t.block] = append(t.blocks[t.block], fmt.Sprintf("balance %x %v -> %v", addr, prev, new))
+		},
+	}
+}
+
+func (t *testBlockTracer) events(block common.Hash) ([]string, bool) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	events, ok := t.blocks[block]
+
+	return events, ok
+}
+
+// This test checks that the live tracer of the chain sees the blocks sealed by
+// the worker as it would see them imported.
+// nolint : paralleltest
+func TestGenerateBlockLiveTracer(t *testing.T) {
+	var (
+		db     = rawdb.NewMemoryDatabase()
+		config = *params.AllCliqueProtocolChanges
+	)
+	config.Clique = &params.CliqueConfig{Period: 1, Epoch: 30000}
+	engine := clique.New(config.Clique, db)
+
+	var (
+		sealTracer   = newTestBlockTracer()
+		importTracer = newTestBlockTracer()
+		b            = newTestWorkerBackendWithVMConfig(t, &config, engine, db, vm.Config{Tracer: sealTracer.hooks()})
+	)
+	defer b.chain.Stop()
+
+	// The transactions are committed without interruption
+	minerConfig := *testConfig
+	minerConfig.CommitInterruptFlag = false
+
+	//nolint:staticcheck
+	w := newWorker(&minerConfig, &config, engine, b, new(event.TypeMux), nil, false)
+	w.setEtherbase(testBankAddress)
+	defer w.close()
+
+	// This test chain imports the sealed blocks.
+	chain, _ := core.NewBlockChain(rawdb.NewMemoryDatabase(), nil, b.genesis, nil, engine, vm.Config{Tracer: importTracer.hooks()}, nil, nil, nil)
+	defer chain.Stop()
+
+	// Ignore empty commit here for less noise.
+	w.skipSealHook = func(task *task) bool {
+		return len(task.receipts) == 0
+	}
+
+	sub := w.mux.Subscribe(core.NewMinedBlockEvent{})
+	defer sub.Unsubscribe()
+
+	w.start()
+
+	for i := 0; i < 3; i++ {
+		b.txPool.Add([]*types.Transaction{b.newRandomTx(true)}, true, false)
+		b.txPool.Add([]*types.Transaction{b.newRandomTx(false)}, true, false)
+
+		select {
+		case ev := <-sub.Chan():
+			block := ev.Data.(core.NewMinedBlockEvent).Block
+			if _, err := chain.InsertChain([]*types.Block{block}); err != nil {
+				t.Fatalf("failed to insert new mined block %d: %v", block.NumberU64(), err)
+			}
+
+			sealed, ok := sealTracer.events(block.Hash())
+			if !ok {
+				t.Fatalf("sealed block %d not traced", block.NumberU64())
+			}
+
+			imported, _ := importTracer.events(block.Hash())
+			if !reflect.DeepEqual(sealed, imported) {
+				t.Fatalf("block %d: sealed block traced as %v, imported as %v", block.NumberU64(), sealed, imported)
+			}
+
+			if len(block.Transactions()) == 0 || len(sealed) == 0 {
+				t.Fatalf("block %d: no transactions traced", block.NumberU64())
+			}
+		case <-time.After(3 * time.Second): // Worker needs 1s to include new changes.
+			t.Fatalf("timeout")
+		}
+	}
+}
+
 func getFakeBorFromConfig(t *testing.T, chainConfig *params.ChainConfig) (consensus.Engine, *gomock.Controller) {
 	t.Helper()
 
@@ -699,6 +699,20 @@ func (n *Node) RPCHandler() (*rpc.Server, error) {
 	return n.inprocHandler, nil
 }
 
+// SetRPCBatchLimit changes the maximum number of requests in a batch accepted by
+// the in-process handler and all running HTTP and WebSocket endpoints.
+func (n *Node) SetRPCBatchLimit(limit uint64) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	n.config.RPCBatchLimit = limit
+	n.inprocHandler.SetRPCBatchLimit(limit)
+
+	for _, server := range []*httpServer{n.http, n.ws, n.httpAuth, n.wsAuth} {
+		server.setRPCBatchLimit(limit)
+	}
+}
+
 // Config returns the configuration of node.
 func (n *Node) Config() *Config {
 	return n.config
@@ -349,6 +349,23 @@ func (h *httpServer) enableRPC(apis []rpc.API, config httpConfig) error {
 	return nil
 }
 
+// setRPCBatchLimit changes the batch limit of the server, applying it to the
+// currently running HTTP and WebSocket handlers as well.
+func (h *httpServer) setRPCBatchLimit(limit uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.RPCBatchLimit = limit
+
+	if handler := h.httpHandler.Load().(*rpcHandler); handler != nil {
+		handler.server.SetRPCBatchLimit(limit)
+	}
+
+	if handler := h.wsHandler.Load().(*rpcHandler); handler != nil {
+		handler.server.SetRPCBatchLimit(limit)
+	}
+}
+
 // disableRPC stops the HTTP RPC handler. This is internal, the caller must hold h.mu.
 func (h *httpServer) disableRPC() bool {
 	handler := h.httpHandler.Load().(*rpcHandler)
@@ -77,6 +77,8 @@ var (
 	errRecentlyDialed   = errors.New("recently dialed")
 	errNetRestrict      = errors.New("not contained in netrestrict list")
 	errNoPort           = errors.New("node does not provide TCP port")
+	errBanned           = errors.New("node is banned")
+	errFiltered         = errors.New("rejected by dial filter")
 )
 
 // dialer creates outbound connections and submits them into Server.
@@ -126,10 +128,12 @@ type dialScheduler struct {
 type dialSetupFunc func(net.Conn, connFlag, *enode.Node) error
 
 type dialConfig struct {
-	self           enode.ID         // our own ID
-	maxDialPeers   int              // maximum number of dialed peers
-	maxActiveDials int              // maximum number of active dials
-	netRestrict    *netutil.Netlist // IP netrestrict list, disabled if nil
+	self           enode.ID               // our own ID
+	maxDialPeers   int                    // maximum number of dialed peers
+	maxActiveDials int                    // maximum number of active dials
+	netRestrict    *netutil.Netlist       // IP netrestrict list, disabled if nil
+	bans           PeerBanList            // banned nodes and IP addresses, disabled if nil
+	filter         func(*enode.Node) bool // dynamic dial candidate filter, disabled if nil
 	resolver       nodeResolver
 	dialer         NodeDialer
 	log            log.Logger
@@ -245,7 +249,7 @@ loop:
 
 		select {
 		case node := <-nodesCh:
-			if err := d.checkDial(node); err != nil {
+			if err := d.checkDynDial(node); err != nil {
 				d.log.Trace("Discarding dial candidate", "id", node.ID(), "ip", node.IPAddr(), "reason", err)
 			} else {
 				d.startDial(newDialTask(node, dynDialedConn))
@@ -382,6 +386,23 @@ func (d *dialScheduler) freeDialSlots() int {
 	return free
 }
 
+// checkDynDial returns an error if the dynamic dial candidate n should not be
+// dialed. On top of checkDial, the candidates must pass the dial filter and not
+// be banned. The static nodes aren't subject to the bans here, as nothing would
+// add them back to the static pool once their ban expires.
+func (d *dialScheduler) checkDynDial(n *enode.Node) error {
+	if err := d.checkDial(n); err != nil {
+		return err
+	}
+	if d.filter != nil && !d.filter(n) {
+		return errFiltered
+	}
+	if d.bans != nil && (d.bans.IsBannedNode(n.ID()) || (n.IPAddr().IsValid() && d.bans.IsBannedIP(n.IPAddr()))) {
+		return errBanned
+	}
+	return nil
+}
+
 // checkDial returns an error if node n should not be dialed.
 func (d *dialScheduler) checkDial(n *enode.Node) error {
 	if n.ID() == d.self {
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math/rand"
 	"net"
+	"net/netip"
 	"reflect"
 	"sync"
 	"testing"
@@ -152,6 +153,89 @@ func TestDialSchedNetRestrict(t *testing.T) {
 	})
 }
 
+// This test checks that candidates rejected by the dial filter are not dialed.
+func TestDialSchedFilter(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*enode.Node{
+		newNode(uintID(0x01), "127.0.0.1:30303"),
+		newNode(uintID(0x02), "127.0.0.2:30303"),
+		newNode(uintID(0x03), "127.0.0.3:30303"),
+		newNode(uintID(0x04), "127.0.0.4:30303"),
+	}
+	config := dialConfig{
+		filter: func(n *enode.Node) bool {
+			return n.ID() == uintID(0x02) || n.ID() == uintID(0x04)
+		},
+		maxActiveDials: 10,
+		maxDialPeers:   10,
+	}
+	runDialTest(t, config, []dialTestRound{
+		{
+			discovered:   nodes,
+			wantNewDials: []*enode.Node{nodes[1], nodes[3]},
+		},
+		{
+			succeeded: []enode.ID{
+				nodes[1].ID(),
+				nodes[3].ID(),
+			},
+		},
+	})
+}
+
+// This test checks that banned candidates are not dialed, while a banned static
+// node is still dialed and connects once its ban expires.
+func TestDialSchedBans(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*enode.Node{
+		newNode(uintID(0x01), "127.0.0.1:30303"),
+		newNode(uintID(0x02), "127.0.0.2:30303"),
+		newNode(uintID(0x03), "127.0.0.3:30303"),
+	}
+	bans := &dialTestBanList{nodes: map[enode.ID]bool{
+		nodes[0].ID(): true,
+		nodes[2].ID(): true,
+	}}
+	config := dialConfig{
+		bans:           bans,
+		maxActiveDials: 10,
+		maxDialPeers:   10,
+	}
+	runDialTest(t, config, []dialTestRound{
+		{
+			update: func(d *dialScheduler) {
+				d.addStatic(nodes[2])
+			},
+			discovered:   nodes[:2],
+			wantNewDials: []*enode.Node{nodes[1], nodes[2]},
+		},
+		// The server rejects the banned static node, whose ban expires.
+		{
+			succeeded: []enode.ID{
+				nodes[1].ID(),
+			},
+			failed: []enode.ID{
+				nodes[2].ID(),
+			},
+			wantResolves: map[enode.ID]*enode.Node{
+				nodes[2].ID(): nil,
+			},
+			update: func(d *dialScheduler) {
+				bans.unban(nodes[2].ID())
+			},
+		},
+		// Nothing happens in this round because we're waiting for
+		// node 0x03's history entry to expire.
+		{},
+		// The static node is dialed again.
+		{
+			wantNewDials: []*enode.Node{nodes[2]},
+		},
+	})
+}
+
 // This test checks that static dials work and obey the limits.
 func TestDialSchedStaticDial(t *testing.T) {
 	t.Parallel()
@@ -397,6 +481,28 @@ func TestDialSchedResolve(t *testing.T) {
 // -------
 // Code below here is the framework for the tests above.
 
+// dialTestBanList bans the given nodes.
+type dialTestBanList struct {
+	mu    sync.Mutex
+	nodes map[enode.ID]bool
+}
+
+func (l *dialTestBanList) IsBannedNode(id enode.ID) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.nodes[id]
+}
+
+func (l *dialTestBanList) IsBannedIP(ip netip.Addr) bool { return false }
+
+func (l *dialTestBanList) unban(id enode.ID) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.nodes, id)
+}
+
 type dialTestRound struct {
 	peersAdded   []*conn
 	peersRemoved []enode.ID
@@ -56,6 +56,7 @@ type Config struct {
 	Bootnodes       []*enode.Node // list of bootstrap nodes
 	PingInterval    time.Duration // speed of node liveness check
 	RefreshInterval time.Duration // used in bucket refresh
+	PrivateNodes    []*enode.Node // nodes never included in the responses
 
 	// The options below are useful in very specific cases, like in unit tests.
 	V5ProtocolID *[6]byte
@@ -89,6 +90,16 @@ func (cfg Config) withDefaults() Config {
 	return cfg
 }
 
+// privateSet returns the set of the private node IDs.
+func (cfg Config) privateSet() map[enode.ID]struct{} {
+	set := make(map[enode.ID]struct{}, len(cfg.PrivateNodes))
+	for _, n := range cfg.PrivateNodes {
+		set[n.ID()] = struct{}{}
+	}
+
+	return set
+}
+
 // ListenUDP starts listening for discovery packets on the given UDP socket.
 func ListenUDP(c UDPConn, ln *enode.LocalNode, cfg Config) (*UDPv4, error) {
 	return ListenV4(c, ln, cfg)
@@ -329,6 +329,39 @@ func TestUDPv4_findnode(t *testing.T) {
 	waitNeighbors(want)
 }
 
+func TestUDPv4_findnodePrivate(t *testing.T) {
+	test := newUDPTest(t)
+	defer test.close()
+
+	// put a public and a private node into the table
+	var public, private *enode.Node
+
+	for i := 0; i < 2; i++ {
+		key := newkey()
+		n := enode.NewV4(&key.PublicKey, net.IP{10, 13, 0, byte(i)}, 0, 2000)
+		test.table.addFoundNode(n, true)
+
+		if i == 0 {
+			public = n
+		} else {
+			private = n
+		}
+	}
+
+	test.udp.private = map[enode.ID]struct{}{private.ID(): {}}
+
+	remoteID := v4wire.EncodePubkey(&test.remotekey.PublicKey).ID()
+	test.table.db.UpdateLastPongReceived(remoteID, test.remoteaddr.Addr(), time.Now())
+
+	// check that the private node is left out of the neighbors
+	test.packetIn(nil, &v4wire.Findnode{Target: testTarget, Expiration: futureExp})
+	test.waitPacketOut(func(p *v4wire.Neighbors, to netip.AddrPort, hash []byte) {
+		if len(p.Nodes) != 1 || p.Nodes[0].ID.ID() != public.ID() {
+			t.Errorf("unexpected neighbors: %v", p.Nodes)
+		}
+	})
+}
+
 func TestUDPv4_findnodeMultiReply(t *testing.T) {
 	test := newUDPTest(t)
 	defer test.close()
@@ -64,6 +64,7 @@ type UDPv5 struct {
 	conn         UDPConn
 	tab          *Table
 	netrestrict  *netutil.Netlist
+	private      map[enode.ID]struct{} // nodes kept out of the findnode responses
 	priv         *ecdsa.PrivateKey
 	localNode    *enode.LocalNode
 	db           *enode.DB
@@ -156,6 +157,7 @@ func newUDPv5(conn UDPConn, ln *enode.LocalNode, cfg Config) (*UDPv5, error) {
 		localNode:    ln,
 		db:           ln.Database(),
 		netrestrict:  cfg.NetRestrict,
+		private:      cfg.privateSet(),
 		priv:         cfg.PrivateKey,
 		log:          cfg.Log,
 		validSchemes: cfg.ValidSchemes,
@@ -941,6 +943,10 @@ func (t *UDPv5) collectTableNodes(rip netip.Addr, distances []uint, limit int) [
 				continue
 			}
 
+			if _, ok := t.private[n.ID()]; ok {
+				continue
+			}
+
 			nodes = append(nodes, n)
 			if len(nodes) >= limit {
 				return nodes
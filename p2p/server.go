@@ -131,6 +131,24 @@ type Config struct {
 	// live nodes in the network.
 	NodeDatabase string `toml:",omitempty"`
 
+	// If PeerBans is set to a non-nil value, the connections of the nodes and
+	// IP addresses it bans are rejected, and the banned nodes are not dialed
+	// unless they are static nodes.
+	PeerBans PeerBanList `toml:"-"`
+
+	// If DialFilter is set to a non-nil value, the nodes found by discovery are
+	// only dialed if it accepts them. Static nodes are always dialed.
+	DialFilter func(*enode.Node) bool `toml:"-"`
+
+	// TrustedOnly restricts the connections to the trusted nodes, as for a
+	// validator node behind its sentry nodes. The discovery is disabled too, so
+	// that the address of the node isn't gossiped.
+	TrustedOnly bool `toml:",omitempty"`
+
+	// Private nodes are kept out of the discovery responses and of the peer
+	// lists, as the validator nodes behind a sentry node.
+	PrivateNodes []*enode.Node `toml:",omitempty"`
+
 	// Protocols should contain the protocols supported
 	// by the server. Matching protocols are launched for
 	// each peer.
@@ -174,6 +192,13 @@ type Config struct {
 	TxArrivalWait time.Duration
 }
 
+// PeerBanList reports the nodes and IP addresses temporarily banned from
+// connecting to the server.
+type PeerBanList interface {
+	IsBannedNode(id enode.ID) bool
+	IsBannedIP(ip netip.Addr) bool
+}
+
 // Server manages all peer connections.
 type Server struct {
 	// Config fields may not be modified while the server is running.
@@ -216,6 +241,76 @@ type Server struct {
 
 	// State of run loop and listenLoop.
 	inboundHistory expHeap
+
+	// IP addresses of the trusted and static nodes, exempt from the IP bans.
+	reserved reservedIPs
+}
+
+// reservedIPs tracks the IP addresses of the trusted and static nodes. The IP
+// bans are shared by all the nodes behind an address, they don't apply to the
+// nodes configured by the operator.
+type reservedIPs struct {
+	lock    sync.RWMutex
+	static  map[enode.ID]netip.Addr
+	trusted map[enode.ID]netip.Addr
+}
+
+// add records the IP address of a trusted or static node.
+func (r *reservedIPs) add(trusted bool, n *enode.Node) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	nodes := &r.static
+	if trusted {
+		nodes = &r.trusted
+	}
+
+	if *nodes == nil {
+		*nodes = make(map[enode.ID]netip.Addr)
+	}
+
+	(*nodes)[n.ID()] = n.IPAddr()
+}
+
+// remove drops the IP address of a trusted or static node.
+func (r *reservedIPs) remove(trusted bool, n *enode.Node) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if trusted {
+		delete(r.trusted, n.ID())
+	} else {
+		delete(r.static, n.ID())
+	}
+}
+
+// has reports whether the node is a trusted or static node.
+func (r *reservedIPs) has(id enode.ID) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	_, static := r.static[id]
+	_, trusted := r.trusted[id]
+
+	return static || trusted
+}
+
+// contains reports whether the IP address is the one of a trusted or static node.
+func (r *reservedIPs) contains(ip netip.Addr) bool {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	ip = ip.Unmap()
+
+	for _, nodes := range []map[enode.ID]netip.Addr{r.static, r.trusted} {
+		for _, addr := range nodes {
+			if addr.IsValid() && addr.Unmap() == ip {
+				return true
+			}
+		}
+	}
+
+	return false
 }
 
 type peerOpFunc func(map[enode.ID]*Peer)
@@ -385,6 +480,7 @@ func (srv *Server) PeerCount() int {
 // the server will connect to the node. If the connection fails for any reason, the server
 // will attempt to reconnect the peer.
 func (srv *Server) AddPeer(node *enode.Node) {
+	srv.reserved.add(false, node)
 	srv.dialsched.addStatic(node)
 }
 
@@ -394,12 +490,48 @@ func (srv *Server) AddPeer(node *enode.Node) {
 // This method blocks until all protocols have exited and the peer is removed. Do not use
 // RemovePeer in protocol implementations, call Disconnect on the Peer instead.
 func (srv *Server) RemovePeer(node *enode.Node) {
+	srv.removeStatic(node, func() bool {
+		srv.reserved.remove(false, node)
+		return true
+	})
+}
+
+// AddDialedPeer adds the given node to the static node set like AddPeer, but
+// without making it a static node of the operator: its IP address isn't exempt
+// from the IP bans.
+func (srv *Server) AddDialedPeer(node *enode.Node) {
+	srv.dialsched.addStatic(node)
+}
+
+// RemoveDialedPeer removes a node added with AddDialedPeer from the static node
+// set and disconnects from it, unless it was added with AddPeer too.
+//
+// Like RemovePeer, it blocks until the peer is removed.
+func (srv *Server) RemoveDialedPeer(node *enode.Node) {
+	srv.removeStatic(node, func() bool {
+		return !srv.reserved.has(node.ID())
+	})
+}
+
+// IsReserved reports whether the node is a trusted or static node of the operator,
+// i.e. one configured at startup or added with AddTrustedPeer or AddPeer since.
+func (srv *Server) IsReserved(id enode.ID) bool {
+	return srv.reserved.has(id)
+}
+
+// removeStatic removes a node from the static node set and disconnects from it
+// if remove, called on the main loop, allows it.
+func (srv *Server) removeStatic(node *enode.Node, remove func() bool) {
 	var (
 		ch  chan *PeerEvent
 		sub event.Subscription
 	)
 	// Disconnect the peer on the main loop.
 	srv.doPeerOp(func(peers map[enode.ID]*Peer) {
+		if !remove() {
+			return
+		}
+
 		srv.dialsched.removeStatic(node)
 
 		if peer := peers[node.ID()]; peer != nil {
@@ -424,6 +556,8 @@ func (srv *Server) RemovePeer(node *enode.Node) {
 // AddTrustedPeer adds the given node to a reserved trusted list which allows the
 // node to always connect, even if the slot are full.
 func (srv *Server) AddTrustedPeer(node *enode.Node) {
+	srv.reserved.add(true, node)
+
 	select {
 	case srv.addtrusted <- node:
 	case <-srv.quit:
@@ -432,6 +566,8 @@ func (srv *Server) AddTrustedPeer(node *enode.Node) {
 
 // RemoveTrustedPeer removes the given node from the trusted peer set.
 func (srv *Server) RemoveTrustedPeer(node *enode.Node) {
+	srv.reserved.remove(true, node)
+
 	select {
 	case srv.removetrusted <- node:
 	case <-srv.quit:
@@ -564,6 +700,14 @@ func (srv *Server) Start() (err error) {
 	srv.peerOp = make(chan peerOpFunc)
 	srv.peerOpDone = make(chan struct{})
 
+	for _, n := range srv.TrustedNodes {
+		srv.reserved.add(true, n)
+	}
+
+	for _, n := range srv.StaticNodes {
+		srv.reserved.add(false, n)
+	}
+
 	if err := srv.setupLocalNode(); err != nil {
 		return err
 	}
@@ -619,7 +763,7 @@ func (srv *Server) setupDiscovery() error {
 	srv.discmix = enode.NewFairMix(discmixTimeout)
 
 	// Don't listen on UDP endpoint if DHT is disabled.
-	if srv.NoDiscovery {
+	if srv.NoDiscovery || srv.TrustedOnly {
 		return nil
 	}
 	conn, err := srv.setupUDPListening()
@@ -641,11 +785,12 @@ func (srv *Server) setupDiscovery() error {
 	// Start discovery services.
 	if srv.Config.DiscoveryV4 {
 		cfg := discover.Config{
-			PrivateKey:  srv.PrivateKey,
-			NetRestrict: srv.NetRestrict,
-			Bootnodes:   srv.BootstrapNodes,
-			Unhandled:   unhandled,
-			Log:         srv.log,
+			PrivateKey:   srv.PrivateKey,
+			NetRestrict:  srv.NetRestrict,
+			Bootnodes:    srv.BootstrapNodes,
+			PrivateNodes: srv.PrivateNodes,
+			Unhandled:    unhandled,
+			Log:          srv.log,
 		}
 
 		ntab, err := discover.ListenV4(conn, srv.localnode, cfg)
@@ -657,10 +802,11 @@ func (srv *Server) setupDiscovery() error {
 	}
 	if srv.Config.DiscoveryV5 {
 		cfg := discover.Config{
-			PrivateKey:  srv.PrivateKey,
-			NetRestrict: srv.NetRestrict,
-			Bootnodes:   srv.BootstrapNodesV5,
-			Log:         srv.log,
+			PrivateKey:   srv.PrivateKey,
+			NetRestrict:  srv.NetRestrict,
+			Bootnodes:    srv.BootstrapNodesV5,
+			PrivateNodes: srv.PrivateNodes,
+			Log:          srv.log,
 		}
 		srv.discv5, err = discover.ListenV5(sconn, srv.localnode, cfg)
 		if err != nil {
@@ -686,6 +832,8 @@ func (srv *Server) setupDialScheduler() {
 		maxActiveDials: srv.MaxPendingPeers,
 		log:            srv.Logger,
 		netRestrict:    srv.NetRestrict,
+		bans:           srv.PeerBans,
+		filter:         srv.DialFilter,
 		dialer:         srv.Dialer,
 		clock:          srv.clock,
 	}
@@ -923,11 +1071,48 @@ func (srv *Server) postHandshakeChecks(peers map[enode.ID]*Peer, inboundCount in
 		return DiscAlreadyConnected
 	case c.node.ID() == srv.localnode.ID():
 		return DiscSelf
+	case !c.is(trustedConn) && srv.isBanned(c):
+		return DiscUselessPeer
+	case !c.is(trustedConn) && srv.TrustedOnly:
+		return DiscUselessPeer
 	default:
 		return nil
 	}
 }
 
+// isPrivate reports whether the node is one of the private nodes.
+func (srv *Server) isPrivate(id enode.ID) bool {
+	for _, n := range srv.PrivateNodes {
+		if n.ID() == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isBanned reports whether the node or the remote IP address of the connection
+// is banned.
+func (srv *Server) isBanned(c *conn) bool {
+	if srv.PeerBans == nil {
+		return false
+	}
+
+	if srv.PeerBans.IsBannedNode(c.node.ID()) {
+		return true
+	}
+
+	// The IP bans don't apply to the trusted and static nodes of the operator,
+	// unlike the static nodes added with AddDialedPeer
+	if c.fd != nil && !srv.reserved.has(c.node.ID()) {
+		if ip := netutil.AddrAddr(c.fd.RemoteAddr()); ip.IsValid() && srv.PeerBans.IsBannedIP(ip) && !srv.reserved.contains(ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (srv *Server) addPeerChecks(peers map[enode.ID]*Peer, inboundCount int, c *conn) error {
 	// Drop connections with no matching protocols.
 	if len(srv.Protocols) > 0 && countMatchingProtocols(srv.Protocols, c.caps) == 0 {
@@ -1025,6 +1210,10 @@ func (srv *Server) checkInboundConn(remoteIP netip.Addr) error {
 	if srv.NetRestrict != nil && !srv.NetRestrict.ContainsAddr(remoteIP) {
 		return errors.New("not in netrestrict list")
 	}
+	// Reject banned addresses, unless they are the ones of trusted or static nodes.
+	if srv.PeerBans != nil && srv.PeerBans.IsBannedIP(remoteIP) && !srv.reserved.contains(remoteIP) {
+		return errors.New("banned address")
+	}
 	// Reject Internet peers that try too often.
 	now := srv.clock.Now()
 	srv.inboundHistory.expire(now, nil)
@@ -1248,7 +1437,7 @@ func (srv *Server) PeersInfo() []*PeerInfo {
 	infos := make([]*PeerInfo, 0, srv.PeerCount())
 
 	for _, peer := range srv.Peers() {
-		if peer != nil {
+		if peer != nil && !srv.isPrivate(peer.ID()) {
 			infos = append(infos, peer.Info())
 		}
 	}
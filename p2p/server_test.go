@@ -23,12 +23,14 @@ import (
 	"io"
 	"math/rand"
 	"net"
+	"net/netip"
 	"reflect"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common/mclock"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/internal/testlog"
 	"github.com/ethereum/go-ethereum/log"
@@ -341,6 +343,56 @@ func TestServerAtCap(t *testing.T) {
 	}
 }
 
+func TestServerTrustedOnly(t *testing.T) {
+	trustedNode := newkey()
+	trustedID := enode.PubkeyToIDV4(&trustedNode.PublicKey)
+	privateID := randomID()
+
+	srv := &Server{
+		Config: Config{
+			PrivateKey:   newkey(),
+			MaxPeers:     10,
+			NoDial:       true,
+			TrustedOnly:  true,
+			TrustedNodes: []*enode.Node{newNode(trustedID, "")},
+			PrivateNodes: []*enode.Node{newNode(privateID, "")},
+			Logger:       testlog.Logger(t, log.LvlTrace),
+		},
+	}
+	if err := srv.Start(); err != nil {
+		t.Fatalf("could not start: %v", err)
+	}
+
+	defer srv.Stop()
+
+	if srv.DiscoveryV4() != nil || srv.DiscoveryV5() != nil {
+		t.Fatal("discovery started for a trusted only server")
+	}
+
+	newconn := func(id enode.ID) *conn {
+		fd, _ := net.Pipe()
+		tx := newTestTransport(&trustedNode.PublicKey, fd, nil)
+		node := enode.SignNull(new(enr.Record), id)
+
+		return &conn{fd: fd, transport: tx, flags: inboundConn, node: node, cont: make(chan error)}
+	}
+
+	// Try inserting a non-trusted connection.
+	c := newconn(randomID())
+	if err := srv.checkpoint(c, srv.checkpointPostHandshake); err != DiscUselessPeer {
+		t.Error("wrong error for untrusted conn:", err)
+	}
+	// Try inserting a trusted connection.
+	c = newconn(trustedID)
+	if err := srv.checkpoint(c, srv.checkpointPostHandshake); err != nil {
+		t.Error("unexpected error for trusted conn @posthandshake:", err)
+	}
+
+	if srv.isPrivate(trustedID) || !srv.isPrivate(privateID) {
+		t.Error("wrong private nodes")
+	}
+}
+
 func TestServerPeerLimits(t *testing.T) {
 	srvkey := newkey()
 	clientkey := newkey()
@@ -558,6 +610,83 @@ func randomID() (id enode.ID) {
 	return id
 }
 
+// testBanList bans the given IP addresses.
+type testBanList map[netip.Addr]bool
+
+func (l testBanList) IsBannedNode(id enode.ID) bool { return false }
+func (l testBanList) IsBannedIP(ip netip.Addr) bool { return l[ip] }
+
+// This test checks that the IP bans don't apply to the trusted and static nodes.
+func TestServerInboundBannedIP(t *testing.T) {
+	var (
+		trusted = netip.MustParseAddr("95.33.21.2")
+		static  = netip.MustParseAddr("95.33.21.3")
+		srv     = &Server{
+			Config: Config{PeerBans: testBanList{trusted: true, static: true}, clock: new(mclock.Simulated)},
+		}
+		trustedNode = enode.NewV4(&newkey().PublicKey, trusted.AsSlice(), 30303, 0)
+		staticNode  = enode.NewV4(&newkey().PublicKey, static.AsSlice(), 30303, 0)
+	)
+
+	if err := srv.checkInboundConn(trusted); err == nil {
+		t.Fatal("banned address accepted")
+	}
+
+	srv.reserved.add(true, trustedNode)
+	srv.reserved.add(false, staticNode)
+
+	if err := srv.checkInboundConn(trusted); err != nil {
+		t.Fatalf("trusted node rejected: %v", err)
+	}
+
+	if err := srv.checkInboundConn(static); err != nil {
+		t.Fatalf("static node rejected: %v", err)
+	}
+
+	srv.reserved.remove(false, staticNode)
+
+	if srv.reserved.contains(static) {
+		t.Fatal("removed static node still exempt from the IP bans")
+	}
+}
+
+// remoteAddrConn is a connection from the given remote address.
+type remoteAddrConn struct {
+	net.Conn
+	remote net.Addr
+}
+
+func (c remoteAddrConn) RemoteAddr() net.Addr { return c.remote }
+
+// This test checks that the IP bans apply to the static nodes added with
+// AddDialedPeer, unlike the ones added with AddPeer.
+func TestServerDialedPeerBannedIP(t *testing.T) {
+	var (
+		ip   = netip.MustParseAddr("95.33.21.4")
+		srv  = &Server{Config: Config{PeerBans: testBanList{ip: true}}}
+		node = enode.NewV4(&newkey().PublicKey, ip.AsSlice(), 30303, 0)
+	)
+
+	fd, _ := net.Pipe()
+	defer fd.Close()
+
+	c := &conn{
+		fd:    remoteAddrConn{fd, &net.TCPAddr{IP: ip.AsSlice(), Port: 30303}},
+		node:  node,
+		flags: staticDialedConn,
+	}
+
+	if !srv.isBanned(c) {
+		t.Fatal("dialed peer exempt from the IP bans")
+	}
+
+	srv.reserved.add(false, node)
+
+	if srv.isBanned(c) {
+		t.Fatal("static node subject to the IP bans")
+	}
+}
+
 // This test checks that inbound connections are throttled by IP.
 func TestServerInboundThrottle(t *testing.T) {
 	const timeout = 5 * time.Second
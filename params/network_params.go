@@ -32,6 +32,22 @@ const (
 	// considered probably final and its rotated bits are calculated.
 	BloomConfirms = 256
 
+	// AddressActivityBlocks is the number of blocks a single section of the
+	// address activity index contains.
+	AddressActivityBlocks uint64 = 4096
+
+	// AddressActivityConfirms is the number of confirmation blocks before an
+	// address activity section is considered probably final and indexed.
+	AddressActivityConfirms = 256
+
+	// LogIndexBlocks is the number of blocks a single section of the log index
+	// contains.
+	LogIndexBlocks uint64 = 1024
+
+	// LogIndexConfirms is the number of confirmation blocks before a log index
+	// section is considered probably final and indexed.
+	LogIndexConfirms = 256
+
 	// CHTFrequency is the block frequency for creating CHTs
 	CHTFrequency = 32768
 
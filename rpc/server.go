@@ -52,7 +52,7 @@ type Server struct {
 	codecs map[ServerCodec]struct{}
 	run    atomic.Bool
 
-	BatchLimit    uint64
+	batchLimit    atomic.Uint64
 	executionPool *SafePool
 
 	batchItemLimit     int
@@ -84,7 +84,7 @@ func NewServer(service string, executionPoolSize uint64, executionPoolRequesttim
 }
 
 func (s *Server) SetRPCBatchLimit(batchLimit uint64) {
-	s.BatchLimit = batchLimit
+	s.batchLimit.Store(batchLimit)
 }
 
 func (s *Server) SetExecutionPoolSize(n int) {
@@ -197,10 +197,10 @@ func (s *Server) serveSingleRequest(ctx context.Context, codec ServerCodec) {
 	}
 
 	if batch {
-		if s.BatchLimit > 0 && len(reqs) > int(s.BatchLimit) {
-			if err1 := codec.writeJSON(ctx, errorMessage(fmt.Errorf("batch limit %d exceeded: %d requests given", s.BatchLimit, len(reqs))), true); err1 != nil {
+		if batchLimit := s.batchLimit.Load(); batchLimit > 0 && len(reqs) > int(batchLimit) {
+			if err1 := codec.writeJSON(ctx, errorMessage(fmt.Errorf("batch limit %d exceeded: %d requests given", batchLimit, len(reqs))), true); err1 != nil {
 				log.Warn("WARNING - requests given exceeds the batch limit", "err", err1)
-				log.Debug("batch limit %d exceeded: %d requests given", s.BatchLimit, len(reqs))
+				log.Debug("batch limit %d exceeded: %d requests given", batchLimit, len(reqs))
 			}
 		} else {
 			//nolint:contextcheck